@@ -15,46 +15,85 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/dummyLogin": {
-            "post": {
-                "description": "Authenticates a user and returns a JWT token for role.",
-                "consumes": [
-                    "application/json"
+        "/audit": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Get state-changing operations journal, newest first. Requires JWT-Token with Moderator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Audit"
                 ],
-                "summary": "Dummy login",
-                "operationId": "DummyLogin",
+                "summary": "List audit events",
+                "operationId": "ListAudit",
                 "parameters": [
                     {
-                        "description": "User credentials (email and password)",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/auth.DummyLoginRequest"
-                        }
+                        "type": "string",
+                        "description": "Filter by PVZ ID",
+                        "name": "pvzId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by actor user ID",
+                        "name": "actorId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pvz.created",
+                            "reception.opened",
+                            "reception.closed",
+                            "product.added",
+                            "product.removed"
+                        ],
+                        "type": "string",
+                        "description": "Filter by action",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of time range (RFC3339)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of time range (RFC3339)",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page for pagination",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "JWT token issued successfully",
+                        "description": "Audit events",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/audit.EventResponse"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid request or validation failed",
-                        "schema": {
-                            "$ref": "#/definitions/response.Error"
-                        }
-                    },
-                    "401": {
-                        "description": "Generate token failed",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -68,46 +107,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
-            "post": {
-                "description": "Authenticate user and returns a JWT Bearer token.",
-                "consumes": [
-                    "application/json"
+        "/cities": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Get cities in which PVZ can be registered, ordered by name. Requires JWT-Token with Moderator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Cities"
                 ],
-                "summary": "User login",
-                "operationId": "Login",
+                "summary": "List cities",
+                "operationId": "ListCities",
                 "parameters": [
                     {
-                        "description": "User credentials (email and password)",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/auth.LoginRequest"
-                        }
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page for pagination",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "JWT access token",
+                        "description": "Cities",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/city.CityResponse"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid request or validation failed",
-                        "schema": {
-                            "$ref": "#/definitions/response.Error"
-                        }
-                    },
-                    "401": {
-                        "description": "Invalid email or password",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -119,16 +160,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/products": {
+            },
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Creates a new product in the PVZ system.",
+                "description": "Add a city in which PVZ can be registered. Requires JWT-Token with Moderator role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -136,36 +175,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Product"
+                    "Cities"
                 ],
-                "summary": "Create a new product",
-                "operationId": "CreateProduct",
+                "summary": "Create city",
+                "operationId": "CreateCity",
                 "parameters": [
                     {
-                        "description": "Product creation payload",
+                        "description": "City data",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/product.CreateRequest"
+                            "$ref": "#/definitions/city.CreateRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Product successfully created",
+                        "description": "City created",
                         "schema": {
-                            "$ref": "#/definitions/product.CreateResponse"
+                            "$ref": "#/definitions/city.CityResponse"
                         }
                     },
                     "400": {
-                        "description": "No reception is currently in progress",
+                        "description": "Invalid request or validation failed",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
                     },
                     "409": {
-                        "description": "Product already exists or conflict error",
+                        "description": "City already exists",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -179,50 +218,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/pvz": {
-            "get": {
+        "/cities/{cityID}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of PVZ points with optional filters. Requires JWT-Token with Employee or Moderator role.",
+                "description": "Delete a city without PVZ. Requires JWT-Token with Moderator role.",
                 "tags": [
-                    "PVZ"
+                    "Cities"
                 ],
-                "summary": "List PVZ points",
+                "summary": "Delete city",
+                "operationId": "DeleteCity",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by city",
-                        "name": "city",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit number of results",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page for pagination",
-                        "name": "page",
-                        "in": "query"
+                        "description": "City ID",
+                        "name": "cityID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "List of PVZ points",
+                    "204": {
+                        "description": "City deleted"
+                    },
+                    "400": {
+                        "description": "Invalid city ID",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/pvz.PVZListResponse"
-                            }
+                            "$ref": "#/definitions/response.Error"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "404": {
+                        "description": "City not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "City has PVZ",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -235,13 +270,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "patch": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create new PVZ. Requires JWT-Token with Employee role.",
+                "description": "Rename a city, its PVZ stay attached to it. Requires JWT-Token with Moderator role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -249,26 +284,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PVZ"
+                    "Cities"
                 ],
-                "summary": "Create PVZ",
-                "operationId": "CreatePVZ",
+                "summary": "Rename city",
+                "operationId": "UpdateCity",
                 "parameters": [
                     {
-                        "description": "PVZ creation data",
+                        "type": "string",
+                        "description": "City ID",
+                        "name": "cityID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "City data",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pvz.CreateRequest"
+                            "$ref": "#/definitions/city.UpdateRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "PVZ successfully created",
+                        "description": "City updated",
                         "schema": {
-                            "$ref": "#/definitions/pvz.CreateResponse"
+                            "$ref": "#/definitions/city.CityResponse"
                         }
                     },
                     "400": {
@@ -284,7 +326,7 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "Pvz with this id already exists",
+                        "description": "City already exists",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -298,46 +340,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/pvz/{pvzID}/close_last_reception": {
+        "/dummyLogin": {
             "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
+                "description": "Authenticates a user and returns a JWT token for role.",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Close the last reception for a given PVZ ID. Requires JWT-Token with Employee role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "PVZ"
+                    "Auth"
                 ],
-                "summary": "Close Last Reception",
-                "operationId": "CloseLastReception",
+                "summary": "Dummy login",
+                "operationId": "DummyLogin",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "PVZ ID",
-                        "name": "pvzID",
-                        "in": "path",
-                        "required": true
+                        "description": "User credentials (email and password)",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.DummyLoginRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully closed last reception",
+                        "description": "JWT token issued successfully",
                         "schema": {
-                            "$ref": "#/definitions/reception.CloseLastReceptionResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
-                        "description": "Invalid or missing PVZ ID",
+                        "description": "Invalid request or validation failed",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
                     },
-                    "404": {
-                        "description": "No open reception found for this PVZ",
+                    "401": {
+                        "description": "Generate token failed",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -351,49 +393,59 @@ const docTemplate = `{
                 }
             }
         },
-        "/pvz/{pvzID}/delete_last_product": {
-            "post": {
+        "/export/receptions": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Deletes the most recently added product for the given PVZ ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Stream all receptions with their products over a date range, one row per product. Receptions without products are exported as a single row with empty product fields. Requires JWT-Token with Moderator role.",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/x-ndjson"
                 ],
                 "tags": [
-                    "PVZ"
+                    "Export"
                 ],
-                "summary": "Delete the last product of a PVZ",
-                "operationId": "DeleteLastProduct",
+                "summary": "Export receptions",
+                "operationId": "ExportReceptions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "PVZ ID (UUID)",
-                        "name": "pvzID",
-                        "in": "path",
-                        "required": true
+                        "description": "Start of reception time range (RFC3339)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of reception time range (RFC3339)",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Output format, csv by default",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted",
+                        "description": "Receptions export, rows of RowResponse in CSV or NDJSON",
                         "schema": {
-                            "$ref": "#/definitions/response.Empty"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/export.RowResponse"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid pvzID format",
-                        "schema": {
-                            "$ref": "#/definitions/response.Error"
-                        }
-                    },
-                    "409": {
-                        "description": "No products to delete",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -407,41 +459,95 @@ const docTemplate = `{
                 }
             }
         },
-        "/receptions": {
-            "post": {
+        "/issuances": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new reception entry. Requires JWT-Token with Employee role.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get issue and return history of the product in chronological order. Requires JWT-Token with Employee or Moderator role.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Reception"
+                    "Issuances"
                 ],
-                "summary": "Create Reception",
-                "operationId": "CreateReception",
+                "summary": "List product issuances",
+                "operationId": "ListIssuances",
                 "parameters": [
                     {
-                        "description": "Reception creation data",
+                        "type": "string",
+                        "description": "Product ID (UUID)",
+                        "name": "productId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Issuance history",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/issuance.Response"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid productId format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Hands a received product over to a customer. Only products of closed receptions can be issued. Requires JWT-Token with Employee role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Issuances"
+                ],
+                "summary": "Issue product to customer",
+                "operationId": "IssueProduct",
+                "parameters": [
+                    {
+                        "description": "Issuance data",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/reception.CreateRequest"
+                            "$ref": "#/definitions/issuance.IssueRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Reception successfully created",
+                        "description": "Product issued",
                         "schema": {
-                            "$ref": "#/definitions/reception.CreateResponse"
+                            "$ref": "#/definitions/issuance.Response"
                         }
                     },
                     "400": {
@@ -451,7 +557,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "PVZ not found",
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Product is already issued or not received yet",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -465,9 +577,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/register": {
+        "/issuances/returns": {
             "post": {
-                "description": "Creates a new user with role and returns created user data.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Accepts an issued product back from the customer, the product is on hand in the PVZ again. The return links to the original issuance. Requires JWT-Token with Employee role.",
                 "consumes": [
                     "application/json"
                 ],
@@ -475,26 +592,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Issuances"
                 ],
-                "summary": "Register new user",
-                "operationId": "Register",
+                "summary": "Return issued product",
+                "operationId": "ReturnProduct",
                 "parameters": [
                     {
-                        "description": "User registration payload",
+                        "description": "Return data",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/auth.RegisterRequest"
+                            "$ref": "#/definitions/issuance.ReturnRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "User successfully created",
+                        "description": "Product returned",
                         "schema": {
-                            "$ref": "#/definitions/auth.RegisterResponse"
+                            "$ref": "#/definitions/issuance.Response"
                         }
                     },
                     "400": {
@@ -503,8 +620,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.Error"
                         }
                     },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
                     "409": {
-                        "description": "Email already exists",
+                        "description": "Product is not issued",
                         "schema": {
                             "$ref": "#/definitions/response.Error"
                         }
@@ -517,135 +640,2163 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "auth.DummyLoginRequest": {
-            "type": "object",
-            "required": [
-                "role"
-            ],
-            "properties": {
-                "role": {
-                    "type": "string"
+        },
+        "/login": {
+            "post": {
+                "description": "Authenticate user and returns a JWT Bearer access token and a refresh token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "User login",
+                "operationId": "Login",
+                "parameters": [
+                    {
+                        "description": "User credentials (email and password)",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Access and refresh tokens",
+                        "schema": {
+                            "$ref": "#/definitions/auth.TokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid email or password",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
                 }
             }
         },
-        "auth.LoginRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
-            "properties": {
-                "email": {
+        "/logout": {
+            "post": {
+                "description": "Revokes the refresh token. If a Bearer access token is passed in Authorization header, it is revoked too.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Logout",
+                "operationId": "Logout",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.LogoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Tokens revoked"
+                    },
+                    "400": {
+                        "description": "Invalid request or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid refresh token",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates a new product in the PVZ system. Fails with 409 when the PVZ capacity or the capacity for the product type is already reached by products on hand.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Create a new product",
+                "operationId": "CreateProduct",
+                "parameters": [
+                    {
+                        "description": "Product creation payload",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.CreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Product successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/product.CreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "No reception is currently in progress",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Product already exists, barcode is already scanned, PVZ is not active or PVZ capacity is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/batch": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Adds up to 100 products to the open reception of one PVZ in a single transaction. With allOrNothing the whole batch fails on the first invalid item, otherwise invalid items are reported in the per-item results. Items beyond the PVZ capacity or the capacity for their product type are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Create products in batch",
+                "operationId": "CreateProductBatch",
+                "parameters": [
+                    {
+                        "description": "Product batch payload",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.BatchCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Some products were not created",
+                        "schema": {
+                            "$ref": "#/definitions/product.BatchCreateResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "All products created",
+                        "schema": {
+                            "$ref": "#/definitions/product.BatchCreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or unknown product type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "PVZ not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "No reception is currently in progress, PVZ is not active or PVZ capacity is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/by-barcode/{code}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the last accepted product with the barcode together with the reception and PVZ that accepted it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Find product by barcode",
+                "operationId": "GetProductByBarcode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product barcode",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product location",
+                        "schema": {
+                            "$ref": "#/definitions/product.LocationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid barcode",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{productID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes any product of the currently open reception. Products of closed or cancelled receptions and products with issuance history can not be deleted. Requires JWT-Token with Employee role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Delete product by ID",
+                "operationId": "DeleteProduct",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID (UUID)",
+                        "name": "productID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successfully deleted"
+                    },
+                    "400": {
+                        "description": "Invalid productID format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Reception of the product is not open or product has issuance history",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a list of PVZ points with optional filters, newest first by default. Date range, reception status and product type filters must match the same reception. Supports page based and keyset pagination: pass the X-Next-Cursor value of the previous response as cursor, keyset pagination works only with the default sort. Requires JWT-Token with Employee or Moderator role.",
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "List PVZ points",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by city, repeated or comma separated",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reception date from (RFC3339)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reception date to (RFC3339)",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "in_progress",
+                                "close",
+                                "cancelled",
+                                "reopened"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by reception status",
+                        "name": "receptionStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Filter by product type in reception",
+                        "name": "productType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "city,-registrationDate",
+                        "description": "Comma separated sort fields: registrationDate, city, lastReceptionAt; prefix - for desc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page for pagination",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor of the next page, cannot be used with page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Return an object {items, page, limit, total, hasNext, nextCursor} instead of the array",
+                        "name": "envelope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of PVZ points",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pvz.PVZListResponse"
+                            }
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "RFC 5988 links to the first, prev, next and, with envelope=true, last pages"
+                            },
+                            "X-Next-Cursor": {
+                                "type": "string",
+                                "description": "Cursor of the next page, absent on the last page"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create new PVZ. Requires JWT-Token with Employee role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Create PVZ",
+                "operationId": "CreatePVZ",
+                "parameters": [
+                    {
+                        "description": "PVZ creation data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pvz.CreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PVZ successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/pvz.CreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, validation failed, invalid location, working hours, capacity or unknown product type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "City not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Pvz with this id already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/nearby": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get active PVZ points within radius of the point, nearest first. Distance is great-circle distance in kilometers, PVZ without coordinates are not returned. Requires JWT-Token with Employee or Moderator role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Nearby PVZ points",
+                "operationId": "NearbyPVZ",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Latitude, -90..90",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Longitude, -180..180",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Radius in kilometers, 5 by default, at most 100",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PVZ points ordered by distance",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pvz.NearbyResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid coordinates, radius or limit",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{pvzID}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a single PVZ with its city, stock on hand and a paginated list of its receptions, newest first. Products of receptions are returned only with include=products. Requires JWT-Token with Employee or Moderator role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Get PVZ",
+                "operationId": "GetPVZ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PVZ ID (UUID)",
+                        "name": "pvzID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Receptions from date (RFC3339)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Receptions to date (RFC3339)",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of receptions",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page for pagination",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "products"
+                        ],
+                        "type": "string",
+                        "description": "Expansions, comma separated",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PVZ with receptions",
+                        "schema": {
+                            "$ref": "#/definitions/pvz.DetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "PVZ not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Partially update PVZ address, location, working hours and capacity limits, fields not passed stay unchanged, an empty workingHours array clears the schedule, an empty typeCapacities object removes per product type limits and an explicit null location or capacity removes the coordinates or the total capacity limit. Working hours are a weekly schedule of non-overlapping intervals per day in HH:MM, close may be 24:00. Requires JWT-Token with Moderator role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Update PVZ profile",
+                "operationId": "UpdatePVZ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PVZ ID (UUID)",
+                        "name": "pvzID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Profile fields to update",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pvz.UpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated PVZ",
+                        "schema": {
+                            "$ref": "#/definitions/pvz.PvzResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, validation failed, invalid location, working hours, capacity or unknown product type",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "PVZ not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{pvzID}/close_last_reception": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Close the last reception for a given PVZ ID. Requires JWT-Token with Employee role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Close Last Reception",
+                "operationId": "CloseLastReception",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PVZ ID",
+                        "name": "pvzID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully closed last reception",
+                        "schema": {
+                            "$ref": "#/definitions/reception.CloseLastReceptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid or missing PVZ ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "No open reception found for this PVZ",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{pvzID}/delete_last_product": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes the most recently added product for the given PVZ ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Delete the last product of a PVZ",
+                "operationId": "DeleteLastProduct",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PVZ ID (UUID)",
+                        "name": "pvzID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully deleted",
+                        "schema": {
+                            "$ref": "#/definitions/response.Empty"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid pvzID format",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "No products to delete",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{pvzID}/inventory": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get counts by product type of products currently on the shelf: received, not removed and not issued. With asOf returns historical inventory at the given moment. Requires JWT-Token with Employee or Moderator role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "PVZ inventory",
+                "operationId": "GetPVZInventory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PVZ ID (UUID)",
+                        "name": "pvzID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Moment of inventory (RFC3339), now by default",
+                        "name": "asOf",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Inventory by product type",
+                        "schema": {
+                            "$ref": "#/definitions/pvz.InventoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid pvzID or asOf",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "PVZ not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/pvz/{pvzID}/status": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Change PVZ status: active, suspended or closed. Reason is required for suspended and closed, the new status takes effect immediately: effectiveDate is optional and, when passed, must be the current time within one minute, scheduled and backdated changes are rejected with 400. Closed is terminal, PVZ with an open reception can not be suspended or closed. Receptions and products can be created only in active PVZ. Requires JWT-Token with Moderator role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVZ"
+                ],
+                "summary": "Change PVZ status",
+                "operationId": "ChangePVZStatus",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PVZ ID (UUID)",
+                        "name": "pvzID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pvz.ChangeStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PVZ with the new status",
+                        "schema": {
+                            "$ref": "#/definitions/pvz.PvzResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "PVZ not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Status transition is not allowed or PVZ has an open reception",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new reception entry. Requires JWT-Token with Employee role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reception"
+                ],
+                "summary": "Create Reception",
+                "operationId": "CreateReception",
+                "parameters": [
+                    {
+                        "description": "Reception creation data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/reception.CreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Reception successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/reception.CreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "PVZ not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "PVZ is not active",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/{receptionID}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancel an open reception created by mistake. Cancelled reception can not be closed or reopened. Requires JWT-Token with Moderator role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reception"
+                ],
+                "summary": "Cancel Reception",
+                "operationId": "CancelReception",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reception ID",
+                        "name": "receptionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reception cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/reception.ReceptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid reception ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Reception not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition is not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/{receptionID}/reopen": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reopen a closed reception to fix a scan. PVZ must have no other open reception. Requires JWT-Token with Moderator role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reception"
+                ],
+                "summary": "Reopen Reception",
+                "operationId": "ReopenReception",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reception ID",
+                        "name": "receptionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reception reopened",
+                        "schema": {
+                            "$ref": "#/definitions/reception.ReceptionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid reception ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Reception not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition is not allowed, PVZ already has an open reception or is not active, or a product barcode is already in another open reception",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/receptions/{receptionID}/transitions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get status history of a reception in chronological order. Requires JWT-Token with Moderator role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reception"
+                ],
+                "summary": "List Reception Transitions",
+                "operationId": "ListReceptionTransitions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reception ID",
+                        "name": "receptionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status history",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/reception.TransitionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid reception ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Reception not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "description": "Creates a new user with role and returns created user data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Register new user",
+                "operationId": "Register",
+                "parameters": [
+                    {
+                        "description": "User registration payload",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/auth.RegisterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "409": {
+                        "description": "Email already exists",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/token/refresh": {
+            "post": {
+                "description": "Exchanges a refresh token for a new access and refresh token pair. The used refresh token is revoked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Refresh tokens",
+                "operationId": "RefreshToken",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.RefreshRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "New access and refresh tokens",
+                        "schema": {
+                            "$ref": "#/definitions/auth.TokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid refresh token",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get registered webhook subscriptions, newest first. Requires JWT-Token with Moderator role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook subscriptions",
+                "operationId": "ListWebhooks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page for pagination",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Subscriptions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/webhook.SubscriptionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Register URL to receive signed event callbacks. If secret is omitted it is generated and returned only in this response. Requires JWT-Token with Moderator role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create webhook subscription",
+                "operationId": "CreateWebhook",
+                "parameters": [
+                    {
+                        "description": "Subscription data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhook.CreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Subscription created",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.CreateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{subscriptionID}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete subscription together with its delivery log. Requires JWT-Token with Moderator role.",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete webhook subscription",
+                "operationId": "DeleteWebhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "subscriptionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Subscription deleted"
+                    },
+                    "400": {
+                        "description": "Invalid subscription ID",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{subscriptionID}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get delivery log of a subscription, newest first. Requires JWT-Token with Moderator role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "operationId": "ListWebhookDeliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "subscriptionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page for pagination",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deliveries",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/webhook.DeliveryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Error"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "audit.EventResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actorId": {
+                    "type": "string"
+                },
+                "after": {
+                    "type": "object"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "entityId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pvzId": {
+                    "type": "string"
+                },
+                "requestId": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.DummyLoginRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "auth.LogoutRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "auth.RefreshRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "auth.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 6
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.RegisterResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.TokenResponse": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "refreshToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "city.CityResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "city.CreateRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "city.UpdateRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "export.RowResponse": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "issued": {
+                    "type": "boolean"
+                },
+                "productDateTime": {
+                    "type": "string"
+                },
+                "productId": {
+                    "type": "string"
+                },
+                "productType": {
+                    "type": "string"
+                },
+                "pvzId": {
+                    "type": "string"
+                },
+                "receptionDateTime": {
+                    "type": "string"
+                },
+                "receptionId": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "issuance.IssueRequest": {
+            "type": "object",
+            "required": [
+                "customer",
+                "productId"
+            ],
+            "properties": {
+                "customer": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "productId": {
+                    "type": "string"
+                }
+            }
+        },
+        "issuance.Response": {
+            "type": "object",
+            "properties": {
+                "actorId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "customer": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "issueId": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "issue",
+                        "return"
+                    ]
+                },
+                "productId": {
+                    "type": "string"
+                },
+                "pvzId": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "issuance.ReturnRequest": {
+            "type": "object",
+            "required": [
+                "productId"
+            ],
+            "properties": {
+                "productId": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "product.BatchCreateRequest": {
+            "type": "object",
+            "required": [
+                "items",
+                "pvzId"
+            ],
+            "properties": {
+                "allOrNothing": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/product.BatchItemRequest"
+                    }
+                },
+                "pvzId": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.BatchCreateResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.BatchItemResponse"
+                    }
+                }
+            }
+        },
+        "product.BatchItemRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "barcode": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "type": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "product.BatchItemResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "product": {
+                    "$ref": "#/definitions/product.CreateResponse"
+                }
+            }
+        },
+        "product.CreateRequest": {
+            "type": "object",
+            "required": [
+                "pvzId",
+                "type"
+            ],
+            "properties": {
+                "barcode": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "pvzId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "product.CreateResponse": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "type": "string"
+                },
+                "dateTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "receptionId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.LocationPvzResponse": {
+            "type": "object",
+            "properties": {
+                "cityId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "registrationDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.LocationReceptionResponse": {
+            "type": "object",
+            "properties": {
+                "dateTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.LocationResponse": {
+            "type": "object",
+            "properties": {
+                "product": {
+                    "$ref": "#/definitions/product.CreateResponse"
+                },
+                "pvz": {
+                    "$ref": "#/definitions/product.LocationPvzResponse"
+                },
+                "reception": {
+                    "$ref": "#/definitions/product.LocationReceptionResponse"
+                }
+            }
+        },
+        "pvz.ChangeStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "effectiveDate": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "suspended",
+                        "closed"
+                    ]
+                }
+            }
+        },
+        "pvz.CreateRequest": {
+            "type": "object",
+            "required": [
+                "city",
+                "id",
+                "registrationDate",
+                "typeCapacities"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "city": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/pvz.LocationRequest"
+                },
+                "registrationDate": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
+                "typeCapacities": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "workingHours": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "$ref": "#/definitions/pvz.WorkingInterval"
+                    }
                 }
             }
         },
-        "auth.RegisterRequest": {
+        "pvz.CreateResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "password",
-                "role"
-            ],
             "properties": {
-                "email": {
+                "address": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 6
+                "capacity": {
+                    "type": "integer"
                 },
-                "role": {
+                "city": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/pvz.Location"
+                },
+                "registrationDate": {
                     "type": "string"
+                },
+                "typeCapacities": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "workingHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pvz.WorkingInterval"
+                    }
                 }
             }
         },
-        "auth.RegisterResponse": {
+        "pvz.DetailResponse": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "pvz": {
+                    "$ref": "#/definitions/pvz.PvzResponse"
                 },
-                "id": {
-                    "type": "string"
+                "receptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pvz.ReceptionDetail"
+                    }
                 },
-                "role": {
-                    "type": "string"
+                "stockOnHand": {
+                    "type": "integer"
                 }
             }
         },
-        "product.CreateRequest": {
+        "pvz.InventoryItemResponse": {
             "type": "object",
-            "required": [
-                "pvzId",
-                "type"
-            ],
             "properties": {
-                "pvzId": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
                 "type": {
-                    "type": "string",
-                    "maxLength": 255
+                    "type": "string"
                 }
             }
         },
-        "product.CreateResponse": {
+        "pvz.InventoryResponse": {
             "type": "object",
             "properties": {
-                "dateTime": {
+                "asOf": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pvz.InventoryItemResponse"
+                    }
                 },
-                "receptionId": {
+                "pvzId": {
                     "type": "string"
                 },
-                "type": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "pvz.CreateRequest": {
+        "pvz.Location": {
+            "type": "object",
+            "properties": {
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                }
+            }
+        },
+        "pvz.LocationRequest": {
             "type": "object",
             "required": [
-                "city",
-                "id",
-                "registrationDate"
+                "lat",
+                "lon"
             ],
             "properties": {
-                "city": {
-                    "type": "string",
-                    "maxLength": 255
-                },
-                "id": {
-                    "type": "string"
+                "lat": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
                 },
-                "registrationDate": {
-                    "type": "string"
+                "lon": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
                 }
             }
         },
-        "pvz.CreateResponse": {
+        "pvz.NearbyResponse": {
             "type": "object",
             "properties": {
-                "city": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
+                "distanceKm": {
+                    "type": "number"
                 },
-                "registrationDate": {
-                    "type": "string"
+                "pvz": {
+                    "$ref": "#/definitions/pvz.PvzResponse"
                 }
             }
         },
@@ -660,6 +2811,9 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/pvz.ReceptionsWithProduct"
                     }
+                },
+                "stockOnHand": {
+                    "type": "integer"
                 }
             }
         },
@@ -672,6 +2826,9 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "issued": {
+                    "type": "boolean"
+                },
                 "receptionId": {
                     "type": "string"
                 },
@@ -683,14 +2840,58 @@ const docTemplate = `{
         "pvz.PvzResponse": {
             "type": "object",
             "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
                 "city": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "location": {
+                    "$ref": "#/definitions/pvz.Location"
+                },
                 "registrationDate": {
                     "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "statusEffectiveAt": {
+                    "type": "string"
+                },
+                "statusReason": {
+                    "type": "string"
+                },
+                "typeCapacities": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "workingHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pvz.WorkingInterval"
+                    }
+                }
+            }
+        },
+        "pvz.ReceptionDetail": {
+            "type": "object",
+            "properties": {
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pvz.ProductsResponse"
+                    }
+                },
+                "reception": {
+                    "$ref": "#/definitions/pvz.ReceptionsResponse"
                 }
             }
         },
@@ -725,6 +2926,74 @@ const docTemplate = `{
                 }
             }
         },
+        "pvz.UpdateRequest": {
+            "type": "object",
+            "required": [
+                "typeCapacities"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "capacity": {
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "location": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pvz.LocationRequest"
+                        }
+                    ],
+                    "x-nullable": true
+                },
+                "typeCapacities": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "workingHours": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "$ref": "#/definitions/pvz.WorkingInterval"
+                    }
+                }
+            }
+        },
+        "pvz.WorkingInterval": {
+            "type": "object",
+            "required": [
+                "close",
+                "day",
+                "open"
+            ],
+            "properties": {
+                "close": {
+                    "type": "string",
+                    "example": "21:00"
+                },
+                "day": {
+                    "type": "string",
+                    "enum": [
+                        "mon",
+                        "tue",
+                        "wed",
+                        "thu",
+                        "fri",
+                        "sat",
+                        "sun"
+                    ],
+                    "example": "mon"
+                },
+                "open": {
+                    "type": "string",
+                    "example": "09:00"
+                }
+            }
+        },
         "reception.CloseLastReceptionResponse": {
             "type": "object",
             "properties": {
@@ -770,6 +3039,40 @@ const docTemplate = `{
                 }
             }
         },
+        "reception.ReceptionResponse": {
+            "type": "object",
+            "properties": {
+                "dateTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pvzID": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "reception.TransitionResponse": {
+            "type": "object",
+            "properties": {
+                "actorId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "fromStatus": {
+                    "type": "string"
+                },
+                "toStatus": {
+                    "type": "string"
+                }
+            }
+        },
         "response.Empty": {
             "type": "object"
         },
@@ -783,6 +3086,109 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "webhook.CreateRequest": {
+            "type": "object",
+            "required": [
+                "eventTypes",
+                "url"
+            ],
+            "properties": {
+                "eventTypes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 16
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048
+                }
+            }
+        },
+        "webhook.CreateResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "webhook.DeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deliveredAt": {
+                    "type": "string"
+                },
+                "eventId": {
+                    "type": "string"
+                },
+                "eventType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "lastStatusCode": {
+                    "type": "integer"
+                },
+                "nextAttemptAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "webhook.SubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -46,9 +46,34 @@ func main() {
 		return
 	}
 
+	runWorker(ctx, c, "outbox relay", appService.OutboxRelay)
+	runWorker(ctx, c, "webhook dispatcher", appService.WebhookDispatcher)
+	runWorker(ctx, c, "event listener", appService.EventListener)
+	runWorker(ctx, c, "token purger", appService.TokenPurger)
+
+	// стримы WatchPVZ сами не заканчиваются, закрываем подписки, чтобы gRPC сервер остановился штатно
+	c.Add(func(ctx context.Context) error {
+		logger.Info("shutting down watch bus")
+		appService.WatchBus.Close()
+		return nil
+	})
+
 	api.NewApi(ctx, c, cfg, appService)
 }
 
+type worker interface {
+	Start(ctx context.Context)
+	Stop(ctx context.Context) error
+}
+
+func runWorker(ctx context.Context, c *closer.Closer, name string, w worker) {
+	w.Start(ctx)
+	c.Add(func(ctx context.Context) error {
+		logger.Info("shutting down " + name)
+		return w.Stop(ctx)
+	})
+}
+
 func connectPostgres(cfg *config.Config, c *closer.Closer) (*pgxpool.Pool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
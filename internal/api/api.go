@@ -12,6 +12,7 @@ import (
 	"github.com/valeragav/avito-pvz-service/internal/config"
 	"github.com/valeragav/avito-pvz-service/pkg/closer"
 	"github.com/valeragav/avito-pvz-service/pkg/logger"
+	"google.golang.org/grpc"
 )
 
 func NewApi(ctx context.Context, c *closer.Closer, cfg *config.Config, appService *app.App) {
@@ -31,7 +32,8 @@ func NewApi(ctx context.Context, c *closer.Closer, cfg *config.Config, appServic
 	gRPCService := "gRPC"
 	runServer(gRPCService, func(ctx context.Context) error {
 		registers := serviceGrpc.CollectRegisters(appService)
-		grpcServer, err := newGrpcServer(cfg, gRPCService, c, registers)
+		opts := serviceGrpc.CollectServerOptions(appService)
+		grpcServer, err := newGrpcServer(cfg, gRPCService, c, registers, opts...)
 		if err != nil {
 			return fmt.Errorf("failed to create gRPC server: %w", err)
 		}
@@ -121,11 +123,11 @@ func newSwaggerServer(cfg *config.Config, name string, c *closer.Closer, router
 	return service
 }
 
-func newGrpcServer(cfg *config.Config, name string, c *closer.Closer, registerFuncs []serviceGrpc.RegisterFunc) (*serviceGrpc.Server, error) {
+func newGrpcServer(cfg *config.Config, name string, c *closer.Closer, registerFuncs []serviceGrpc.RegisterFunc, opts ...grpc.ServerOption) (*serviceGrpc.Server, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	service, err := serviceGrpc.NewServer(ctx, name, cfg.GRPC.Address, registerFuncs)
+	service, err := serviceGrpc.NewServer(ctx, name, cfg.GRPC.Address, registerFuncs, opts...)
 	if err != nil {
 		return nil, err
 	}
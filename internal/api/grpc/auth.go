@@ -0,0 +1,119 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authMetadataKey = "authorization"
+	prefixAuth      = "Bearer "
+
+	// reflection нужен для grpcurl/postman, он не отдаёт данных
+	reflectionPrefix = "/grpc.reflection."
+)
+
+type JwtService interface {
+	ValidateJwt(ctx context.Context, token string) (*domain.UserClaims, error)
+}
+
+// AuthInterceptor - аналог AuthMiddleware + RequireRoles для gRPC.
+// Методы, которых нет в methodRoles, запрещены.
+type AuthInterceptor struct {
+	jwtService  JwtService
+	methodRoles map[string][]domain.Role
+}
+
+func NewAuthInterceptor(jwtService JwtService, methodRoles map[string][]domain.Role) *AuthInterceptor {
+	return &AuthInterceptor{
+		jwtService,
+		methodRoles,
+	}
+}
+
+func (a *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (a *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *AuthInterceptor) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	if strings.HasPrefix(fullMethod, reflectionPrefix) {
+		return ctx, nil
+	}
+
+	roles, ok := a.methodRoles[fullMethod]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "authorization required")
+	}
+
+	// токен без схемы Bearer не принимаем
+	jwtToken, ok := strings.CutPrefix(values[0], prefixAuth)
+	if !ok || jwtToken == "" {
+		return nil, status.Error(codes.Unauthenticated, "invalid token format")
+	}
+
+	claims, err := a.jwtService.ValidateJwt(ctx, jwtToken)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidToken) {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+		logger.ErrorCtx(ctx, "failed to validate token", "error", err)
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if !slices.Contains(roles, claims.Role) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	return context.WithValue(ctx, middleware.ContextClaims{}, *claims), nil
+}
+
+// serverStream подменяет контекст стрима на контекст с ролью пользователя
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// userIDFromContext возвращает id пользователя, положенный интерцептором, или uuid.Nil
+func userIDFromContext(ctx context.Context) uuid.UUID {
+	claims, _ := middleware.ClaimsFromContext(ctx)
+	return claims.UserID
+}
@@ -0,0 +1,230 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testUserID = uuid.New()
+
+type mockJwtService struct {
+	tokens map[string]domain.Role
+}
+
+func (m *mockJwtService) ValidateJwt(ctx context.Context, token string) (*domain.UserClaims, error) {
+	if token == "denylist-down-token" {
+		return nil, errors.New("failed to check token denylist: connection refused")
+	}
+	role, ok := m.tokens[token]
+	if !ok {
+		return nil, fmt.Errorf("%w: token signature is invalid", domain.ErrInvalidToken)
+	}
+	return &domain.UserClaims{UserID: testUserID, Role: role}, nil
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (m *mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+const (
+	testMethodEmployee = "/pvz.v1.PVZService/AddProduct"
+	testMethodAll      = "/pvz.v1.PVZService/ListPVZ"
+)
+
+func newTestAuthInterceptor() *AuthInterceptor {
+	return NewAuthInterceptor(
+		&mockJwtService{tokens: map[string]domain.Role{
+			"employee-token":  domain.EmployeeRole,
+			"moderator-token": domain.ModeratorRole,
+		}},
+		map[string][]domain.Role{
+			testMethodEmployee: {domain.EmployeeRole},
+			testMethodAll:      {domain.EmployeeRole, domain.ModeratorRole},
+		},
+	)
+}
+
+func TestAuthInterceptor_Unary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		method   string
+		md       metadata.MD
+		wantCode codes.Code
+		wantMsg  string
+		wantRole domain.Role
+	}{
+		{
+			name:     "employee allowed",
+			method:   testMethodEmployee,
+			md:       metadata.Pairs("authorization", "Bearer employee-token"),
+			wantCode: codes.OK,
+			wantRole: domain.EmployeeRole,
+		},
+		{
+			name:     "moderator allowed on shared method",
+			method:   testMethodAll,
+			md:       metadata.Pairs("authorization", "Bearer moderator-token"),
+			wantCode: codes.OK,
+			wantRole: domain.ModeratorRole,
+		},
+		{
+			name:     "moderator forbidden",
+			method:   testMethodEmployee,
+			md:       metadata.Pairs("authorization", "Bearer moderator-token"),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "no metadata",
+			method:   testMethodEmployee,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "empty bearer",
+			method:   testMethodEmployee,
+			md:       metadata.Pairs("authorization", "Bearer "),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "token without bearer scheme",
+			method:   testMethodEmployee,
+			md:       metadata.Pairs("authorization", "employee-token"),
+			wantCode: codes.Unauthenticated,
+			wantMsg:  "invalid token format",
+		},
+		{
+			name:     "invalid token",
+			method:   testMethodEmployee,
+			md:       metadata.Pairs("authorization", "Bearer bad"),
+			wantCode: codes.Unauthenticated,
+			wantMsg:  "invalid token",
+		},
+		{
+			name:     "denylist error is internal",
+			method:   testMethodEmployee,
+			md:       metadata.Pairs("authorization", "Bearer denylist-down-token"),
+			wantCode: codes.Internal,
+			wantMsg:  "internal server error",
+		},
+		{
+			name:     "unknown method denied",
+			method:   "/pvz.v1.PVZService/Unknown",
+			md:       metadata.Pairs("authorization", "Bearer employee-token"),
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var gotClaims domain.UserClaims
+			handler := func(ctx context.Context, req any) (any, error) {
+				gotClaims, _ = middleware.ClaimsFromContext(ctx)
+				return "ok", nil
+			}
+
+			resp, err := newTestAuthInterceptor().Unary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				if tt.wantMsg != "" {
+					assert.Equal(t, tt.wantMsg, status.Convert(err).Message())
+				}
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "ok", resp)
+			assert.Equal(t, tt.wantRole, gotClaims.Role)
+			assert.Equal(t, testUserID, gotClaims.UserID)
+		})
+	}
+}
+
+func TestAuthInterceptor_Stream(t *testing.T) {
+	t.Parallel()
+
+	interceptor := newTestAuthInterceptor().Stream()
+
+	t.Run("role available in stream context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer employee-token"))
+
+		var gotClaims domain.UserClaims
+		err := interceptor(nil, &mockServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: testMethodAll}, func(srv any, ss grpc.ServerStream) error {
+			gotClaims, _ = middleware.ClaimsFromContext(ss.Context())
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.EmployeeRole, gotClaims.Role)
+		assert.Equal(t, testUserID, gotClaims.UserID)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+		err := interceptor(nil, &mockServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: testMethodAll}, func(srv any, ss grpc.ServerStream) error {
+			called = true
+			return nil
+		})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.False(t, called)
+	})
+
+	t.Run("reflection is public", func(t *testing.T) {
+		t.Parallel()
+
+		err := interceptor(nil, &mockServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"}, func(srv any, ss grpc.ServerStream) error {
+			return nil
+		})
+
+		assert.NoError(t, err)
+	})
+}
+
+// новый метод без правила в MethodRoles будет недоступен, поэтому проверяем покрытие
+func TestMethodRoles_CoverAllMethods(t *testing.T) {
+	t.Parallel()
+
+	desc := pvz_v1.PVZService_ServiceDesc
+
+	for _, m := range desc.Methods {
+		fullMethod := "/" + desc.ServiceName + "/" + m.MethodName
+		assert.Contains(t, MethodRoles, fullMethod)
+	}
+	for _, s := range desc.Streams {
+		fullMethod := "/" + desc.ServiceName + "/" + s.StreamName
+		assert.Contains(t, MethodRoles, fullMethod)
+	}
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"errors"
+
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mapErrorToGRPC - аналог mapErrorToHTTP из http хендлеров
+func mapErrorToGRPC(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrPVZNotFound),
+		errors.Is(err, domain.ErrCityNotFound),
+		errors.Is(err, domain.ErrReceptionNotFound),
+		errors.Is(err, domain.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, domain.ErrInvalidExportPeriod),
+		errors.Is(err, domain.ErrInvalidPVZLocation),
+		errors.Is(err, domain.ErrInvalidWorkingHours),
+		errors.Is(err, domain.ErrInvalidPVZCapacity),
+		errors.Is(err, domain.ErrInvalidNearbyRadius),
+		errors.Is(err, domain.ErrPVZCursorSort),
+		errors.Is(err, domain.ErrProductTypeNotFound):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	case errors.Is(err, domain.ErrSlowConsumer):
+		return status.Error(codes.ResourceExhausted, err.Error())
+
+	case errors.Is(err, domain.ErrDuplicatePvzID):
+		return status.Error(codes.AlreadyExists, "pvz with this id already exists")
+
+	case errors.Is(err, domain.ErrProductBarcodeDuplicate):
+		return status.Error(codes.AlreadyExists, err.Error())
+
+	case errors.Is(err, domain.ErrNoReceptionIsCurrentlyInProgress),
+		errors.Is(err, domain.ErrPVZNotActive),
+		errors.Is(err, domain.ErrPVZCapacityExceeded),
+		errors.Is(err, domain.ErrProductToDelete),
+		errors.Is(err, domain.ErrProductReceptionClosed),
+		errors.Is(err, domain.ErrProductAlreadyIssued),
+		errors.Is(err, domain.ErrProductHasIssuances),
+		errors.Is(err, domain.ErrProductNotIssued),
+		errors.Is(err, domain.ErrProductNotReceived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
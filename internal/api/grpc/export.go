@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"github.com/google/uuid"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"google.golang.org/grpc/status"
+)
+
+// ExportReceptions отправляет строки выгрузки по мере чтения из базы, как GET /export/receptions.
+func (s *PVZServer) ExportReceptions(req *pvz_v1.ExportReceptionsRequest, stream pvz_v1.PVZService_ExportReceptionsServer) error {
+	ctx := stream.Context()
+
+	exportIn := dto.ReceptionExport{}
+	if req.GetStartDate() != nil {
+		startDate := req.GetStartDate().AsTime()
+		exportIn.StartDate = &startDate
+	}
+	if req.GetEndDate() != nil {
+		endDate := req.GetEndDate().AsTime()
+		exportIn.EndDate = &endDate
+	}
+
+	err := s.receptionUseCase.Export(ctx, exportIn, func(row domain.ReceptionExportRow) error {
+		return stream.Send(exportRowToResponse(row))
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return mapErrorToGRPC(err)
+	}
+
+	return nil
+}
+
+func exportRowToResponse(row domain.ReceptionExportRow) *pvz_v1.ExportReceptionsRow {
+	res := &pvz_v1.ExportReceptionsRow{
+		Reception: receptionToResponse(&domain.Reception{
+			ID:              row.ReceptionID,
+			PvzID:           row.PvzID,
+			DateTime:        row.ReceptionDateTime,
+			ReceptionStatus: &domain.ReceptionStatus{Name: row.Status},
+		}),
+		City: row.City,
+	}
+
+	if row.ProductID != uuid.Nil {
+		res.Product = productToResponse(&domain.Product{
+			ID:          row.ProductID,
+			DateTime:    row.ProductDateTime,
+			ReceptionID: row.ReceptionID,
+			Barcode:     row.Barcode,
+			Issued:      row.Issued,
+			ProductType: &domain.ProductType{Name: row.ProductType},
+		})
+	}
+
+	return res
+}
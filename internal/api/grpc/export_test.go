@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fakeExportStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sent    []*pvz_v1.ExportReceptionsRow
+	sendErr error
+}
+
+func (s *fakeExportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeExportStream) Send(row *pvz_v1.ExportReceptionsRow) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.sent = append(s.sent, row)
+	return nil
+}
+
+func TestExportReceptions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	receptionID := uuid.New()
+	rows := []domain.ReceptionExportRow{
+		{
+			ReceptionID:       receptionID,
+			PvzID:             uuid.New(),
+			City:              "Москва",
+			ReceptionDateTime: now,
+			Status:            domain.ReceptionStatusClose,
+			ProductID:         uuid.New(),
+			ProductDateTime:   now,
+			ProductType:       "обувь",
+			Issued:            true,
+		},
+		{
+			ReceptionID:       uuid.New(),
+			PvzID:             uuid.New(),
+			City:              "Казань",
+			ReceptionDateTime: now,
+			Status:            domain.ReceptionStatusInProgress,
+		},
+	}
+
+	t.Run("rows streamed", func(t *testing.T) {
+		t.Parallel()
+
+		mock := &mockReceptionService{exportRows: rows}
+		stream := &fakeExportStream{ctx: context.Background()}
+		srv := NewPVZServer(nil, mock, nil, nil, nil, nil)
+
+		start := now.Add(-time.Hour)
+		err := srv.ExportReceptions(&pvz_v1.ExportReceptionsRequest{StartDate: timestamppb.New(start)}, stream)
+		require.NoError(t, err)
+
+		require.NotNil(t, mock.gotExportIn.StartDate)
+		assert.Equal(t, start, *mock.gotExportIn.StartDate)
+		assert.Nil(t, mock.gotExportIn.EndDate)
+
+		require.Len(t, stream.sent, 2)
+		assert.Equal(t, receptionID.String(), stream.sent[0].GetReception().GetId())
+		assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED, stream.sent[0].GetReception().GetStatus())
+		assert.Equal(t, "Москва", stream.sent[0].GetCity())
+		assert.Equal(t, "обувь", stream.sent[0].GetProduct().GetType())
+		assert.True(t, stream.sent[0].GetProduct().GetIssued())
+		// приёмка без товаров
+		assert.Nil(t, stream.sent[1].GetProduct())
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(nil, &mockReceptionService{err: domain.ErrInvalidExportPeriod}, nil, nil, nil, nil)
+		err := srv.ExportReceptions(&pvz_v1.ExportReceptionsRequest{}, &fakeExportStream{ctx: context.Background()})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("client gone", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		stream := &fakeExportStream{ctx: ctx, sendErr: errors.New("transport is closing")}
+		srv := NewPVZServer(nil, &mockReceptionService{exportRows: rows}, nil, nil, nil, nil)
+		err := srv.ExportReceptions(&pvz_v1.ExportReceptionsRequest{}, stream)
+
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	})
+
+	t.Run("usecase error hidden", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(nil, &mockReceptionService{err: errors.New("db is down")}, nil, nil, nil, nil)
+		err := srv.ExportReceptions(&pvz_v1.ExportReceptionsRequest{}, &fakeExportStream{ctx: context.Background()})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.NotContains(t, st.Message(), "db is down")
+	})
+}
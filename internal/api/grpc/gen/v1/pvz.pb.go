@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -27,6 +28,8 @@ type ReceptionStatus int32
 const (
 	ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS ReceptionStatus = 0
 	ReceptionStatus_RECEPTION_STATUS_CLOSED      ReceptionStatus = 1
+	ReceptionStatus_RECEPTION_STATUS_CANCELLED   ReceptionStatus = 2
+	ReceptionStatus_RECEPTION_STATUS_REOPENED    ReceptionStatus = 3
 )
 
 // Enum value maps for ReceptionStatus.
@@ -34,10 +37,14 @@ var (
 	ReceptionStatus_name = map[int32]string{
 		0: "RECEPTION_STATUS_IN_PROGRESS",
 		1: "RECEPTION_STATUS_CLOSED",
+		2: "RECEPTION_STATUS_CANCELLED",
+		3: "RECEPTION_STATUS_REOPENED",
 	}
 	ReceptionStatus_value = map[string]int32{
 		"RECEPTION_STATUS_IN_PROGRESS": 0,
 		"RECEPTION_STATUS_CLOSED":      1,
+		"RECEPTION_STATUS_CANCELLED":   2,
+		"RECEPTION_STATUS_REOPENED":    3,
 	}
 )
 
@@ -68,13 +75,67 @@ func (ReceptionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_pvz_proto_rawDescGZIP(), []int{0}
 }
 
+type IssuanceKind int32
+
+const (
+	IssuanceKind_ISSUANCE_KIND_ISSUE  IssuanceKind = 0
+	IssuanceKind_ISSUANCE_KIND_RETURN IssuanceKind = 1
+)
+
+// Enum value maps for IssuanceKind.
+var (
+	IssuanceKind_name = map[int32]string{
+		0: "ISSUANCE_KIND_ISSUE",
+		1: "ISSUANCE_KIND_RETURN",
+	}
+	IssuanceKind_value = map[string]int32{
+		"ISSUANCE_KIND_ISSUE":  0,
+		"ISSUANCE_KIND_RETURN": 1,
+	}
+)
+
+func (x IssuanceKind) Enum() *IssuanceKind {
+	p := new(IssuanceKind)
+	*p = x
+	return p
+}
+
+func (x IssuanceKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssuanceKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_proto_enumTypes[1].Descriptor()
+}
+
+func (IssuanceKind) Type() protoreflect.EnumType {
+	return &file_pvz_proto_enumTypes[1]
+}
+
+func (x IssuanceKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssuanceKind.Descriptor instead.
+func (IssuanceKind) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{1}
+}
+
 type PVZ struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RegistrationDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
 	City             string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Address          string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Location         *GeoPoint              `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	WorkingHours     []*WorkingInterval     `protobuf:"bytes,7,rep,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	// 0 - вместимость без ограничения
+	Capacity int32 `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// лимиты остатка по названию типа товара
+	TypeCapacities map[string]int32 `protobuf:"bytes,9,rep,name=type_capacities,json=typeCapacities,proto3" json:"type_capacities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PVZ) Reset() {
@@ -128,6 +189,313 @@ func (x *PVZ) GetCity() string {
 	return ""
 }
 
+func (x *PVZ) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PVZ) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PVZ) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *PVZ) GetWorkingHours() []*WorkingInterval {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *PVZ) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *PVZ) GetTypeCapacities() map[string]int32 {
+	if x != nil {
+		return x.TypeCapacities
+	}
+	return nil
+}
+
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_pvz_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GeoPoint) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+type WorkingInterval struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Day   string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	// HH:MM, close может быть 24:00
+	Open          string `protobuf:"bytes,2,opt,name=open,proto3" json:"open,omitempty"`
+	Close         string `protobuf:"bytes,3,opt,name=close,proto3" json:"close,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkingInterval) Reset() {
+	*x = WorkingInterval{}
+	mi := &file_pvz_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkingInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingInterval) ProtoMessage() {}
+
+func (x *WorkingInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingInterval.ProtoReflect.Descriptor instead.
+func (*WorkingInterval) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkingInterval) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *WorkingInterval) GetOpen() string {
+	if x != nil {
+		return x.Open
+	}
+	return ""
+}
+
+func (x *WorkingInterval) GetClose() string {
+	if x != nil {
+		return x.Close
+	}
+	return ""
+}
+
+type Reception struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	PvzId         string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        ReceptionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=pvz.v1.ReceptionStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reception) Reset() {
+	*x = Reception{}
+	mi := &file_pvz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reception) ProtoMessage() {}
+
+func (x *Reception) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reception.ProtoReflect.Descriptor instead.
+func (*Reception) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Reception) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reception) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Reception) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *Reception) GetStatus() ReceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,4,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Barcode       string                 `protobuf:"bytes,5,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	Issued        bool                   `protobuf:"varint,6,opt,name=issued,proto3" json:"issued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_pvz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Product) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Product) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *Product) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Product) GetIssued() bool {
+	if x != nil {
+		return x.Issued
+	}
+	return false
+}
+
 type GetPVZListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -136,7 +504,7 @@ type GetPVZListRequest struct {
 
 func (x *GetPVZListRequest) Reset() {
 	*x = GetPVZListRequest{}
-	mi := &file_pvz_proto_msgTypes[1]
+	mi := &file_pvz_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -148,7 +516,7 @@ func (x *GetPVZListRequest) String() string {
 func (*GetPVZListRequest) ProtoMessage() {}
 
 func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_proto_msgTypes[1]
+	mi := &file_pvz_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +529,7 @@ func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
 func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_proto_rawDescGZIP(), []int{1}
+	return file_pvz_proto_rawDescGZIP(), []int{5}
 }
 
 type GetPVZListResponse struct {
@@ -173,7 +541,7 @@ type GetPVZListResponse struct {
 
 func (x *GetPVZListResponse) Reset() {
 	*x = GetPVZListResponse{}
-	mi := &file_pvz_proto_msgTypes[2]
+	mi := &file_pvz_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -185,7 +553,7 @@ func (x *GetPVZListResponse) String() string {
 func (*GetPVZListResponse) ProtoMessage() {}
 
 func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_proto_msgTypes[2]
+	mi := &file_pvz_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +566,7 @@ func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
 func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
-	return file_pvz_proto_rawDescGZIP(), []int{2}
+	return file_pvz_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetPVZListResponse) GetPvzs() []*PVZ {
@@ -208,25 +576,1791 @@ func (x *GetPVZListResponse) GetPvzs() []*PVZ {
 	return nil
 }
 
-var File_pvz_proto protoreflect.FileDescriptor
+type CreatePVZRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	City             string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	RegistrationDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
+	Address          string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Location         *GeoPoint              `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	WorkingHours     []*WorkingInterval     `protobuf:"bytes,6,rep,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	// 0 - вместимость без ограничения
+	Capacity       int32            `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	TypeCapacities map[string]int32 `protobuf:"bytes,8,rep,name=type_capacities,json=typeCapacities,proto3" json:"type_capacities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_pvz_proto_rawDesc = "" +
-	"\n" +
-	"\tpvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"r\n" +
-	"\x03PVZ\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
-	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
-	"\x04city\x18\x03 \x01(\tR\x04city\"\x13\n" +
-	"\x11GetPVZListRequest\"5\n" +
-	"\x12GetPVZListResponse\x12\x1f\n" +
-	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs*P\n" +
-	"\x0fReceptionStatus\x12 \n" +
-	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
-	"\x17RECEPTION_STATUS_CLOSED\x10\x012Q\n" +
-	"\n" +
-	"PVZService\x12C\n" +
+func (x *CreatePVZRequest) Reset() {
+	*x = CreatePVZRequest{}
+	mi := &file_pvz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZRequest) ProtoMessage() {}
+
+func (x *CreatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZRequest.ProtoReflect.Descriptor instead.
+func (*CreatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreatePVZRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetRegistrationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationDate
+	}
+	return nil
+}
+
+func (x *CreatePVZRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreatePVZRequest) GetWorkingHours() []*WorkingInterval {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *CreatePVZRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreatePVZRequest) GetTypeCapacities() map[string]int32 {
+	if x != nil {
+		return x.TypeCapacities
+	}
+	return nil
+}
+
+type CreatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZResponse) Reset() {
+	*x = CreatePVZResponse{}
+	mi := &file_pvz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZResponse) ProtoMessage() {}
+
+func (x *CreatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZResponse.ProtoReflect.Descriptor instead.
+func (*CreatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type ListPVZRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// 0 - значение по умолчанию (первая страница)
+	Page uint32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// 0 - значение по умолчанию
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_cursor из предыдущего ответа, не совместим с page
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// PVZ из любого из перечисленных городов
+	Cities []string `protobuf:"bytes,6,rep,name=cities,proto3" json:"cities,omitempty"`
+	// PVZ, у которых есть приёмка в одном из статусов
+	ReceptionStatuses []ReceptionStatus `protobuf:"varint,7,rep,packed,name=reception_statuses,json=receptionStatuses,proto3,enum=pvz.v1.ReceptionStatus" json:"reception_statuses,omitempty"`
+	// PVZ, у которых есть товар одного из типов
+	ProductTypes []string `protobuf:"bytes,8,rep,name=product_types,json=productTypes,proto3" json:"product_types,omitempty"`
+	// поля через запятую как в HTTP, минус означает desc: "city,-registrationDate"; с cursor только по умолчанию
+	Sort          string `protobuf:"bytes,9,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPVZRequest) Reset() {
+	*x = ListPVZRequest{}
+	mi := &file_pvz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPVZRequest) ProtoMessage() {}
+
+func (x *ListPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPVZRequest.ProtoReflect.Descriptor instead.
+func (*ListPVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPVZRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListPVZRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListPVZRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPVZRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPVZRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPVZRequest) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *ListPVZRequest) GetReceptionStatuses() []ReceptionStatus {
+	if x != nil {
+		return x.ReceptionStatuses
+	}
+	return nil
+}
+
+func (x *ListPVZRequest) GetProductTypes() []string {
+	if x != nil {
+		return x.ProductTypes
+	}
+	return nil
+}
+
+func (x *ListPVZRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ReceptionWithProducts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionWithProducts) Reset() {
+	*x = ReceptionWithProducts{}
+	mi := &file_pvz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionWithProducts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionWithProducts) ProtoMessage() {}
+
+func (x *ReceptionWithProducts) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionWithProducts.ProtoReflect.Descriptor instead.
+func (*ReceptionWithProducts) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReceptionWithProducts) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ReceptionWithProducts) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type PVZWithReceptions struct {
+	state      protoimpl.MessageState   `protogen:"open.v1"`
+	Pvz        *PVZ                     `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Receptions []*ReceptionWithProducts `protobuf:"bytes,2,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	// принятые и ещё не выданные товары
+	StockOnHand   int32 `protobuf:"varint,3,opt,name=stock_on_hand,json=stockOnHand,proto3" json:"stock_on_hand,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZWithReceptions) Reset() {
+	*x = PVZWithReceptions{}
+	mi := &file_pvz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZWithReceptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZWithReceptions) ProtoMessage() {}
+
+func (x *PVZWithReceptions) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZWithReceptions.ProtoReflect.Descriptor instead.
+func (*PVZWithReceptions) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PVZWithReceptions) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *PVZWithReceptions) GetReceptions() []*ReceptionWithProducts {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+func (x *PVZWithReceptions) GetStockOnHand() int32 {
+	if x != nil {
+		return x.StockOnHand
+	}
+	return 0
+}
+
+type ListPVZResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*PVZWithReceptions   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// пустой на последней странице
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPVZResponse) Reset() {
+	*x = ListPVZResponse{}
+	mi := &file_pvz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPVZResponse) ProtoMessage() {}
+
+func (x *ListPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPVZResponse.ProtoReflect.Descriptor instead.
+func (*ListPVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPVZResponse) GetItems() []*PVZWithReceptions {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListPVZResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListNearbyPVZRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Lat   float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon   float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	// 0 - радиус по умолчанию, 5 км
+	RadiusKm float64 `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	// 0 - значение по умолчанию
+	Limit         uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNearbyPVZRequest) Reset() {
+	*x = ListNearbyPVZRequest{}
+	mi := &file_pvz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNearbyPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNearbyPVZRequest) ProtoMessage() {}
+
+func (x *ListNearbyPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNearbyPVZRequest.ProtoReflect.Descriptor instead.
+func (*ListNearbyPVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListNearbyPVZRequest) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *ListNearbyPVZRequest) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *ListNearbyPVZRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *ListNearbyPVZRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type NearbyPVZ struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyPVZ) Reset() {
+	*x = NearbyPVZ{}
+	mi := &file_pvz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyPVZ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyPVZ) ProtoMessage() {}
+
+func (x *NearbyPVZ) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyPVZ.ProtoReflect.Descriptor instead.
+func (*NearbyPVZ) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NearbyPVZ) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *NearbyPVZ) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type ListNearbyPVZResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ближайшие первыми
+	Items         []*NearbyPVZ `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNearbyPVZResponse) Reset() {
+	*x = ListNearbyPVZResponse{}
+	mi := &file_pvz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNearbyPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNearbyPVZResponse) ProtoMessage() {}
+
+func (x *ListNearbyPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNearbyPVZResponse.ProtoReflect.Descriptor instead.
+func (*ListNearbyPVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListNearbyPVZResponse) GetItems() []*NearbyPVZ {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionRequest) Reset() {
+	*x = CreateReceptionRequest{}
+	mi := &file_pvz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionRequest) ProtoMessage() {}
+
+func (x *CreateReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CreateReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionResponse) Reset() {
+	*x = CreateReceptionResponse{}
+	mi := &file_pvz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionResponse) ProtoMessage() {}
+
+func (x *CreateReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type CloseLastReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLastReceptionRequest) Reset() {
+	*x = CloseLastReceptionRequest{}
+	mi := &file_pvz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLastReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLastReceptionRequest) ProtoMessage() {}
+
+func (x *CloseLastReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLastReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CloseLastReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CloseLastReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CloseLastReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLastReceptionResponse) Reset() {
+	*x = CloseLastReceptionResponse{}
+	mi := &file_pvz_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLastReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLastReceptionResponse) ProtoMessage() {}
+
+func (x *CloseLastReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLastReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CloseLastReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CloseLastReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type AddProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Barcode       string                 `protobuf:"bytes,3,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductRequest) Reset() {
+	*x = AddProductRequest{}
+	mi := &file_pvz_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductRequest) ProtoMessage() {}
+
+func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductRequest.ProtoReflect.Descriptor instead.
+func (*AddProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+type AddProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductResponse) Reset() {
+	*x = AddProductResponse{}
+	mi := &file_pvz_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductResponse) ProtoMessage() {}
+
+func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductResponse.ProtoReflect.Descriptor instead.
+func (*AddProductResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type DeleteLastProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductRequest) Reset() {
+	*x = DeleteLastProductRequest{}
+	mi := &file_pvz_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductRequest) ProtoMessage() {}
+
+func (x *DeleteLastProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteLastProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type DeleteLastProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductResponse) Reset() {
+	*x = DeleteLastProductResponse{}
+	mi := &file_pvz_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductResponse) ProtoMessage() {}
+
+func (x *DeleteLastProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteLastProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_pvz_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_pvz_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type Issuance struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PvzId     string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Kind      IssuanceKind           `protobuf:"varint,4,opt,name=kind,proto3,enum=pvz.v1.IssuanceKind" json:"kind,omitempty"`
+	// для возврата id исходной выдачи
+	IssueId       string                 `protobuf:"bytes,5,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Customer      string                 `protobuf:"bytes,6,opt,name=customer,proto3" json:"customer,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Issuance) Reset() {
+	*x = Issuance{}
+	mi := &file_pvz_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Issuance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Issuance) ProtoMessage() {}
+
+func (x *Issuance) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Issuance.ProtoReflect.Descriptor instead.
+func (*Issuance) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Issuance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Issuance) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Issuance) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *Issuance) GetKind() IssuanceKind {
+	if x != nil {
+		return x.Kind
+	}
+	return IssuanceKind_ISSUANCE_KIND_ISSUE
+}
+
+func (x *Issuance) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *Issuance) GetCustomer() string {
+	if x != nil {
+		return x.Customer
+	}
+	return ""
+}
+
+func (x *Issuance) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Issuance) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type IssueProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Customer      string                 `protobuf:"bytes,2,opt,name=customer,proto3" json:"customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueProductRequest) Reset() {
+	*x = IssueProductRequest{}
+	mi := &file_pvz_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueProductRequest) ProtoMessage() {}
+
+func (x *IssueProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueProductRequest.ProtoReflect.Descriptor instead.
+func (*IssueProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *IssueProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *IssueProductRequest) GetCustomer() string {
+	if x != nil {
+		return x.Customer
+	}
+	return ""
+}
+
+type IssueProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuance      *Issuance              `protobuf:"bytes,1,opt,name=issuance,proto3" json:"issuance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueProductResponse) Reset() {
+	*x = IssueProductResponse{}
+	mi := &file_pvz_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueProductResponse) ProtoMessage() {}
+
+func (x *IssueProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueProductResponse.ProtoReflect.Descriptor instead.
+func (*IssueProductResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *IssueProductResponse) GetIssuance() *Issuance {
+	if x != nil {
+		return x.Issuance
+	}
+	return nil
+}
+
+type ReturnProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnProductRequest) Reset() {
+	*x = ReturnProductRequest{}
+	mi := &file_pvz_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnProductRequest) ProtoMessage() {}
+
+func (x *ReturnProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnProductRequest.ProtoReflect.Descriptor instead.
+func (*ReturnProductRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReturnProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReturnProductRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReturnProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuance      *Issuance              `protobuf:"bytes,1,opt,name=issuance,proto3" json:"issuance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnProductResponse) Reset() {
+	*x = ReturnProductResponse{}
+	mi := &file_pvz_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnProductResponse) ProtoMessage() {}
+
+func (x *ReturnProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnProductResponse.ProtoReflect.Descriptor instead.
+func (*ReturnProductResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReturnProductResponse) GetIssuance() *Issuance {
+	if x != nil {
+		return x.Issuance
+	}
+	return nil
+}
+
+// границы периода не обязательны
+type ExportReceptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReceptionsRequest) Reset() {
+	*x = ExportReceptionsRequest{}
+	mi := &file_pvz_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReceptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReceptionsRequest) ProtoMessage() {}
+
+func (x *ExportReceptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReceptionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportReceptionsRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExportReceptionsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ExportReceptionsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+// строка выгрузки: одна на товар, product не заполнен у приёмки без товаров
+type ExportReceptionsRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Product       *Product               `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReceptionsRow) Reset() {
+	*x = ExportReceptionsRow{}
+	mi := &file_pvz_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReceptionsRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReceptionsRow) ProtoMessage() {}
+
+func (x *ExportReceptionsRow) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReceptionsRow.ProtoReflect.Descriptor instead.
+func (*ExportReceptionsRow) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExportReceptionsRow) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ExportReceptionsRow) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ExportReceptionsRow) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// пустой фильтр - события всех PVZ
+type WatchPVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzIds        []string               `protobuf:"bytes,1,rep,name=pvz_ids,json=pvzIds,proto3" json:"pvz_ids,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPVZRequest) Reset() {
+	*x = WatchPVZRequest{}
+	mi := &file_pvz_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPVZRequest) ProtoMessage() {}
+
+func (x *WatchPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPVZRequest.ProtoReflect.Descriptor instead.
+func (*WatchPVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchPVZRequest) GetPvzIds() []string {
+	if x != nil {
+		return x.PvzIds
+	}
+	return nil
+}
+
+func (x *WatchPVZRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+// событие outbox, payload - JSON как в webhook, пустой у слишком больших событий
+type PVZEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	AggregateId   string                 `protobuf:"bytes,3,opt,name=aggregate_id,json=aggregateId,proto3" json:"aggregate_id,omitempty"`
+	PvzId         string                 `protobuf:"bytes,4,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Payload       string                 `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZEvent) Reset() {
+	*x = PVZEvent{}
+	mi := &file_pvz_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZEvent) ProtoMessage() {}
+
+func (x *PVZEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZEvent.ProtoReflect.Descriptor instead.
+func (*PVZEvent) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PVZEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetAggregateId() string {
+	if x != nil {
+		return x.AggregateId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *PVZEvent) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+var File_pvz_proto protoreflect.FileDescriptor
+
+const file_pvz_proto_rawDesc = "" +
+	"\n" +
+	"\tpvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xb9\x03\n" +
+	"\x03PVZ\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
+	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12,\n" +
+	"\blocation\x18\x06 \x01(\v2\x10.pvz.v1.GeoPointR\blocation\x12<\n" +
+	"\rworking_hours\x18\a \x03(\v2\x17.pvz.v1.WorkingIntervalR\fworkingHours\x12\x1a\n" +
+	"\bcapacity\x18\b \x01(\x05R\bcapacity\x12H\n" +
+	"\x0ftype_capacities\x18\t \x03(\v2\x1f.pvz.v1.PVZ.TypeCapacitiesEntryR\x0etypeCapacities\x1aA\n" +
+	"\x13TypeCapacitiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"`\n" +
+	"\bGeoPoint\x12)\n" +
+	"\x03lat\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\x03lat\x12)\n" +
+	"\x03lon\x18\x02 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\x03lon\"\xb7\x01\n" +
+	"\x0fWorkingInterval\x12:\n" +
+	"\x03day\x18\x01 \x01(\tB(\xfaB%r#R\x03monR\x03tueR\x03wedR\x03thuR\x03friR\x03satR\x03sunR\x03day\x122\n" +
+	"\x04open\x18\x02 \x01(\tB\x1e\xfaB\x1br\x192\x17^[0-2][0-9]:[0-5][0-9]$R\x04open\x124\n" +
+	"\x05close\x18\x03 \x01(\tB\x1e\xfaB\x1br\x192\x17^[0-2][0-9]:[0-5][0-9]$R\x05close\"\x9c\x01\n" +
+	"\tReception\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x15\n" +
+	"\x06pvz_id\x18\x03 \x01(\tR\x05pvzId\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.pvz.v1.ReceptionStatusR\x06status\"\xbb\x01\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12!\n" +
+	"\freception_id\x18\x04 \x01(\tR\vreceptionId\x12\x18\n" +
+	"\abarcode\x18\x05 \x01(\tR\abarcode\x12\x16\n" +
+	"\x06issued\x18\x06 \x01(\bR\x06issued\"\x13\n" +
+	"\x11GetPVZListRequest\"5\n" +
+	"\x12GetPVZListResponse\x12\x1f\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs\"\x91\x04\n" +
+	"\x10CreatePVZRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
+	"\x04city\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x04city\x12Q\n" +
+	"\x11registration_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\x10registrationDate\x12\"\n" +
+	"\aaddress\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\aaddress\x12,\n" +
+	"\blocation\x18\x05 \x01(\v2\x10.pvz.v1.GeoPointR\blocation\x12F\n" +
+	"\rworking_hours\x18\x06 \x03(\v2\x17.pvz.v1.WorkingIntervalB\b\xfaB\x05\x92\x01\x02\x102R\fworkingHours\x12#\n" +
+	"\bcapacity\x18\a \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bcapacity\x12n\n" +
+	"\x0ftype_capacities\x18\b \x03(\v2,.pvz.v1.CreatePVZRequest.TypeCapacitiesEntryB\x17\xfaB\x14\x9a\x01\x11\x102\"\ar\x05\x10\x01\x18\xff\x01*\x04\x1a\x02 \x00R\x0etypeCapacities\x1aA\n" +
+	"\x13TypeCapacitiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"2\n" +
+	"\x11CreatePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"\x9d\x03\n" +
+	"\x0eListPVZRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\rR\x04page\x12\x1d\n" +
+	"\x05limit\x18\x04 \x01(\rB\a\xfaB\x04*\x02\x18dR\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12)\n" +
+	"\x06cities\x18\x06 \x03(\tB\x11\xfaB\x0e\x92\x01\v\x10d\"\ar\x05\x10\x01\x18\xff\x01R\x06cities\x12W\n" +
+	"\x12reception_statuses\x18\a \x03(\x0e2\x17.pvz.v1.ReceptionStatusB\x0f\xfaB\f\x92\x01\t\x10\x04\"\x05\x82\x01\x02\x10\x01R\x11receptionStatuses\x126\n" +
+	"\rproduct_types\x18\b \x03(\tB\x11\xfaB\x0e\x92\x01\v\x10d\"\ar\x05\x10\x01\x18\xff\x01R\fproductTypes\x12\x12\n" +
+	"\x04sort\x18\t \x01(\tR\x04sort\"u\n" +
+	"\x15ReceptionWithProducts\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12+\n" +
+	"\bproducts\x18\x02 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"\x95\x01\n" +
+	"\x11PVZWithReceptions\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x12=\n" +
+	"\n" +
+	"receptions\x18\x02 \x03(\v2\x1d.pvz.v1.ReceptionWithProductsR\n" +
+	"receptions\x12\"\n" +
+	"\rstock_on_hand\x18\x03 \x01(\x05R\vstockOnHand\"c\n" +
+	"\x0fListPVZResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.pvz.v1.PVZWithReceptionsR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xc1\x01\n" +
+	"\x14ListNearbyPVZRequest\x12)\n" +
+	"\x03lat\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80V@)\x00\x00\x00\x00\x00\x80V\xc0R\x03lat\x12)\n" +
+	"\x03lon\x18\x02 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x80f@)\x00\x00\x00\x00\x00\x80f\xc0R\x03lon\x124\n" +
+	"\tradius_km\x18\x03 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\bradiusKm\x12\x1d\n" +
+	"\x05limit\x18\x04 \x01(\rB\a\xfaB\x04*\x02\x18dR\x05limit\"K\n" +
+	"\tNearbyPVZ\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\"@\n" +
+	"\x15ListNearbyPVZResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.pvz.v1.NearbyPVZR\x05items\"9\n" +
+	"\x16CreateReceptionRequest\x12\x1f\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x05pvzId\"J\n" +
+	"\x17CreateReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"<\n" +
+	"\x19CloseLastReceptionRequest\x12\x1f\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x05pvzId\"M\n" +
+	"\x1aCloseLastReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"w\n" +
+	"\x11AddProductRequest\x12\x1f\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x05pvzId\x12\x1e\n" +
+	"\x04type\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\x04type\x12!\n" +
+	"\abarcode\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\abarcode\"?\n" +
+	"\x12AddProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\";\n" +
+	"\x18DeleteLastProductRequest\x12\x1f\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\x05pvzId\"F\n" +
+	"\x19DeleteLastProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"?\n" +
+	"\x14DeleteProductRequest\x12'\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tproductId\"B\n" +
+	"\x15DeleteProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"\x84\x02\n" +
+	"\bIssuance\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x15\n" +
+	"\x06pvz_id\x18\x03 \x01(\tR\x05pvzId\x12(\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x14.pvz.v1.IssuanceKindR\x04kind\x12\x19\n" +
+	"\bissue_id\x18\x05 \x01(\tR\aissueId\x12\x1a\n" +
+	"\bcustomer\x18\x06 \x01(\tR\bcustomer\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"f\n" +
+	"\x13IssueProductRequest\x12'\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tproductId\x12&\n" +
+	"\bcustomer\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\bcustomer\"D\n" +
+	"\x14IssueProductResponse\x12,\n" +
+	"\bissuance\x18\x01 \x01(\v2\x10.pvz.v1.IssuanceR\bissuance\"a\n" +
+	"\x14ReturnProductRequest\x12'\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01R\tproductId\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\x06reason\"E\n" +
+	"\x15ReturnProductResponse\x12,\n" +
+	"\bissuance\x18\x01 \x01(\v2\x10.pvz.v1.IssuanceR\bissuance\"\x8b\x01\n" +
+	"\x17ExportReceptionsRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x85\x01\n" +
+	"\x13ExportReceptionsRow\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12)\n" +
+	"\aproduct\x18\x03 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"Y\n" +
+	"\x0fWatchPVZRequest\x12(\n" +
+	"\apvz_ids\x18\x01 \x03(\tB\x0f\xfaB\f\x92\x01\t\x10d\"\x05r\x03\xb0\x01\x01R\x06pvzIds\x12\x1c\n" +
+	"\x04city\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x04city\"\xd3\x01\n" +
+	"\bPVZEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12!\n" +
+	"\faggregate_id\x18\x03 \x01(\tR\vaggregateId\x12\x15\n" +
+	"\x06pvz_id\x18\x04 \x01(\tR\x05pvzId\x12\x12\n" +
+	"\x04city\x18\x05 \x01(\tR\x04city\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x18\n" +
+	"\apayload\x18\a \x01(\tR\apayload*\x8f\x01\n" +
+	"\x0fReceptionStatus\x12 \n" +
+	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
+	"\x17RECEPTION_STATUS_CLOSED\x10\x01\x12\x1e\n" +
+	"\x1aRECEPTION_STATUS_CANCELLED\x10\x02\x12\x1d\n" +
+	"\x19RECEPTION_STATUS_REOPENED\x10\x03*A\n" +
+	"\fIssuanceKind\x12\x17\n" +
+	"\x13ISSUANCE_KIND_ISSUE\x10\x00\x12\x18\n" +
+	"\x14ISSUANCE_KIND_RETURN\x10\x012\xe1\a\n" +
+	"\n" +
+	"PVZService\x12C\n" +
+	"\n" +
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse\x12@\n" +
+	"\tCreatePVZ\x12\x18.pvz.v1.CreatePVZRequest\x1a\x19.pvz.v1.CreatePVZResponse\x12:\n" +
+	"\aListPVZ\x12\x16.pvz.v1.ListPVZRequest\x1a\x17.pvz.v1.ListPVZResponse\x12L\n" +
+	"\rListNearbyPVZ\x12\x1c.pvz.v1.ListNearbyPVZRequest\x1a\x1d.pvz.v1.ListNearbyPVZResponse\x12R\n" +
+	"\x0fCreateReception\x12\x1e.pvz.v1.CreateReceptionRequest\x1a\x1f.pvz.v1.CreateReceptionResponse\x12[\n" +
+	"\x12CloseLastReception\x12!.pvz.v1.CloseLastReceptionRequest\x1a\".pvz.v1.CloseLastReceptionResponse\x12C\n" +
 	"\n" +
-	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponseBDZBgithub.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1;v1b\x06proto3"
+	"AddProduct\x12\x19.pvz.v1.AddProductRequest\x1a\x1a.pvz.v1.AddProductResponse\x12X\n" +
+	"\x11DeleteLastProduct\x12 .pvz.v1.DeleteLastProductRequest\x1a!.pvz.v1.DeleteLastProductResponse\x12L\n" +
+	"\rDeleteProduct\x12\x1c.pvz.v1.DeleteProductRequest\x1a\x1d.pvz.v1.DeleteProductResponse\x12I\n" +
+	"\fIssueProduct\x12\x1b.pvz.v1.IssueProductRequest\x1a\x1c.pvz.v1.IssueProductResponse\x12L\n" +
+	"\rReturnProduct\x12\x1c.pvz.v1.ReturnProductRequest\x1a\x1d.pvz.v1.ReturnProductResponse\x12R\n" +
+	"\x10ExportReceptions\x12\x1f.pvz.v1.ExportReceptionsRequest\x1a\x1b.pvz.v1.ExportReceptionsRow0\x01\x127\n" +
+	"\bWatchPVZ\x12\x17.pvz.v1.WatchPVZRequest\x1a\x10.pvz.v1.PVZEvent0\x01BDZBgithub.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1;v1b\x06proto3"
 
 var (
 	file_pvz_proto_rawDescOnce sync.Once
@@ -240,25 +2374,119 @@ func file_pvz_proto_rawDescGZIP() []byte {
 	return file_pvz_proto_rawDescData
 }
 
-var file_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_pvz_proto_goTypes = []any{
-	(ReceptionStatus)(0),          // 0: pvz.v1.ReceptionStatus
-	(*PVZ)(nil),                   // 1: pvz.v1.PVZ
-	(*GetPVZListRequest)(nil),     // 2: pvz.v1.GetPVZListRequest
-	(*GetPVZListResponse)(nil),    // 3: pvz.v1.GetPVZListResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(ReceptionStatus)(0),               // 0: pvz.v1.ReceptionStatus
+	(IssuanceKind)(0),                  // 1: pvz.v1.IssuanceKind
+	(*PVZ)(nil),                        // 2: pvz.v1.PVZ
+	(*GeoPoint)(nil),                   // 3: pvz.v1.GeoPoint
+	(*WorkingInterval)(nil),            // 4: pvz.v1.WorkingInterval
+	(*Reception)(nil),                  // 5: pvz.v1.Reception
+	(*Product)(nil),                    // 6: pvz.v1.Product
+	(*GetPVZListRequest)(nil),          // 7: pvz.v1.GetPVZListRequest
+	(*GetPVZListResponse)(nil),         // 8: pvz.v1.GetPVZListResponse
+	(*CreatePVZRequest)(nil),           // 9: pvz.v1.CreatePVZRequest
+	(*CreatePVZResponse)(nil),          // 10: pvz.v1.CreatePVZResponse
+	(*ListPVZRequest)(nil),             // 11: pvz.v1.ListPVZRequest
+	(*ReceptionWithProducts)(nil),      // 12: pvz.v1.ReceptionWithProducts
+	(*PVZWithReceptions)(nil),          // 13: pvz.v1.PVZWithReceptions
+	(*ListPVZResponse)(nil),            // 14: pvz.v1.ListPVZResponse
+	(*ListNearbyPVZRequest)(nil),       // 15: pvz.v1.ListNearbyPVZRequest
+	(*NearbyPVZ)(nil),                  // 16: pvz.v1.NearbyPVZ
+	(*ListNearbyPVZResponse)(nil),      // 17: pvz.v1.ListNearbyPVZResponse
+	(*CreateReceptionRequest)(nil),     // 18: pvz.v1.CreateReceptionRequest
+	(*CreateReceptionResponse)(nil),    // 19: pvz.v1.CreateReceptionResponse
+	(*CloseLastReceptionRequest)(nil),  // 20: pvz.v1.CloseLastReceptionRequest
+	(*CloseLastReceptionResponse)(nil), // 21: pvz.v1.CloseLastReceptionResponse
+	(*AddProductRequest)(nil),          // 22: pvz.v1.AddProductRequest
+	(*AddProductResponse)(nil),         // 23: pvz.v1.AddProductResponse
+	(*DeleteLastProductRequest)(nil),   // 24: pvz.v1.DeleteLastProductRequest
+	(*DeleteLastProductResponse)(nil),  // 25: pvz.v1.DeleteLastProductResponse
+	(*DeleteProductRequest)(nil),       // 26: pvz.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),      // 27: pvz.v1.DeleteProductResponse
+	(*Issuance)(nil),                   // 28: pvz.v1.Issuance
+	(*IssueProductRequest)(nil),        // 29: pvz.v1.IssueProductRequest
+	(*IssueProductResponse)(nil),       // 30: pvz.v1.IssueProductResponse
+	(*ReturnProductRequest)(nil),       // 31: pvz.v1.ReturnProductRequest
+	(*ReturnProductResponse)(nil),      // 32: pvz.v1.ReturnProductResponse
+	(*ExportReceptionsRequest)(nil),    // 33: pvz.v1.ExportReceptionsRequest
+	(*ExportReceptionsRow)(nil),        // 34: pvz.v1.ExportReceptionsRow
+	(*WatchPVZRequest)(nil),            // 35: pvz.v1.WatchPVZRequest
+	(*PVZEvent)(nil),                   // 36: pvz.v1.PVZEvent
+	nil,                                // 37: pvz.v1.PVZ.TypeCapacitiesEntry
+	nil,                                // 38: pvz.v1.CreatePVZRequest.TypeCapacitiesEntry
+	(*timestamppb.Timestamp)(nil),      // 39: google.protobuf.Timestamp
 }
 var file_pvz_proto_depIdxs = []int32{
-	4, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
-	1, // 1: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
-	2, // 2: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
-	3, // 3: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	39, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
+	3,  // 1: pvz.v1.PVZ.location:type_name -> pvz.v1.GeoPoint
+	4,  // 2: pvz.v1.PVZ.working_hours:type_name -> pvz.v1.WorkingInterval
+	37, // 3: pvz.v1.PVZ.type_capacities:type_name -> pvz.v1.PVZ.TypeCapacitiesEntry
+	39, // 4: pvz.v1.Reception.date_time:type_name -> google.protobuf.Timestamp
+	0,  // 5: pvz.v1.Reception.status:type_name -> pvz.v1.ReceptionStatus
+	39, // 6: pvz.v1.Product.date_time:type_name -> google.protobuf.Timestamp
+	2,  // 7: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
+	39, // 8: pvz.v1.CreatePVZRequest.registration_date:type_name -> google.protobuf.Timestamp
+	3,  // 9: pvz.v1.CreatePVZRequest.location:type_name -> pvz.v1.GeoPoint
+	4,  // 10: pvz.v1.CreatePVZRequest.working_hours:type_name -> pvz.v1.WorkingInterval
+	38, // 11: pvz.v1.CreatePVZRequest.type_capacities:type_name -> pvz.v1.CreatePVZRequest.TypeCapacitiesEntry
+	2,  // 12: pvz.v1.CreatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	39, // 13: pvz.v1.ListPVZRequest.start_date:type_name -> google.protobuf.Timestamp
+	39, // 14: pvz.v1.ListPVZRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 15: pvz.v1.ListPVZRequest.reception_statuses:type_name -> pvz.v1.ReceptionStatus
+	5,  // 16: pvz.v1.ReceptionWithProducts.reception:type_name -> pvz.v1.Reception
+	6,  // 17: pvz.v1.ReceptionWithProducts.products:type_name -> pvz.v1.Product
+	2,  // 18: pvz.v1.PVZWithReceptions.pvz:type_name -> pvz.v1.PVZ
+	12, // 19: pvz.v1.PVZWithReceptions.receptions:type_name -> pvz.v1.ReceptionWithProducts
+	13, // 20: pvz.v1.ListPVZResponse.items:type_name -> pvz.v1.PVZWithReceptions
+	2,  // 21: pvz.v1.NearbyPVZ.pvz:type_name -> pvz.v1.PVZ
+	16, // 22: pvz.v1.ListNearbyPVZResponse.items:type_name -> pvz.v1.NearbyPVZ
+	5,  // 23: pvz.v1.CreateReceptionResponse.reception:type_name -> pvz.v1.Reception
+	5,  // 24: pvz.v1.CloseLastReceptionResponse.reception:type_name -> pvz.v1.Reception
+	6,  // 25: pvz.v1.AddProductResponse.product:type_name -> pvz.v1.Product
+	6,  // 26: pvz.v1.DeleteLastProductResponse.product:type_name -> pvz.v1.Product
+	6,  // 27: pvz.v1.DeleteProductResponse.product:type_name -> pvz.v1.Product
+	1,  // 28: pvz.v1.Issuance.kind:type_name -> pvz.v1.IssuanceKind
+	39, // 29: pvz.v1.Issuance.created_at:type_name -> google.protobuf.Timestamp
+	28, // 30: pvz.v1.IssueProductResponse.issuance:type_name -> pvz.v1.Issuance
+	28, // 31: pvz.v1.ReturnProductResponse.issuance:type_name -> pvz.v1.Issuance
+	39, // 32: pvz.v1.ExportReceptionsRequest.start_date:type_name -> google.protobuf.Timestamp
+	39, // 33: pvz.v1.ExportReceptionsRequest.end_date:type_name -> google.protobuf.Timestamp
+	5,  // 34: pvz.v1.ExportReceptionsRow.reception:type_name -> pvz.v1.Reception
+	6,  // 35: pvz.v1.ExportReceptionsRow.product:type_name -> pvz.v1.Product
+	39, // 36: pvz.v1.PVZEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7,  // 37: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
+	9,  // 38: pvz.v1.PVZService.CreatePVZ:input_type -> pvz.v1.CreatePVZRequest
+	11, // 39: pvz.v1.PVZService.ListPVZ:input_type -> pvz.v1.ListPVZRequest
+	15, // 40: pvz.v1.PVZService.ListNearbyPVZ:input_type -> pvz.v1.ListNearbyPVZRequest
+	18, // 41: pvz.v1.PVZService.CreateReception:input_type -> pvz.v1.CreateReceptionRequest
+	20, // 42: pvz.v1.PVZService.CloseLastReception:input_type -> pvz.v1.CloseLastReceptionRequest
+	22, // 43: pvz.v1.PVZService.AddProduct:input_type -> pvz.v1.AddProductRequest
+	24, // 44: pvz.v1.PVZService.DeleteLastProduct:input_type -> pvz.v1.DeleteLastProductRequest
+	26, // 45: pvz.v1.PVZService.DeleteProduct:input_type -> pvz.v1.DeleteProductRequest
+	29, // 46: pvz.v1.PVZService.IssueProduct:input_type -> pvz.v1.IssueProductRequest
+	31, // 47: pvz.v1.PVZService.ReturnProduct:input_type -> pvz.v1.ReturnProductRequest
+	33, // 48: pvz.v1.PVZService.ExportReceptions:input_type -> pvz.v1.ExportReceptionsRequest
+	35, // 49: pvz.v1.PVZService.WatchPVZ:input_type -> pvz.v1.WatchPVZRequest
+	8,  // 50: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
+	10, // 51: pvz.v1.PVZService.CreatePVZ:output_type -> pvz.v1.CreatePVZResponse
+	14, // 52: pvz.v1.PVZService.ListPVZ:output_type -> pvz.v1.ListPVZResponse
+	17, // 53: pvz.v1.PVZService.ListNearbyPVZ:output_type -> pvz.v1.ListNearbyPVZResponse
+	19, // 54: pvz.v1.PVZService.CreateReception:output_type -> pvz.v1.CreateReceptionResponse
+	21, // 55: pvz.v1.PVZService.CloseLastReception:output_type -> pvz.v1.CloseLastReceptionResponse
+	23, // 56: pvz.v1.PVZService.AddProduct:output_type -> pvz.v1.AddProductResponse
+	25, // 57: pvz.v1.PVZService.DeleteLastProduct:output_type -> pvz.v1.DeleteLastProductResponse
+	27, // 58: pvz.v1.PVZService.DeleteProduct:output_type -> pvz.v1.DeleteProductResponse
+	30, // 59: pvz.v1.PVZService.IssueProduct:output_type -> pvz.v1.IssueProductResponse
+	32, // 60: pvz.v1.PVZService.ReturnProduct:output_type -> pvz.v1.ReturnProductResponse
+	34, // 61: pvz.v1.PVZService.ExportReceptions:output_type -> pvz.v1.ExportReceptionsRow
+	36, // 62: pvz.v1.PVZService.WatchPVZ:output_type -> pvz.v1.PVZEvent
+	50, // [50:63] is the sub-list for method output_type
+	37, // [37:50] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_pvz_proto_init() }
@@ -271,8 +2499,8 @@ func file_pvz_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pvz_proto_rawDesc), len(file_pvz_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _pvz_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on PVZ with the rules defined in the proto
 // definition for this message. If any rules are violated, the first error
 // encountered is returned, or nil if there are no violations.
@@ -89,6 +92,77 @@ func (m *PVZ) validate(all bool) error {
 
 	// no validation rules for City
 
+	// no validation rules for Status
+
+	// no validation rules for Address
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetWorkingHours() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PVZValidationError{
+						field:  fmt.Sprintf("WorkingHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PVZValidationError{
+						field:  fmt.Sprintf("WorkingHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PVZValidationError{
+					field:  fmt.Sprintf("WorkingHours[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Capacity
+
+	// no validation rules for TypeCapacities
+
 	if len(errors) > 0 {
 		return PVZMultiError(errors)
 	}
@@ -166,42 +240,196 @@ var _ interface {
 	ErrorName() string
 } = PVZValidationError{}
 
-// Validate checks the field values on GetPVZListRequest with the rules defined
+// Validate checks the field values on GeoPoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GeoPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GeoPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GeoPointMultiError, or nil
+// if none found.
+func (m *GeoPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GeoPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLat(); val < -90 || val > 90 {
+		err := GeoPointValidationError{
+			field:  "Lat",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLon(); val < -180 || val > 180 {
+		err := GeoPointValidationError{
+			field:  "Lon",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GeoPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// GeoPointMultiError is an error wrapping multiple validation errors returned
+// by GeoPoint.ValidateAll() if the designated constraints aren't met.
+type GeoPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GeoPointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GeoPointMultiError) AllErrors() []error { return m }
+
+// GeoPointValidationError is the validation error returned by
+// GeoPoint.Validate if the designated constraints aren't met.
+type GeoPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GeoPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GeoPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GeoPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GeoPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GeoPointValidationError) ErrorName() string { return "GeoPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GeoPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGeoPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GeoPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GeoPointValidationError{}
+
+// Validate checks the field values on WorkingInterval with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetPVZListRequest) Validate() error {
+func (m *WorkingInterval) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPVZListRequest with the rules
+// ValidateAll checks the field values on WorkingInterval with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetPVZListRequestMultiError, or nil if none found.
-func (m *GetPVZListRequest) ValidateAll() error {
+// WorkingIntervalMultiError, or nil if none found.
+func (m *WorkingInterval) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPVZListRequest) validate(all bool) error {
+func (m *WorkingInterval) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if _, ok := _WorkingInterval_Day_InLookup[m.GetDay()]; !ok {
+		err := WorkingIntervalValidationError{
+			field:  "Day",
+			reason: "value must be in list [mon tue wed thu fri sat sun]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WorkingInterval_Open_Pattern.MatchString(m.GetOpen()) {
+		err := WorkingIntervalValidationError{
+			field:  "Open",
+			reason: "value does not match regex pattern \"^[0-2][0-9]:[0-5][0-9]$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WorkingInterval_Close_Pattern.MatchString(m.GetClose()) {
+		err := WorkingIntervalValidationError{
+			field:  "Close",
+			reason: "value does not match regex pattern \"^[0-2][0-9]:[0-5][0-9]$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return GetPVZListRequestMultiError(errors)
+		return WorkingIntervalMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPVZListRequestMultiError is an error wrapping multiple validation errors
-// returned by GetPVZListRequest.ValidateAll() if the designated constraints
+// WorkingIntervalMultiError is an error wrapping multiple validation errors
+// returned by WorkingInterval.ValidateAll() if the designated constraints
 // aren't met.
-type GetPVZListRequestMultiError []error
+type WorkingIntervalMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPVZListRequestMultiError) Error() string {
+func (m WorkingIntervalMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -210,11 +438,11 @@ func (m GetPVZListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPVZListRequestMultiError) AllErrors() []error { return m }
+func (m WorkingIntervalMultiError) AllErrors() []error { return m }
 
-// GetPVZListRequestValidationError is the validation error returned by
-// GetPVZListRequest.Validate if the designated constraints aren't met.
-type GetPVZListRequestValidationError struct {
+// WorkingIntervalValidationError is the validation error returned by
+// WorkingInterval.Validate if the designated constraints aren't met.
+type WorkingIntervalValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -222,24 +450,22 @@ type GetPVZListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPVZListRequestValidationError) Field() string { return e.field }
+func (e WorkingIntervalValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPVZListRequestValidationError) Reason() string { return e.reason }
+func (e WorkingIntervalValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPVZListRequestValidationError) Cause() error { return e.cause }
+func (e WorkingIntervalValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPVZListRequestValidationError) Key() bool { return e.key }
+func (e WorkingIntervalValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPVZListRequestValidationError) ErrorName() string {
-	return "GetPVZListRequestValidationError"
-}
+func (e WorkingIntervalValidationError) ErrorName() string { return "WorkingIntervalValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetPVZListRequestValidationError) Error() string {
+func (e WorkingIntervalValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -251,14 +477,14 @@ func (e GetPVZListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPVZListRequest.%s: %s%s",
+		"invalid %sWorkingInterval.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPVZListRequestValidationError{}
+var _ error = WorkingIntervalValidationError{}
 
 var _ interface {
 	Field() string
@@ -266,78 +492,92 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPVZListRequestValidationError{}
+} = WorkingIntervalValidationError{}
 
-// Validate checks the field values on GetPVZListResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetPVZListResponse) Validate() error {
+var _WorkingInterval_Day_InLookup = map[string]struct{}{
+	"mon": {},
+	"tue": {},
+	"wed": {},
+	"thu": {},
+	"fri": {},
+	"sat": {},
+	"sun": {},
+}
+
+var _WorkingInterval_Open_Pattern = regexp.MustCompile("^[0-2][0-9]:[0-5][0-9]$")
+
+var _WorkingInterval_Close_Pattern = regexp.MustCompile("^[0-2][0-9]:[0-5][0-9]$")
+
+// Validate checks the field values on Reception with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Reception) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetPVZListResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetPVZListResponseMultiError, or nil if none found.
-func (m *GetPVZListResponse) ValidateAll() error {
+// ValidateAll checks the field values on Reception with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReceptionMultiError, or nil
+// if none found.
+func (m *Reception) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetPVZListResponse) validate(all bool) error {
+func (m *Reception) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPvzs() {
-		_, _ = idx, item
+	// no validation rules for Id
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetPVZListResponseValidationError{
-						field:  fmt.Sprintf("Pvzs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetPVZListResponseValidationError{
-						field:  fmt.Sprintf("Pvzs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReceptionValidationError{
+					field:  "DateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetPVZListResponseValidationError{
-					field:  fmt.Sprintf("Pvzs[%v]", idx),
+				errors = append(errors, ReceptionValidationError{
+					field:  "DateTime",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceptionValidationError{
+				field:  "DateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
+	// no validation rules for PvzId
+
+	// no validation rules for Status
+
 	if len(errors) > 0 {
-		return GetPVZListResponseMultiError(errors)
+		return ReceptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetPVZListResponseMultiError is an error wrapping multiple validation errors
-// returned by GetPVZListResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetPVZListResponseMultiError []error
+// ReceptionMultiError is an error wrapping multiple validation errors returned
+// by Reception.ValidateAll() if the designated constraints aren't met.
+type ReceptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetPVZListResponseMultiError) Error() string {
+func (m ReceptionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -346,11 +586,11 @@ func (m GetPVZListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetPVZListResponseMultiError) AllErrors() []error { return m }
+func (m ReceptionMultiError) AllErrors() []error { return m }
 
-// GetPVZListResponseValidationError is the validation error returned by
-// GetPVZListResponse.Validate if the designated constraints aren't met.
-type GetPVZListResponseValidationError struct {
+// ReceptionValidationError is the validation error returned by
+// Reception.Validate if the designated constraints aren't met.
+type ReceptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -358,24 +598,22 @@ type GetPVZListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetPVZListResponseValidationError) Field() string { return e.field }
+func (e ReceptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetPVZListResponseValidationError) Reason() string { return e.reason }
+func (e ReceptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetPVZListResponseValidationError) Cause() error { return e.cause }
+func (e ReceptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetPVZListResponseValidationError) Key() bool { return e.key }
+func (e ReceptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetPVZListResponseValidationError) ErrorName() string {
-	return "GetPVZListResponseValidationError"
-}
+func (e ReceptionValidationError) ErrorName() string { return "ReceptionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetPVZListResponseValidationError) Error() string {
+func (e ReceptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -387,14 +625,14 @@ func (e GetPVZListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetPVZListResponse.%s: %s%s",
+		"invalid %sReception.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetPVZListResponseValidationError{}
+var _ error = ReceptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -402,4 +640,4498 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetPVZListResponseValidationError{}
+} = ReceptionValidationError{}
+
+// Validate checks the field values on Product with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Product) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Product with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ProductMultiError, or nil if none found.
+func (m *Product) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Product) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetDateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "DateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProductValidationError{
+					field:  "DateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProductValidationError{
+				field:  "DateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Type
+
+	// no validation rules for ReceptionId
+
+	// no validation rules for Barcode
+
+	// no validation rules for Issued
+
+	if len(errors) > 0 {
+		return ProductMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductMultiError is an error wrapping multiple validation errors returned
+// by Product.ValidateAll() if the designated constraints aren't met.
+type ProductMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductMultiError) AllErrors() []error { return m }
+
+// ProductValidationError is the validation error returned by Product.Validate
+// if the designated constraints aren't met.
+type ProductValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductValidationError) ErrorName() string { return "ProductValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProductValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProduct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductValidationError{}
+
+// Validate checks the field values on GetPVZListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPVZListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPVZListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPVZListRequestMultiError, or nil if none found.
+func (m *GetPVZListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPVZListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetPVZListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPVZListRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPVZListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPVZListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPVZListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPVZListRequestMultiError) AllErrors() []error { return m }
+
+// GetPVZListRequestValidationError is the validation error returned by
+// GetPVZListRequest.Validate if the designated constraints aren't met.
+type GetPVZListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPVZListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPVZListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPVZListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPVZListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPVZListRequestValidationError) ErrorName() string {
+	return "GetPVZListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPVZListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPVZListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPVZListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPVZListRequestValidationError{}
+
+// Validate checks the field values on GetPVZListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPVZListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPVZListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPVZListResponseMultiError, or nil if none found.
+func (m *GetPVZListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPVZListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPvzs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPVZListResponseValidationError{
+						field:  fmt.Sprintf("Pvzs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPVZListResponseValidationError{
+						field:  fmt.Sprintf("Pvzs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPVZListResponseValidationError{
+					field:  fmt.Sprintf("Pvzs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPVZListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPVZListResponseMultiError is an error wrapping multiple validation errors
+// returned by GetPVZListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetPVZListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPVZListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPVZListResponseMultiError) AllErrors() []error { return m }
+
+// GetPVZListResponseValidationError is the validation error returned by
+// GetPVZListResponse.Validate if the designated constraints aren't met.
+type GetPVZListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPVZListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPVZListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPVZListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPVZListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPVZListResponseValidationError) ErrorName() string {
+	return "GetPVZListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPVZListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPVZListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPVZListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPVZListResponseValidationError{}
+
+// Validate checks the field values on CreatePVZRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreatePVZRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePVZRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePVZRequestMultiError, or nil if none found.
+func (m *CreatePVZRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePVZRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = CreatePVZRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCity()); l < 1 || l > 255 {
+		err := CreatePVZRequestValidationError{
+			field:  "City",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRegistrationDate() == nil {
+		err := CreatePVZRequestValidationError{
+			field:  "RegistrationDate",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) > 500 {
+		err := CreatePVZRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePVZRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePVZRequestValidationError{
+					field:  "Location",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePVZRequestValidationError{
+				field:  "Location",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetWorkingHours()) > 50 {
+		err := CreatePVZRequestValidationError{
+			field:  "WorkingHours",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetWorkingHours() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreatePVZRequestValidationError{
+						field:  fmt.Sprintf("WorkingHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreatePVZRequestValidationError{
+						field:  fmt.Sprintf("WorkingHours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreatePVZRequestValidationError{
+					field:  fmt.Sprintf("WorkingHours[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetCapacity() < 0 {
+		err := CreatePVZRequestValidationError{
+			field:  "Capacity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTypeCapacities()) > 50 {
+		err := CreatePVZRequestValidationError{
+			field:  "TypeCapacities",
+			reason: "value must contain no more than 50 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetTypeCapacities()))
+		i := 0
+		for key := range m.GetTypeCapacities() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetTypeCapacities()[key]
+			_ = val
+
+			if l := utf8.RuneCountInString(key); l < 1 || l > 255 {
+				err := CreatePVZRequestValidationError{
+					field:  fmt.Sprintf("TypeCapacities[%v]", key),
+					reason: "value length must be between 1 and 255 runes, inclusive",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if val <= 0 {
+				err := CreatePVZRequestValidationError{
+					field:  fmt.Sprintf("TypeCapacities[%v]", key),
+					reason: "value must be greater than 0",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePVZRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreatePVZRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreatePVZRequestMultiError is an error wrapping multiple validation errors
+// returned by CreatePVZRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePVZRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePVZRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePVZRequestMultiError) AllErrors() []error { return m }
+
+// CreatePVZRequestValidationError is the validation error returned by
+// CreatePVZRequest.Validate if the designated constraints aren't met.
+type CreatePVZRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePVZRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePVZRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePVZRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePVZRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePVZRequestValidationError) ErrorName() string { return "CreatePVZRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreatePVZRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePVZRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePVZRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePVZRequestValidationError{}
+
+// Validate checks the field values on CreatePVZResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreatePVZResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePVZResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePVZResponseMultiError, or nil if none found.
+func (m *CreatePVZResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePVZResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPvz()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePVZResponseValidationError{
+					field:  "Pvz",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePVZResponseValidationError{
+					field:  "Pvz",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPvz()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePVZResponseValidationError{
+				field:  "Pvz",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePVZResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePVZResponseMultiError is an error wrapping multiple validation errors
+// returned by CreatePVZResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreatePVZResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePVZResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePVZResponseMultiError) AllErrors() []error { return m }
+
+// CreatePVZResponseValidationError is the validation error returned by
+// CreatePVZResponse.Validate if the designated constraints aren't met.
+type CreatePVZResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePVZResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePVZResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePVZResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePVZResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePVZResponseValidationError) ErrorName() string {
+	return "CreatePVZResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePVZResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePVZResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePVZResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePVZResponseValidationError{}
+
+// Validate checks the field values on ListPVZRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListPVZRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPVZRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListPVZRequestMultiError,
+// or nil if none found.
+func (m *ListPVZRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPVZRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPVZRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPVZRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPVZRequestValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListPVZRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListPVZRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListPVZRequestValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Page
+
+	if m.GetLimit() > 100 {
+		err := ListPVZRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cursor
+
+	if len(m.GetCities()) > 100 {
+		err := ListPVZRequestValidationError{
+			field:  "Cities",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetCities() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 255 {
+			err := ListPVZRequestValidationError{
+				field:  fmt.Sprintf("Cities[%v]", idx),
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetReceptionStatuses()) > 4 {
+		err := ListPVZRequestValidationError{
+			field:  "ReceptionStatuses",
+			reason: "value must contain no more than 4 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetReceptionStatuses() {
+		_, _ = idx, item
+
+		if _, ok := ReceptionStatus_name[int32(item)]; !ok {
+			err := ListPVZRequestValidationError{
+				field:  fmt.Sprintf("ReceptionStatuses[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetProductTypes()) > 100 {
+		err := ListPVZRequestValidationError{
+			field:  "ProductTypes",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetProductTypes() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 255 {
+			err := ListPVZRequestValidationError{
+				field:  fmt.Sprintf("ProductTypes[%v]", idx),
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return ListPVZRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPVZRequestMultiError is an error wrapping multiple validation errors
+// returned by ListPVZRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListPVZRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPVZRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPVZRequestMultiError) AllErrors() []error { return m }
+
+// ListPVZRequestValidationError is the validation error returned by
+// ListPVZRequest.Validate if the designated constraints aren't met.
+type ListPVZRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPVZRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPVZRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPVZRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPVZRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPVZRequestValidationError) ErrorName() string { return "ListPVZRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListPVZRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPVZRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPVZRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPVZRequestValidationError{}
+
+// Validate checks the field values on ReceptionWithProducts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReceptionWithProducts) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceptionWithProducts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReceptionWithProductsMultiError, or nil if none found.
+func (m *ReceptionWithProducts) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceptionWithProducts) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReception()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReceptionWithProductsValidationError{
+					field:  "Reception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReceptionWithProductsValidationError{
+					field:  "Reception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReception()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceptionWithProductsValidationError{
+				field:  "Reception",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReceptionWithProductsValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReceptionWithProductsValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReceptionWithProductsValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReceptionWithProductsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceptionWithProductsMultiError is an error wrapping multiple validation
+// errors returned by ReceptionWithProducts.ValidateAll() if the designated
+// constraints aren't met.
+type ReceptionWithProductsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceptionWithProductsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceptionWithProductsMultiError) AllErrors() []error { return m }
+
+// ReceptionWithProductsValidationError is the validation error returned by
+// ReceptionWithProducts.Validate if the designated constraints aren't met.
+type ReceptionWithProductsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceptionWithProductsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceptionWithProductsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceptionWithProductsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceptionWithProductsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceptionWithProductsValidationError) ErrorName() string {
+	return "ReceptionWithProductsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReceptionWithProductsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceptionWithProducts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceptionWithProductsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceptionWithProductsValidationError{}
+
+// Validate checks the field values on PVZWithReceptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PVZWithReceptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PVZWithReceptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PVZWithReceptionsMultiError, or nil if none found.
+func (m *PVZWithReceptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PVZWithReceptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPvz()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZWithReceptionsValidationError{
+					field:  "Pvz",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZWithReceptionsValidationError{
+					field:  "Pvz",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPvz()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZWithReceptionsValidationError{
+				field:  "Pvz",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetReceptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PVZWithReceptionsValidationError{
+						field:  fmt.Sprintf("Receptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PVZWithReceptionsValidationError{
+						field:  fmt.Sprintf("Receptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PVZWithReceptionsValidationError{
+					field:  fmt.Sprintf("Receptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for StockOnHand
+
+	if len(errors) > 0 {
+		return PVZWithReceptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PVZWithReceptionsMultiError is an error wrapping multiple validation errors
+// returned by PVZWithReceptions.ValidateAll() if the designated constraints
+// aren't met.
+type PVZWithReceptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PVZWithReceptionsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PVZWithReceptionsMultiError) AllErrors() []error { return m }
+
+// PVZWithReceptionsValidationError is the validation error returned by
+// PVZWithReceptions.Validate if the designated constraints aren't met.
+type PVZWithReceptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PVZWithReceptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PVZWithReceptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PVZWithReceptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PVZWithReceptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PVZWithReceptionsValidationError) ErrorName() string {
+	return "PVZWithReceptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PVZWithReceptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPVZWithReceptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PVZWithReceptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PVZWithReceptionsValidationError{}
+
+// Validate checks the field values on ListPVZResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListPVZResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPVZResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPVZResponseMultiError, or nil if none found.
+func (m *ListPVZResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPVZResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPVZResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPVZResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPVZResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListPVZResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPVZResponseMultiError is an error wrapping multiple validation errors
+// returned by ListPVZResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListPVZResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPVZResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPVZResponseMultiError) AllErrors() []error { return m }
+
+// ListPVZResponseValidationError is the validation error returned by
+// ListPVZResponse.Validate if the designated constraints aren't met.
+type ListPVZResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPVZResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPVZResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPVZResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPVZResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPVZResponseValidationError) ErrorName() string { return "ListPVZResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListPVZResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPVZResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPVZResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPVZResponseValidationError{}
+
+// Validate checks the field values on ListNearbyPVZRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNearbyPVZRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNearbyPVZRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNearbyPVZRequestMultiError, or nil if none found.
+func (m *ListNearbyPVZRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNearbyPVZRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLat(); val < -90 || val > 90 {
+		err := ListNearbyPVZRequestValidationError{
+			field:  "Lat",
+			reason: "value must be inside range [-90, 90]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLon(); val < -180 || val > 180 {
+		err := ListNearbyPVZRequestValidationError{
+			field:  "Lon",
+			reason: "value must be inside range [-180, 180]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetRadiusKm(); val < 0 || val > 100 {
+		err := ListNearbyPVZRequestValidationError{
+			field:  "RadiusKm",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() > 100 {
+		err := ListNearbyPVZRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListNearbyPVZRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNearbyPVZRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNearbyPVZRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNearbyPVZRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNearbyPVZRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNearbyPVZRequestMultiError) AllErrors() []error { return m }
+
+// ListNearbyPVZRequestValidationError is the validation error returned by
+// ListNearbyPVZRequest.Validate if the designated constraints aren't met.
+type ListNearbyPVZRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNearbyPVZRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNearbyPVZRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNearbyPVZRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNearbyPVZRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNearbyPVZRequestValidationError) ErrorName() string {
+	return "ListNearbyPVZRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNearbyPVZRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNearbyPVZRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNearbyPVZRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNearbyPVZRequestValidationError{}
+
+// Validate checks the field values on NearbyPVZ with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NearbyPVZ) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NearbyPVZ with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NearbyPVZMultiError, or nil
+// if none found.
+func (m *NearbyPVZ) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NearbyPVZ) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPvz()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NearbyPVZValidationError{
+					field:  "Pvz",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NearbyPVZValidationError{
+					field:  "Pvz",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPvz()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NearbyPVZValidationError{
+				field:  "Pvz",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DistanceKm
+
+	if len(errors) > 0 {
+		return NearbyPVZMultiError(errors)
+	}
+
+	return nil
+}
+
+// NearbyPVZMultiError is an error wrapping multiple validation errors returned
+// by NearbyPVZ.ValidateAll() if the designated constraints aren't met.
+type NearbyPVZMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NearbyPVZMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NearbyPVZMultiError) AllErrors() []error { return m }
+
+// NearbyPVZValidationError is the validation error returned by
+// NearbyPVZ.Validate if the designated constraints aren't met.
+type NearbyPVZValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NearbyPVZValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NearbyPVZValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NearbyPVZValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NearbyPVZValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NearbyPVZValidationError) ErrorName() string { return "NearbyPVZValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NearbyPVZValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNearbyPVZ.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NearbyPVZValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NearbyPVZValidationError{}
+
+// Validate checks the field values on ListNearbyPVZResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNearbyPVZResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNearbyPVZResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNearbyPVZResponseMultiError, or nil if none found.
+func (m *ListNearbyPVZResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNearbyPVZResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNearbyPVZResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNearbyPVZResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNearbyPVZResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListNearbyPVZResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNearbyPVZResponseMultiError is an error wrapping multiple validation
+// errors returned by ListNearbyPVZResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListNearbyPVZResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNearbyPVZResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNearbyPVZResponseMultiError) AllErrors() []error { return m }
+
+// ListNearbyPVZResponseValidationError is the validation error returned by
+// ListNearbyPVZResponse.Validate if the designated constraints aren't met.
+type ListNearbyPVZResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNearbyPVZResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNearbyPVZResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNearbyPVZResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNearbyPVZResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNearbyPVZResponseValidationError) ErrorName() string {
+	return "ListNearbyPVZResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNearbyPVZResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNearbyPVZResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNearbyPVZResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNearbyPVZResponseValidationError{}
+
+// Validate checks the field values on CreateReceptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReceptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReceptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReceptionRequestMultiError, or nil if none found.
+func (m *CreateReceptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReceptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPvzId()); err != nil {
+		err = CreateReceptionRequestValidationError{
+			field:  "PvzId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateReceptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateReceptionRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateReceptionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReceptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReceptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReceptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReceptionRequestMultiError) AllErrors() []error { return m }
+
+// CreateReceptionRequestValidationError is the validation error returned by
+// CreateReceptionRequest.Validate if the designated constraints aren't met.
+type CreateReceptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReceptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReceptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReceptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReceptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReceptionRequestValidationError) ErrorName() string {
+	return "CreateReceptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReceptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReceptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReceptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReceptionRequestValidationError{}
+
+// Validate checks the field values on CreateReceptionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReceptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReceptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReceptionResponseMultiError, or nil if none found.
+func (m *CreateReceptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReceptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReception()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReceptionResponseValidationError{
+					field:  "Reception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReceptionResponseValidationError{
+					field:  "Reception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReception()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReceptionResponseValidationError{
+				field:  "Reception",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateReceptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReceptionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateReceptionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReceptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReceptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReceptionResponseMultiError) AllErrors() []error { return m }
+
+// CreateReceptionResponseValidationError is the validation error returned by
+// CreateReceptionResponse.Validate if the designated constraints aren't met.
+type CreateReceptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReceptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReceptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReceptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReceptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReceptionResponseValidationError) ErrorName() string {
+	return "CreateReceptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReceptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReceptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReceptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReceptionResponseValidationError{}
+
+// Validate checks the field values on CloseLastReceptionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloseLastReceptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloseLastReceptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloseLastReceptionRequestMultiError, or nil if none found.
+func (m *CloseLastReceptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloseLastReceptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPvzId()); err != nil {
+		err = CloseLastReceptionRequestValidationError{
+			field:  "PvzId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CloseLastReceptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CloseLastReceptionRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CloseLastReceptionRequestMultiError is an error wrapping multiple validation
+// errors returned by CloseLastReceptionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CloseLastReceptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloseLastReceptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloseLastReceptionRequestMultiError) AllErrors() []error { return m }
+
+// CloseLastReceptionRequestValidationError is the validation error returned by
+// CloseLastReceptionRequest.Validate if the designated constraints aren't met.
+type CloseLastReceptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloseLastReceptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloseLastReceptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloseLastReceptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloseLastReceptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloseLastReceptionRequestValidationError) ErrorName() string {
+	return "CloseLastReceptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloseLastReceptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloseLastReceptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloseLastReceptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloseLastReceptionRequestValidationError{}
+
+// Validate checks the field values on CloseLastReceptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloseLastReceptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloseLastReceptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloseLastReceptionResponseMultiError, or nil if none found.
+func (m *CloseLastReceptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloseLastReceptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReception()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloseLastReceptionResponseValidationError{
+					field:  "Reception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloseLastReceptionResponseValidationError{
+					field:  "Reception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReception()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloseLastReceptionResponseValidationError{
+				field:  "Reception",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CloseLastReceptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloseLastReceptionResponseMultiError is an error wrapping multiple
+// validation errors returned by CloseLastReceptionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CloseLastReceptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloseLastReceptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloseLastReceptionResponseMultiError) AllErrors() []error { return m }
+
+// CloseLastReceptionResponseValidationError is the validation error returned
+// by CloseLastReceptionResponse.Validate if the designated constraints aren't met.
+type CloseLastReceptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloseLastReceptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloseLastReceptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloseLastReceptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloseLastReceptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloseLastReceptionResponseValidationError) ErrorName() string {
+	return "CloseLastReceptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloseLastReceptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloseLastReceptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloseLastReceptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloseLastReceptionResponseValidationError{}
+
+// Validate checks the field values on AddProductRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProductRequestMultiError, or nil if none found.
+func (m *AddProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPvzId()); err != nil {
+		err = AddProductRequestValidationError{
+			field:  "PvzId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetType()); l < 1 || l > 255 {
+		err := AddProductRequestValidationError{
+			field:  "Type",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBarcode()) > 64 {
+		err := AddProductRequestValidationError{
+			field:  "Barcode",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddProductRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddProductRequestMultiError is an error wrapping multiple validation errors
+// returned by AddProductRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProductRequestMultiError) AllErrors() []error { return m }
+
+// AddProductRequestValidationError is the validation error returned by
+// AddProductRequest.Validate if the designated constraints aren't met.
+type AddProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProductRequestValidationError) ErrorName() string {
+	return "AddProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProductRequestValidationError{}
+
+// Validate checks the field values on AddProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProductResponseMultiError, or nil if none found.
+func (m *AddProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddProductResponseValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProductResponseMultiError is an error wrapping multiple validation errors
+// returned by AddProductResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AddProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProductResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProductResponseMultiError) AllErrors() []error { return m }
+
+// AddProductResponseValidationError is the validation error returned by
+// AddProductResponse.Validate if the designated constraints aren't met.
+type AddProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProductResponseValidationError) ErrorName() string {
+	return "AddProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProductResponseValidationError{}
+
+// Validate checks the field values on DeleteLastProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteLastProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteLastProductRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteLastProductRequestMultiError, or nil if none found.
+func (m *DeleteLastProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteLastProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPvzId()); err != nil {
+		err = DeleteLastProductRequestValidationError{
+			field:  "PvzId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteLastProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteLastProductRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteLastProductRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteLastProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteLastProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteLastProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteLastProductRequestMultiError) AllErrors() []error { return m }
+
+// DeleteLastProductRequestValidationError is the validation error returned by
+// DeleteLastProductRequest.Validate if the designated constraints aren't met.
+type DeleteLastProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteLastProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteLastProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteLastProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteLastProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteLastProductRequestValidationError) ErrorName() string {
+	return "DeleteLastProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteLastProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteLastProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteLastProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteLastProductRequestValidationError{}
+
+// Validate checks the field values on DeleteLastProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteLastProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteLastProductResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteLastProductResponseMultiError, or nil if none found.
+func (m *DeleteLastProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteLastProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteLastProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteLastProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteLastProductResponseValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteLastProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteLastProductResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteLastProductResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteLastProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteLastProductResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteLastProductResponseMultiError) AllErrors() []error { return m }
+
+// DeleteLastProductResponseValidationError is the validation error returned by
+// DeleteLastProductResponse.Validate if the designated constraints aren't met.
+type DeleteLastProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteLastProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteLastProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteLastProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteLastProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteLastProductResponseValidationError) ErrorName() string {
+	return "DeleteLastProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteLastProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteLastProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteLastProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteLastProductResponseValidationError{}
+
+// Validate checks the field values on DeleteProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProductRequestMultiError, or nil if none found.
+func (m *DeleteProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProductId()); err != nil {
+		err = DeleteProductRequestValidationError{
+			field:  "ProductId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteProductRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteProductRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProductRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProductRequestValidationError is the validation error returned by
+// DeleteProductRequest.Validate if the designated constraints aren't met.
+type DeleteProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProductRequestValidationError) ErrorName() string {
+	return "DeleteProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProductRequestValidationError{}
+
+// Validate checks the field values on DeleteProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProductResponseMultiError, or nil if none found.
+func (m *DeleteProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteProductResponseValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProductResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProductResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProductResponseMultiError) AllErrors() []error { return m }
+
+// DeleteProductResponseValidationError is the validation error returned by
+// DeleteProductResponse.Validate if the designated constraints aren't met.
+type DeleteProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProductResponseValidationError) ErrorName() string {
+	return "DeleteProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProductResponseValidationError{}
+
+// Validate checks the field values on Issuance with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Issuance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Issuance with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IssuanceMultiError, or nil
+// if none found.
+func (m *Issuance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Issuance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProductId
+
+	// no validation rules for PvzId
+
+	// no validation rules for Kind
+
+	// no validation rules for IssueId
+
+	// no validation rules for Customer
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssuanceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssuanceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssuanceValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IssuanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssuanceMultiError is an error wrapping multiple validation errors returned
+// by Issuance.ValidateAll() if the designated constraints aren't met.
+type IssuanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssuanceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssuanceMultiError) AllErrors() []error { return m }
+
+// IssuanceValidationError is the validation error returned by
+// Issuance.Validate if the designated constraints aren't met.
+type IssuanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssuanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssuanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssuanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssuanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssuanceValidationError) ErrorName() string { return "IssuanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssuanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssuance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssuanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssuanceValidationError{}
+
+// Validate checks the field values on IssueProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueProductRequestMultiError, or nil if none found.
+func (m *IssueProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProductId()); err != nil {
+		err = IssueProductRequestValidationError{
+			field:  "ProductId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCustomer()); l < 1 || l > 255 {
+		err := IssueProductRequestValidationError{
+			field:  "Customer",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IssueProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *IssueProductRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// IssueProductRequestMultiError is an error wrapping multiple validation
+// errors returned by IssueProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type IssueProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueProductRequestMultiError) AllErrors() []error { return m }
+
+// IssueProductRequestValidationError is the validation error returned by
+// IssueProductRequest.Validate if the designated constraints aren't met.
+type IssueProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueProductRequestValidationError) ErrorName() string {
+	return "IssueProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueProductRequestValidationError{}
+
+// Validate checks the field values on IssueProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueProductResponseMultiError, or nil if none found.
+func (m *IssueProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetIssuance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueProductResponseValidationError{
+					field:  "Issuance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueProductResponseValidationError{
+					field:  "Issuance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssuance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueProductResponseValidationError{
+				field:  "Issuance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IssueProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueProductResponseMultiError is an error wrapping multiple validation
+// errors returned by IssueProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IssueProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueProductResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueProductResponseMultiError) AllErrors() []error { return m }
+
+// IssueProductResponseValidationError is the validation error returned by
+// IssueProductResponse.Validate if the designated constraints aren't met.
+type IssueProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueProductResponseValidationError) ErrorName() string {
+	return "IssueProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueProductResponseValidationError{}
+
+// Validate checks the field values on ReturnProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnProductRequestMultiError, or nil if none found.
+func (m *ReturnProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetProductId()); err != nil {
+		err = ReturnProductRequestValidationError{
+			field:  "ProductId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 1000 {
+		err := ReturnProductRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReturnProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ReturnProductRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ReturnProductRequestMultiError is an error wrapping multiple validation
+// errors returned by ReturnProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReturnProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnProductRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnProductRequestMultiError) AllErrors() []error { return m }
+
+// ReturnProductRequestValidationError is the validation error returned by
+// ReturnProductRequest.Validate if the designated constraints aren't met.
+type ReturnProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnProductRequestValidationError) ErrorName() string {
+	return "ReturnProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnProductRequestValidationError{}
+
+// Validate checks the field values on ReturnProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnProductResponseMultiError, or nil if none found.
+func (m *ReturnProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetIssuance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnProductResponseValidationError{
+					field:  "Issuance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReturnProductResponseValidationError{
+					field:  "Issuance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssuance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnProductResponseValidationError{
+				field:  "Issuance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReturnProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnProductResponseMultiError is an error wrapping multiple validation
+// errors returned by ReturnProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReturnProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnProductResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnProductResponseMultiError) AllErrors() []error { return m }
+
+// ReturnProductResponseValidationError is the validation error returned by
+// ReturnProductResponse.Validate if the designated constraints aren't met.
+type ReturnProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnProductResponseValidationError) ErrorName() string {
+	return "ReturnProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnProductResponseValidationError{}
+
+// Validate checks the field values on ExportReceptionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportReceptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportReceptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportReceptionsRequestMultiError, or nil if none found.
+func (m *ExportReceptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportReceptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportReceptionsRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportReceptionsRequestValidationError{
+					field:  "StartDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportReceptionsRequestValidationError{
+				field:  "StartDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportReceptionsRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportReceptionsRequestValidationError{
+					field:  "EndDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportReceptionsRequestValidationError{
+				field:  "EndDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportReceptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportReceptionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportReceptionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportReceptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportReceptionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportReceptionsRequestMultiError) AllErrors() []error { return m }
+
+// ExportReceptionsRequestValidationError is the validation error returned by
+// ExportReceptionsRequest.Validate if the designated constraints aren't met.
+type ExportReceptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportReceptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportReceptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportReceptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportReceptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportReceptionsRequestValidationError) ErrorName() string {
+	return "ExportReceptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportReceptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportReceptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportReceptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportReceptionsRequestValidationError{}
+
+// Validate checks the field values on ExportReceptionsRow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportReceptionsRow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportReceptionsRow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportReceptionsRowMultiError, or nil if none found.
+func (m *ExportReceptionsRow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportReceptionsRow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReception()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportReceptionsRowValidationError{
+					field:  "Reception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportReceptionsRowValidationError{
+					field:  "Reception",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReception()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportReceptionsRowValidationError{
+				field:  "Reception",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for City
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportReceptionsRowValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportReceptionsRowValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportReceptionsRowValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportReceptionsRowMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportReceptionsRowMultiError is an error wrapping multiple validation
+// errors returned by ExportReceptionsRow.ValidateAll() if the designated
+// constraints aren't met.
+type ExportReceptionsRowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportReceptionsRowMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportReceptionsRowMultiError) AllErrors() []error { return m }
+
+// ExportReceptionsRowValidationError is the validation error returned by
+// ExportReceptionsRow.Validate if the designated constraints aren't met.
+type ExportReceptionsRowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportReceptionsRowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportReceptionsRowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportReceptionsRowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportReceptionsRowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportReceptionsRowValidationError) ErrorName() string {
+	return "ExportReceptionsRowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportReceptionsRowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportReceptionsRow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportReceptionsRowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportReceptionsRowValidationError{}
+
+// Validate checks the field values on WatchPVZRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchPVZRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchPVZRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchPVZRequestMultiError, or nil if none found.
+func (m *WatchPVZRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchPVZRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetPvzIds()) > 100 {
+		err := WatchPVZRequestValidationError{
+			field:  "PvzIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPvzIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = WatchPVZRequestValidationError{
+				field:  fmt.Sprintf("PvzIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetCity()) > 255 {
+		err := WatchPVZRequestValidationError{
+			field:  "City",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchPVZRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *WatchPVZRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// WatchPVZRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchPVZRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchPVZRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchPVZRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchPVZRequestMultiError) AllErrors() []error { return m }
+
+// WatchPVZRequestValidationError is the validation error returned by
+// WatchPVZRequest.Validate if the designated constraints aren't met.
+type WatchPVZRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchPVZRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchPVZRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchPVZRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchPVZRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchPVZRequestValidationError) ErrorName() string { return "WatchPVZRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatchPVZRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchPVZRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchPVZRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchPVZRequestValidationError{}
+
+// Validate checks the field values on PVZEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PVZEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PVZEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PVZEventMultiError, or nil
+// if none found.
+func (m *PVZEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PVZEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for AggregateId
+
+	// no validation rules for PvzId
+
+	// no validation rules for City
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Payload
+
+	if len(errors) > 0 {
+		return PVZEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// PVZEventMultiError is an error wrapping multiple validation errors returned
+// by PVZEvent.ValidateAll() if the designated constraints aren't met.
+type PVZEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PVZEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PVZEventMultiError) AllErrors() []error { return m }
+
+// PVZEventValidationError is the validation error returned by
+// PVZEvent.Validate if the designated constraints aren't met.
+type PVZEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PVZEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PVZEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PVZEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PVZEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PVZEventValidationError) ErrorName() string { return "PVZEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PVZEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPVZEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PVZEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PVZEventValidationError{}
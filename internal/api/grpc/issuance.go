@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	context "context"
+
+	"github.com/google/uuid"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *PVZServer) IssueProduct(ctx context.Context, req *pvz_v1.IssueProductRequest) (*pvz_v1.IssueProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	issuanceRes, err := s.issuanceUseCase.Issue(ctx, dto.IssuanceCreate{
+		ProductID: uuid.MustParse(req.GetProductId()),
+		Customer:  req.GetCustomer(),
+		IssuedBy:  userIDFromContext(ctx),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+
+	return &pvz_v1.IssueProductResponse{Issuance: issuanceToResponse(issuanceRes)}, nil
+}
+
+func (s *PVZServer) ReturnProduct(ctx context.Context, req *pvz_v1.ReturnProductRequest) (*pvz_v1.ReturnProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	issuanceRes, err := s.issuanceUseCase.Return(ctx, dto.IssuanceReturn{
+		ProductID:  uuid.MustParse(req.GetProductId()),
+		Reason:     req.GetReason(),
+		ReturnedBy: userIDFromContext(ctx),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+
+	return &pvz_v1.ReturnProductResponse{Issuance: issuanceToResponse(issuanceRes)}, nil
+}
+
+func issuanceToResponse(issuance *domain.Issuance) *pvz_v1.Issuance {
+	kind := pvz_v1.IssuanceKind_ISSUANCE_KIND_ISSUE
+	if issuance.Kind == domain.IssuanceKindReturn {
+		kind = pvz_v1.IssuanceKind_ISSUANCE_KIND_RETURN
+	}
+
+	var issueID string
+	if issuance.IssueID != uuid.Nil {
+		issueID = issuance.IssueID.String()
+	}
+
+	return &pvz_v1.Issuance{
+		Id:        issuance.ID.String(),
+		ProductId: issuance.ProductID.String(),
+		PvzId:     issuance.PvzID.String(),
+		Kind:      kind,
+		IssueId:   issueID,
+		Customer:  issuance.Customer,
+		Reason:    issuance.Reason,
+		CreatedAt: timestamppb.New(issuance.CreatedAt),
+	}
+}
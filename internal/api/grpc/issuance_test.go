@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	context "context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockIssuanceService struct {
+	issuance    *domain.Issuance
+	err         error
+	gotIssueIn  dto.IssuanceCreate
+	gotReturnIn dto.IssuanceReturn
+}
+
+func (m *mockIssuanceService) Issue(ctx context.Context, issueIn dto.IssuanceCreate) (*domain.Issuance, error) {
+	m.gotIssueIn = issueIn
+	return m.issuance, m.err
+}
+
+func (m *mockIssuanceService) Return(ctx context.Context, returnIn dto.IssuanceReturn) (*domain.Issuance, error) {
+	m.gotReturnIn = returnIn
+	return m.issuance, m.err
+}
+
+func TestIssueProduct(t *testing.T) {
+	t.Parallel()
+
+	productID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name     string
+		req      *pvz_v1.IssueProductRequest
+		mock     *mockIssuanceService
+		wantCode codes.Code
+		wantIn   dto.IssuanceCreate
+	}{
+		{
+			name:     "success",
+			req:      &pvz_v1.IssueProductRequest{ProductId: productID.String(), Customer: "Ivanov"},
+			mock:     &mockIssuanceService{issuance: &domain.Issuance{ID: uuid.New(), ProductID: productID, Kind: domain.IssuanceKindIssue}},
+			wantCode: codes.OK,
+			wantIn:   dto.IssuanceCreate{ProductID: productID, Customer: "Ivanov", IssuedBy: userID},
+		},
+		{
+			name:     "empty customer",
+			req:      &pvz_v1.IssueProductRequest{ProductId: productID.String()},
+			mock:     &mockIssuanceService{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "product not received",
+			req:      &pvz_v1.IssueProductRequest{ProductId: productID.String(), Customer: "Ivanov"},
+			mock:     &mockIssuanceService{err: domain.ErrProductNotReceived},
+			wantCode: codes.FailedPrecondition,
+			wantIn:   dto.IssuanceCreate{ProductID: productID, Customer: "Ivanov", IssuedBy: userID},
+		},
+		{
+			name:     "product not found",
+			req:      &pvz_v1.IssueProductRequest{ProductId: productID.String(), Customer: "Ivanov"},
+			mock:     &mockIssuanceService{err: domain.ErrProductNotFound},
+			wantCode: codes.NotFound,
+			wantIn:   dto.IssuanceCreate{ProductID: productID, Customer: "Ivanov", IssuedBy: userID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.WithValue(context.Background(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole})
+
+			srv := NewPVZServer(nil, nil, nil, tt.mock, nil, nil)
+			resp, err := srv.IssueProduct(ctx, tt.req)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantIn, tt.mock.gotIssueIn)
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, productID.String(), resp.GetIssuance().GetProductId())
+				assert.Equal(t, pvz_v1.IssuanceKind_ISSUANCE_KIND_ISSUE, resp.GetIssuance().GetKind())
+			}
+		})
+	}
+}
+
+func TestReturnProduct(t *testing.T) {
+	t.Parallel()
+
+	productID := uuid.New()
+	issueID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name     string
+		mock     *mockIssuanceService
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			mock: &mockIssuanceService{issuance: &domain.Issuance{
+				ID:        uuid.New(),
+				ProductID: productID,
+				Kind:      domain.IssuanceKindReturn,
+				IssueID:   issueID,
+			}},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "product not issued",
+			mock:     &mockIssuanceService{err: domain.ErrProductNotIssued},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.WithValue(context.Background(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole})
+
+			srv := NewPVZServer(nil, nil, nil, tt.mock, nil, nil)
+			resp, err := srv.ReturnProduct(ctx, &pvz_v1.ReturnProductRequest{ProductId: productID.String(), Reason: "damaged"})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, dto.IssuanceReturn{ProductID: productID, Reason: "damaged", ReturnedBy: userID}, tt.mock.gotReturnIn)
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, issueID.String(), resp.GetIssuance().GetIssueId())
+				assert.Equal(t, pvz_v1.IssuanceKind_ISSUANCE_KIND_RETURN, resp.GetIssuance().GetKind())
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	context "context"
+
+	"github.com/google/uuid"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/metrics"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *PVZServer) AddProduct(ctx context.Context, req *pvz_v1.AddProductRequest) (*pvz_v1.AddProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	productRes, err := s.productUseCase.Create(ctx, dto.ProductCreate{
+		TypeName:  req.GetType(),
+		Barcode:   req.GetBarcode(),
+		PvzID:     uuid.MustParse(req.GetPvzId()),
+		CreatedBy: userIDFromContext(ctx),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+
+	metrics.CreatedProductsInc()
+
+	return &pvz_v1.AddProductResponse{Product: productToResponse(productRes)}, nil
+}
+
+func (s *PVZServer) DeleteLastProduct(ctx context.Context, req *pvz_v1.DeleteLastProductRequest) (*pvz_v1.DeleteLastProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	productRes, err := s.productUseCase.DeleteLastProduct(ctx, dto.ProductDeleteLast{
+		PvzID:     uuid.MustParse(req.GetPvzId()),
+		DeletedBy: userIDFromContext(ctx),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+
+	return &pvz_v1.DeleteLastProductResponse{Product: productToResponse(productRes)}, nil
+}
+
+func (s *PVZServer) DeleteProduct(ctx context.Context, req *pvz_v1.DeleteProductRequest) (*pvz_v1.DeleteProductResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	productRes, err := s.productUseCase.DeleteProduct(ctx, dto.ProductDelete{
+		ProductID: uuid.MustParse(req.GetProductId()),
+		DeletedBy: userIDFromContext(ctx),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+
+	return &pvz_v1.DeleteProductResponse{Product: productToResponse(productRes)}, nil
+}
+
+func productToResponse(product *domain.Product) *pvz_v1.Product {
+	var typeName string
+	if product.ProductType != nil {
+		typeName = product.ProductType.Name
+	}
+
+	return &pvz_v1.Product{
+		Id:          product.ID.String(),
+		DateTime:    timestamppb.New(product.DateTime),
+		Type:        typeName,
+		ReceptionId: product.ReceptionID.String(),
+		Barcode:     product.Barcode,
+		Issued:      product.Issued,
+	}
+}
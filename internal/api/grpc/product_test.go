@@ -0,0 +1,211 @@
+package grpc
+
+import (
+	context "context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockProductService struct {
+	product     *domain.Product
+	err         error
+	gotDeleteIn dto.ProductDeleteLast
+	gotDelete   dto.ProductDelete
+}
+
+func (m *mockProductService) Create(ctx context.Context, createIn dto.ProductCreate) (*domain.Product, error) {
+	return m.product, m.err
+}
+
+func (m *mockProductService) DeleteLastProduct(ctx context.Context, deleteIn dto.ProductDeleteLast) (*domain.Product, error) {
+	m.gotDeleteIn = deleteIn
+	return m.product, m.err
+}
+
+func (m *mockProductService) DeleteProduct(ctx context.Context, deleteIn dto.ProductDelete) (*domain.Product, error) {
+	m.gotDelete = deleteIn
+	return m.product, m.err
+}
+
+func TestAddProduct(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	product := &domain.Product{
+		ID:          uuid.New(),
+		DateTime:    time.Now(),
+		ReceptionID: uuid.New(),
+		ProductType: &domain.ProductType{Name: "обувь"},
+	}
+
+	tests := []struct {
+		name     string
+		req      *pvz_v1.AddProductRequest
+		mock     *mockProductService
+		wantCode codes.Code
+	}{
+		{
+			name:     "success",
+			req:      &pvz_v1.AddProductRequest{PvzId: pvzID.String(), Type: "обувь"},
+			mock:     &mockProductService{product: product},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "empty type",
+			req:      &pvz_v1.AddProductRequest{PvzId: pvzID.String()},
+			mock:     &mockProductService{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "no reception in progress",
+			req:      &pvz_v1.AddProductRequest{PvzId: pvzID.String(), Type: "обувь"},
+			mock:     &mockProductService{err: domain.ErrNoReceptionIsCurrentlyInProgress},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "unknown product type",
+			req:      &pvz_v1.AddProductRequest{PvzId: pvzID.String(), Type: "мебель"},
+			mock:     &mockProductService{err: domain.ErrProductTypeNotFound},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "barcode already scanned",
+			req:      &pvz_v1.AddProductRequest{PvzId: pvzID.String(), Type: "обувь", Barcode: "4601234567890"},
+			mock:     &mockProductService{err: domain.ErrProductBarcodeDuplicate},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewPVZServer(nil, nil, tt.mock, nil, nil, nil)
+			resp, err := srv.AddProduct(context.Background(), tt.req)
+
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, product.ID.String(), resp.GetProduct().GetId())
+			assert.Equal(t, "обувь", resp.GetProduct().GetType())
+			assert.Equal(t, product.ReceptionID.String(), resp.GetProduct().GetReceptionId())
+		})
+	}
+}
+
+func TestDeleteLastProduct(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name     string
+		mock     *mockProductService
+		wantCode codes.Code
+	}{
+		{
+			name:     "success",
+			mock:     &mockProductService{product: &domain.Product{ID: uuid.New()}},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "no products to delete",
+			mock:     &mockProductService{err: domain.ErrProductToDelete},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "pvz not found",
+			mock:     &mockProductService{err: domain.ErrPVZNotFound},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.WithValue(context.Background(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole})
+
+			srv := NewPVZServer(nil, nil, tt.mock, nil, nil, nil)
+			_, err := srv.DeleteLastProduct(ctx, &pvz_v1.DeleteLastProductRequest{PvzId: pvzID.String()})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, dto.ProductDeleteLast{PvzID: pvzID, DeletedBy: userID}, tt.mock.gotDeleteIn)
+		})
+	}
+}
+
+func TestDeleteProduct(t *testing.T) {
+	t.Parallel()
+
+	productID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name     string
+		req      *pvz_v1.DeleteProductRequest
+		mock     *mockProductService
+		wantCode codes.Code
+		wantIn   dto.ProductDelete
+	}{
+		{
+			name:     "success",
+			req:      &pvz_v1.DeleteProductRequest{ProductId: productID.String()},
+			mock:     &mockProductService{product: &domain.Product{ID: productID}},
+			wantCode: codes.OK,
+			wantIn:   dto.ProductDelete{ProductID: productID, DeletedBy: userID},
+		},
+		{
+			name:     "invalid product id",
+			req:      &pvz_v1.DeleteProductRequest{ProductId: "bad"},
+			mock:     &mockProductService{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "product not found",
+			req:      &pvz_v1.DeleteProductRequest{ProductId: productID.String()},
+			mock:     &mockProductService{err: domain.ErrProductNotFound},
+			wantCode: codes.NotFound,
+			wantIn:   dto.ProductDelete{ProductID: productID, DeletedBy: userID},
+		},
+		{
+			name:     "reception closed",
+			req:      &pvz_v1.DeleteProductRequest{ProductId: productID.String()},
+			mock:     &mockProductService{err: domain.ErrProductReceptionClosed},
+			wantCode: codes.FailedPrecondition,
+			wantIn:   dto.ProductDelete{ProductID: productID, DeletedBy: userID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.WithValue(context.Background(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole})
+
+			srv := NewPVZServer(nil, nil, tt.mock, nil, nil, nil)
+			resp, err := srv.DeleteProduct(ctx, tt.req)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantIn, tt.mock.gotDelete)
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, productID.String(), resp.GetProduct().GetId())
+			}
+		})
+	}
+}
@@ -3,27 +3,65 @@ package grpc
 import (
 	context "context"
 
+	"github.com/google/uuid"
 	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
-
+	"github.com/valeragav/avito-pvz-service/internal/metrics"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/watch"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-type pvzLister interface {
+type pvzService interface {
 	ListOverview(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, error)
+	List(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, *listparams.Cursor, error)
+	Create(ctx context.Context, createIn dto.PVZCreate) (*domain.PVZ, error)
+	ListNearby(ctx context.Context, params dto.PVZNearbyParams) ([]*domain.PVZ, error)
+}
+
+type receptionService interface {
+	Create(ctx context.Context, createIn dto.ReceptionCreate) (*domain.Reception, error)
+	CloseLastReception(ctx context.Context, closeIn dto.ReceptionClose) (*domain.Reception, error)
+	Export(ctx context.Context, exportIn dto.ReceptionExport, fn func(domain.ReceptionExportRow) error) error
+}
+
+type productService interface {
+	Create(ctx context.Context, createIn dto.ProductCreate) (*domain.Product, error)
+	DeleteLastProduct(ctx context.Context, deleteIn dto.ProductDeleteLast) (*domain.Product, error)
+	DeleteProduct(ctx context.Context, deleteIn dto.ProductDelete) (*domain.Product, error)
+}
+
+type issuanceService interface {
+	Issue(ctx context.Context, issueIn dto.IssuanceCreate) (*domain.Issuance, error)
+	Return(ctx context.Context, returnIn dto.IssuanceReturn) (*domain.Issuance, error)
+}
+
+type watchBus interface {
+	Subscribe(filter domain.PVZEventFilter) *watch.Subscription
+	Unsubscribe(sub *watch.Subscription)
 }
 
 type PVZServer struct {
 	pvz_v1.UnimplementedPVZServiceServer
-	pvzUseCase pvzLister
+	pvzUseCase       pvzService
+	receptionUseCase receptionService
+	productUseCase   productService
+	issuanceUseCase  issuanceService
+	cursorCodec      *listparams.CursorCodec
+	watchBus         watchBus
 }
 
-func NewPVZServer(pVZUseCase pvzLister) *PVZServer {
+func NewPVZServer(pvzUseCase pvzService, receptionUseCase receptionService, productUseCase productService, issuanceUseCase issuanceService, cursorCodec *listparams.CursorCodec, watchBus watchBus) *PVZServer {
 	return &PVZServer{
-		pvzUseCase: pVZUseCase,
+		pvzUseCase:       pvzUseCase,
+		receptionUseCase: receptionUseCase,
+		productUseCase:   productUseCase,
+		issuanceUseCase:  issuanceUseCase,
+		cursorCodec:      cursorCodec,
+		watchBus:         watchBus,
 	}
 }
 
@@ -38,20 +76,259 @@ func (s *PVZServer) GetPVZList(ctx context.Context, _ *pvz_v1.GetPVZListRequest)
 	return &pvz_v1.GetPVZListResponse{Pvzs: pvzList}, nil
 }
 
-func pvzListToResponse(pvzs []*domain.PVZ) []*pvz_v1.PVZ {
-	pvzList := make([]*pvz_v1.PVZ, 0, len(pvzs))
+func (s *PVZServer) CreatePVZ(ctx context.Context, req *pvz_v1.CreatePVZRequest) (*pvz_v1.CreatePVZResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pvzRes, err := s.pvzUseCase.Create(ctx, dto.PVZCreate{
+		ID:               uuid.MustParse(req.GetId()),
+		CityName:         req.GetCity(),
+		RegistrationDate: req.GetRegistrationDate().AsTime(),
+		CreatedBy:        userIDFromContext(ctx),
+		Address:          req.GetAddress(),
+		Location:         geoPointFromRequest(req.GetLocation()),
+		WorkingHours:     workingHoursFromRequest(req.GetWorkingHours()),
+		Capacity:         capacityFromRequest(req.GetCapacity()),
+		TypeCapacities:   typeCapacitiesFromRequest(req.GetTypeCapacities()),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+
+	metrics.CreatedPVZInc()
+
+	return &pvz_v1.CreatePVZResponse{Pvz: pvzToResponse(pvzRes)}, nil
+}
+
+func (s *PVZServer) ListPVZ(ctx context.Context, req *pvz_v1.ListPVZRequest) (*pvz_v1.ListPVZResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
+	listParams, err := toPVZListParams(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetCursor() != "" {
+		if req.GetPage() != 0 {
+			return nil, status.Error(codes.InvalidArgument, "cursor and page cannot be used together")
+		}
+
+		cursor, err := s.cursorCodec.Decode(req.GetCursor())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		listParams.Cursor = &cursor
+	}
+
+	pvzs, next, err := s.pvzUseCase.List(ctx, listParams)
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+
+	items := make([]*pvz_v1.PVZWithReceptions, 0, len(pvzs))
 	for _, pvz := range pvzs {
-		var city string
-		if pvz.City != nil {
-			city = pvz.City.Name
+		receptions := make([]*pvz_v1.ReceptionWithProducts, 0, len(pvz.Receptions))
+		for _, reception := range pvz.Receptions {
+			products := make([]*pvz_v1.Product, 0, len(reception.Products))
+			for _, product := range reception.Products {
+				products = append(products, productToResponse(product))
+			}
+
+			receptions = append(receptions, &pvz_v1.ReceptionWithProducts{
+				Reception: receptionToResponse(reception),
+				Products:  products,
+			})
+		}
+
+		items = append(items, &pvz_v1.PVZWithReceptions{
+			Pvz:         pvzToResponse(pvz),
+			Receptions:  receptions,
+			StockOnHand: int32(pvz.StockOnHand),
+		})
+	}
+
+	resp := &pvz_v1.ListPVZResponse{Items: items}
+	if next != nil {
+		resp.NextCursor = s.cursorCodec.Encode(*next)
+	}
+
+	return resp, nil
+}
+
+func (s *PVZServer) ListNearbyPVZ(ctx context.Context, req *pvz_v1.ListNearbyPVZRequest) (*pvz_v1.ListNearbyPVZResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pvzs, err := s.pvzUseCase.ListNearby(ctx, dto.PVZNearbyParams{
+		Point:    domain.GeoPoint{Lat: req.GetLat(), Lon: req.GetLon()},
+		RadiusKm: req.GetRadiusKm(),
+		Limit:    uint(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+
+	items := make([]*pvz_v1.NearbyPVZ, 0, len(pvzs))
+	for _, pvz := range pvzs {
+		items = append(items, &pvz_v1.NearbyPVZ{
+			Pvz:        pvzToResponse(pvz),
+			DistanceKm: pvz.DistanceKm,
+		})
+	}
+
+	return &pvz_v1.ListNearbyPVZResponse{Items: items}, nil
+}
+
+func toPVZListParams(req *pvz_v1.ListPVZRequest) (*dto.PVZListParams, error) {
+	pagination := listparams.Pagination{
+		Page:  uint(req.GetPage()),
+		Limit: uint(req.GetLimit()),
+	}
+	if pagination.Page == 0 {
+		pagination.Page = 1
+	}
+	if pagination.Limit == 0 {
+		pagination.Limit = listparams.DefaultLimit
+	}
+
+	filter := &dto.PVZFilter{}
+	if req.GetStartDate() != nil {
+		startDate := req.GetStartDate().AsTime()
+		filter.StartDate = &startDate
+	}
+	if req.GetEndDate() != nil {
+		endDate := req.GetEndDate().AsTime()
+		filter.EndDate = &endDate
+	}
+	filter.Cities = req.GetCities()
+	filter.ProductTypes = req.GetProductTypes()
+	for _, st := range req.GetReceptionStatuses() {
+		filter.ReceptionStatuses = append(filter.ReceptionStatuses, receptionStatusFromProto(st))
+	}
+
+	var sorts []listparams.Sort
+	if req.GetSort() != "" {
+		var err error
+		sorts, err = listparams.ParseSortList(req.GetSort(), domain.PVZSortFields)
+		if err != nil {
+			return nil, err
 		}
-		pvzList = append(pvzList, &pvz_v1.PVZ{
-			Id:               pvz.ID.String(),
-			RegistrationDate: timestamppb.New(pvz.RegistrationDate),
-			City:             city,
+	}
+
+	return &dto.PVZListParams{
+		Filter:     filter,
+		Sort:       sorts,
+		Pagination: &pagination,
+	}, nil
+}
+
+func pvzToResponse(pvz *domain.PVZ) *pvz_v1.PVZ {
+	var city string
+	if pvz.City != nil {
+		city = pvz.City.Name
+	}
+
+	return &pvz_v1.PVZ{
+		Id:               pvz.ID.String(),
+		RegistrationDate: timestamppb.New(pvz.RegistrationDate),
+		City:             city,
+		Status:           string(pvz.Status),
+		Address:          pvz.Address,
+		Location:         geoPointToResponse(pvz.Location),
+		WorkingHours:     workingHoursToResponse(pvz.WorkingHours),
+		Capacity:         capacityToResponse(pvz.Capacity),
+		TypeCapacities:   typeCapacitiesToResponse(pvz.TypeCapacities),
+	}
+}
+
+func geoPointFromRequest(point *pvz_v1.GeoPoint) *domain.GeoPoint {
+	if point == nil {
+		return nil
+	}
+	return &domain.GeoPoint{Lat: point.GetLat(), Lon: point.GetLon()}
+}
+
+func geoPointToResponse(point *domain.GeoPoint) *pvz_v1.GeoPoint {
+	if point == nil {
+		return nil
+	}
+	return &pvz_v1.GeoPoint{Lat: point.Lat, Lon: point.Lon}
+}
+
+func workingHoursFromRequest(intervals []*pvz_v1.WorkingInterval) domain.WorkingHours {
+	if len(intervals) == 0 {
+		return nil
+	}
+
+	res := make(domain.WorkingHours, 0, len(intervals))
+	for _, interval := range intervals {
+		res = append(res, domain.WorkingInterval{
+			Day:   domain.Weekday(interval.GetDay()),
+			Open:  interval.GetOpen(),
+			Close: interval.GetClose(),
 		})
 	}
+	return res
+}
+
+func workingHoursToResponse(hours domain.WorkingHours) []*pvz_v1.WorkingInterval {
+	res := make([]*pvz_v1.WorkingInterval, 0, len(hours))
+	for _, interval := range hours {
+		res = append(res, &pvz_v1.WorkingInterval{
+			Day:   string(interval.Day),
+			Open:  interval.Open,
+			Close: interval.Close,
+		})
+	}
+	return res
+}
+
+// capacityFromRequest 0 в proto означает вместимость без ограничения
+func capacityFromRequest(capacity int32) *int {
+	if capacity == 0 {
+		return nil
+	}
+	c := int(capacity)
+	return &c
+}
+
+func capacityToResponse(capacity *int) int32 {
+	if capacity == nil {
+		return 0
+	}
+	return int32(*capacity)
+}
+
+func typeCapacitiesFromRequest(capacities map[string]int32) map[string]int {
+	if len(capacities) == 0 {
+		return nil
+	}
+
+	res := make(map[string]int, len(capacities))
+	for typeName, capacity := range capacities {
+		res[typeName] = int(capacity)
+	}
+	return res
+}
+
+func typeCapacitiesToResponse(capacities map[string]int) map[string]int32 {
+	res := make(map[string]int32, len(capacities))
+	for typeName, capacity := range capacities {
+		res[typeName] = int32(capacity)
+	}
+	return res
+}
+
+func pvzListToResponse(pvzs []*domain.PVZ) []*pvz_v1.PVZ {
+	pvzList := make([]*pvz_v1.PVZ, 0, len(pvzs))
+
+	for _, pvz := range pvzs {
+		pvzList = append(pvzList, pvzToResponse(pvz))
+	}
 
 	return pvzList
 }
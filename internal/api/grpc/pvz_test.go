@@ -12,19 +12,48 @@ import (
 	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type mockPVZLister struct {
 	pvzs []*domain.PVZ
+	next *listparams.Cursor
 	err  error
+
+	gotListParams *dto.PVZListParams
+	gotCreate     dto.PVZCreate
+	gotNearby     dto.PVZNearbyParams
 }
 
 func (m *mockPVZLister) ListOverview(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, error) {
 	return m.pvzs, m.err
 }
 
+func (m *mockPVZLister) List(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, *listparams.Cursor, error) {
+	m.gotListParams = pvzListParams
+	return m.pvzs, m.next, m.err
+}
+
+func (m *mockPVZLister) Create(ctx context.Context, createIn dto.PVZCreate) (*domain.PVZ, error) {
+	m.gotCreate = createIn
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &domain.PVZ{
+		ID:               createIn.ID,
+		RegistrationDate: createIn.RegistrationDate,
+		City:             &domain.City{Name: createIn.CityName},
+	}, nil
+}
+
+func (m *mockPVZLister) ListNearby(ctx context.Context, params dto.PVZNearbyParams) ([]*domain.PVZ, error) {
+	m.gotNearby = params
+	return m.pvzs, m.err
+}
+
 func TestGetPVZList(t *testing.T) {
 	t.Parallel()
 
@@ -80,7 +109,7 @@ func TestGetPVZList(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			srv := NewPVZServer(tt.mock)
+			srv := NewPVZServer(tt.mock, nil, nil, nil, nil, nil)
 			resp, err := srv.GetPVZList(context.Background(), &pvz_v1.GetPVZListRequest{})
 
 			if tt.wantErr {
@@ -122,7 +151,7 @@ func TestGetPVZList_FieldMapping(t *testing.T) {
 		},
 	}
 
-	srv := NewPVZServer(mock)
+	srv := NewPVZServer(mock, nil, nil, nil, nil, nil)
 	resp, err := srv.GetPVZList(context.Background(), &pvz_v1.GetPVZListRequest{})
 
 	require.NoError(t, err)
@@ -149,7 +178,7 @@ func TestGetPVZList_PreservesOrder(t *testing.T) {
 		}
 	}
 
-	srv := NewPVZServer(&mockPVZLister{pvzs: pvzs})
+	srv := NewPVZServer(&mockPVZLister{pvzs: pvzs}, nil, nil, nil, nil, nil)
 	resp, err := srv.GetPVZList(context.Background(), &pvz_v1.GetPVZListRequest{})
 
 	require.NoError(t, err)
@@ -166,7 +195,7 @@ func TestGetPVZList_ErrorMessagePropagated(t *testing.T) {
 	const errMsg = "connection refused"
 	mock := &mockPVZLister{err: errors.New(errMsg)}
 
-	srv := NewPVZServer(mock)
+	srv := NewPVZServer(mock, nil, nil, nil, nil, nil)
 	_, err := srv.GetPVZList(context.Background(), &pvz_v1.GetPVZListRequest{})
 
 	require.Error(t, err)
@@ -182,7 +211,7 @@ func TestGetPVZList_CancelledContext(t *testing.T) {
 
 	mock := &mockPVZLister{err: context.Canceled}
 
-	srv := NewPVZServer(mock)
+	srv := NewPVZServer(mock, nil, nil, nil, nil, nil)
 	_, err := srv.GetPVZList(ctx, &pvz_v1.GetPVZListRequest{})
 
 	require.Error(t, err)
@@ -291,3 +320,410 @@ func TestPvzListToResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatePVZ(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("33333333-3333-3333-3333-333333333333")
+	regDate := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		req      *pvz_v1.CreatePVZRequest
+		mock     *mockPVZLister
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			req: &pvz_v1.CreatePVZRequest{
+				Id:               id.String(),
+				City:             "Москва",
+				RegistrationDate: timestamppb.New(regDate),
+				Address:          "ул. Тверская, 1",
+				Location:         &pvz_v1.GeoPoint{Lat: 55.7558, Lon: 37.6173},
+				WorkingHours:     []*pvz_v1.WorkingInterval{{Day: "mon", Open: "09:00", Close: "21:00"}},
+				Capacity:         500,
+			},
+			mock:     &mockPVZLister{},
+			wantCode: codes.OK,
+		},
+		{
+			name: "success without profile",
+			req: &pvz_v1.CreatePVZRequest{
+				Id:               id.String(),
+				City:             "Москва",
+				RegistrationDate: timestamppb.New(regDate),
+			},
+			mock:     &mockPVZLister{},
+			wantCode: codes.OK,
+		},
+		{
+			name: "latitude out of range",
+			req: &pvz_v1.CreatePVZRequest{
+				Id:               id.String(),
+				City:             "Москва",
+				RegistrationDate: timestamppb.New(regDate),
+				Location:         &pvz_v1.GeoPoint{Lat: -91, Lon: 37.6173},
+			},
+			mock:     &mockPVZLister{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "malformed working hours",
+			req: &pvz_v1.CreatePVZRequest{
+				Id:               id.String(),
+				City:             "Москва",
+				RegistrationDate: timestamppb.New(regDate),
+				WorkingHours:     []*pvz_v1.WorkingInterval{{Day: "mon", Open: "9am", Close: "21:00"}},
+			},
+			mock:     &mockPVZLister{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "overlapping working hours",
+			req: &pvz_v1.CreatePVZRequest{
+				Id:               id.String(),
+				City:             "Москва",
+				RegistrationDate: timestamppb.New(regDate),
+				WorkingHours: []*pvz_v1.WorkingInterval{
+					{Day: "mon", Open: "09:00", Close: "18:00"},
+					{Day: "mon", Open: "12:00", Close: "20:00"},
+				},
+			},
+			mock:     &mockPVZLister{err: domain.ErrInvalidWorkingHours},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid id",
+			req: &pvz_v1.CreatePVZRequest{
+				Id:               "not-uuid",
+				City:             "Москва",
+				RegistrationDate: timestamppb.New(regDate),
+			},
+			mock:     &mockPVZLister{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "missing registration date",
+			req: &pvz_v1.CreatePVZRequest{
+				Id:   id.String(),
+				City: "Москва",
+			},
+			mock:     &mockPVZLister{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "city not found",
+			req: &pvz_v1.CreatePVZRequest{
+				Id:               id.String(),
+				City:             "Тверь",
+				RegistrationDate: timestamppb.New(regDate),
+			},
+			mock:     &mockPVZLister{err: domain.ErrCityNotFound},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "duplicate id",
+			req: &pvz_v1.CreatePVZRequest{
+				Id:               id.String(),
+				City:             "Москва",
+				RegistrationDate: timestamppb.New(regDate),
+			},
+			mock:     &mockPVZLister{err: domain.ErrDuplicatePvzID},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := NewPVZServer(tt.mock, nil, nil, nil, nil, nil)
+			resp, err := srv.CreatePVZ(context.Background(), tt.req)
+
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, id.String(), resp.GetPvz().GetId())
+			assert.Equal(t, "Москва", resp.GetPvz().GetCity())
+			assert.Equal(t, regDate, tt.mock.gotCreate.RegistrationDate)
+			assert.Equal(t, tt.req.GetAddress(), tt.mock.gotCreate.Address)
+			assert.Equal(t, geoPointFromRequest(tt.req.GetLocation()), tt.mock.gotCreate.Location)
+			assert.Len(t, tt.mock.gotCreate.WorkingHours, len(tt.req.GetWorkingHours()))
+			if tt.req.GetCapacity() == 0 {
+				assert.Nil(t, tt.mock.gotCreate.Capacity)
+			} else {
+				require.NotNil(t, tt.mock.gotCreate.Capacity)
+				assert.Equal(t, int(tt.req.GetCapacity()), *tt.mock.gotCreate.Capacity)
+			}
+		})
+	}
+}
+
+func TestListPVZ(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	productID := uuid.New()
+	now := time.Now().UTC()
+
+	t.Run("nested receptions and products", func(t *testing.T) {
+		t.Parallel()
+
+		mock := &mockPVZLister{
+			pvzs: []*domain.PVZ{
+				{
+					ID:               pvzID,
+					RegistrationDate: now,
+					City:             &domain.City{Name: "Казань"},
+					Receptions: []*domain.Reception{
+						{
+							ID:              receptionID,
+							PvzID:           pvzID,
+							DateTime:        now,
+							ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusClose},
+							Products: []*domain.Product{
+								{
+									ID:          productID,
+									DateTime:    now,
+									ReceptionID: receptionID,
+									ProductType: &domain.ProductType{Name: "электроника"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		srv := NewPVZServer(mock, nil, nil, nil, nil, nil)
+		resp, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{})
+
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 1)
+
+		item := resp.GetItems()[0]
+		assert.Equal(t, pvzID.String(), item.GetPvz().GetId())
+		require.Len(t, item.GetReceptions(), 1)
+		assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED, item.GetReceptions()[0].GetReception().GetStatus())
+		require.Len(t, item.GetReceptions()[0].GetProducts(), 1)
+		assert.Equal(t, "электроника", item.GetReceptions()[0].GetProducts()[0].GetType())
+
+		// значения пагинации по умолчанию
+		require.NotNil(t, mock.gotListParams)
+		assert.Equal(t, uint(1), mock.gotListParams.Pagination.Page)
+		assert.Equal(t, uint(listparams.DefaultLimit), mock.gotListParams.Pagination.Limit)
+		assert.Nil(t, mock.gotListParams.Filter.StartDate)
+	})
+
+	t.Run("filter and pagination passed to usecase", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+		mock := &mockPVZLister{}
+		srv := NewPVZServer(mock, nil, nil, nil, nil, nil)
+		_, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{
+			StartDate: timestamppb.New(start),
+			EndDate:   timestamppb.New(end),
+			Page:      3,
+			Limit:     10,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(3), mock.gotListParams.Pagination.Page)
+		assert.Equal(t, uint(10), mock.gotListParams.Pagination.Limit)
+		assert.Equal(t, start, *mock.gotListParams.Filter.StartDate)
+		assert.Equal(t, end, *mock.gotListParams.Filter.EndDate)
+	})
+
+	t.Run("list filters and sort passed to usecase", func(t *testing.T) {
+		t.Parallel()
+
+		mock := &mockPVZLister{}
+		srv := NewPVZServer(mock, nil, nil, nil, nil, nil)
+		_, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{
+			Cities: []string{"Москва", "Казань"},
+			ReceptionStatuses: []pvz_v1.ReceptionStatus{
+				pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS,
+				pvz_v1.ReceptionStatus_RECEPTION_STATUS_REOPENED,
+			},
+			ProductTypes: []string{"обувь"},
+			Sort:         "city,-registrationDate",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Москва", "Казань"}, mock.gotListParams.Filter.Cities)
+		assert.Equal(t,
+			[]domain.ReceptionStatusCode{domain.ReceptionStatusInProgress, domain.ReceptionStatusReopened},
+			mock.gotListParams.Filter.ReceptionStatuses,
+		)
+		assert.Equal(t, []string{"обувь"}, mock.gotListParams.Filter.ProductTypes)
+		assert.Equal(t, []listparams.Sort{
+			{Field: domain.PVZSortCity, Order: listparams.SortAsc},
+			{Field: domain.PVZSortRegistrationDate, Order: listparams.SortDesc},
+		}, mock.gotListParams.Sort)
+	})
+
+	t.Run("invalid sort field", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(&mockPVZLister{}, nil, nil, nil, nil, nil)
+		_, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{Sort: "address"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("undefined reception status", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(&mockPVZLister{}, nil, nil, nil, nil, nil)
+		_, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{
+			ReceptionStatuses: []pvz_v1.ReceptionStatus{42},
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("cursor round trip", func(t *testing.T) {
+		t.Parallel()
+
+		codec := listparams.NewCursorCodec([]byte("secret"))
+		next := listparams.Cursor{Time: now, ID: pvzID}
+
+		mock := &mockPVZLister{next: &next}
+		srv := NewPVZServer(mock, nil, nil, nil, codec, nil)
+		resp, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{Cursor: codec.Encode(next)})
+
+		require.NoError(t, err)
+		require.NotNil(t, mock.gotListParams.Cursor)
+		assert.True(t, next.Time.Equal(mock.gotListParams.Cursor.Time))
+		assert.Equal(t, pvzID, mock.gotListParams.Cursor.ID)
+
+		decoded, err := codec.Decode(resp.GetNextCursor())
+		require.NoError(t, err)
+		assert.Equal(t, pvzID, decoded.ID)
+	})
+
+	t.Run("last page without next cursor", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(&mockPVZLister{}, nil, nil, nil, listparams.NewCursorCodec([]byte("secret")), nil)
+		resp, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{})
+
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetNextCursor())
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(&mockPVZLister{}, nil, nil, nil, listparams.NewCursorCodec([]byte("secret")), nil)
+		_, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{Cursor: "forged"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("cursor with page", func(t *testing.T) {
+		t.Parallel()
+
+		codec := listparams.NewCursorCodec([]byte("secret"))
+		srv := NewPVZServer(&mockPVZLister{}, nil, nil, nil, codec, nil)
+		_, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{
+			Cursor: codec.Encode(listparams.Cursor{Time: now, ID: pvzID}),
+			Page:   2,
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("limit too large", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(&mockPVZLister{}, nil, nil, nil, nil, nil)
+		_, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{Limit: listparams.MaxLimit + 1})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("usecase error hidden", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(&mockPVZLister{err: errors.New("db is down")}, nil, nil, nil, nil, nil)
+		_, err := srv.ListPVZ(context.Background(), &pvz_v1.ListPVZRequest{})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.NotContains(t, st.Message(), "db is down")
+	})
+}
+
+func TestListNearbyPVZ(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	t.Run("nearest first with distance", func(t *testing.T) {
+		t.Parallel()
+
+		mock := &mockPVZLister{pvzs: []*domain.PVZ{{
+			ID:         pvzID,
+			City:       &domain.City{Name: "Москва"},
+			Status:     domain.PVZStatusActive,
+			Location:   &domain.GeoPoint{Lat: 55.76, Lon: 37.62},
+			DistanceKm: 0.5,
+		}}}
+		srv := NewPVZServer(mock, nil, nil, nil, nil, nil)
+
+		resp, err := srv.ListNearbyPVZ(context.Background(), &pvz_v1.ListNearbyPVZRequest{Lat: 55.7558, Lon: 37.6173, RadiusKm: 2, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, resp.GetItems(), 1)
+
+		item := resp.GetItems()[0]
+		assert.Equal(t, pvzID.String(), item.GetPvz().GetId())
+		assert.Equal(t, 55.76, item.GetPvz().GetLocation().GetLat())
+		assert.InDelta(t, 0.5, item.GetDistanceKm(), 1e-9)
+		assert.Equal(t, dto.PVZNearbyParams{
+			Point:    domain.GeoPoint{Lat: 55.7558, Lon: 37.6173},
+			RadiusKm: 2,
+			Limit:    10,
+		}, mock.gotNearby)
+	})
+
+	t.Run("latitude out of range", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(&mockPVZLister{}, nil, nil, nil, nil, nil)
+
+		_, err := srv.ListNearbyPVZ(context.Background(), &pvz_v1.ListNearbyPVZRequest{Lat: 100, Lon: 37.6173})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("radius too large", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(&mockPVZLister{}, nil, nil, nil, nil, nil)
+
+		_, err := srv.ListNearbyPVZ(context.Background(), &pvz_v1.ListNearbyPVZRequest{Lat: 55.7558, Lon: 37.6173, RadiusKm: 150})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("internal error hidden", func(t *testing.T) {
+		t.Parallel()
+
+		srv := NewPVZServer(&mockPVZLister{err: errors.New("db error")}, nil, nil, nil, nil, nil)
+
+		_, err := srv.ListNearbyPVZ(context.Background(), &pvz_v1.ListNearbyPVZRequest{Lat: 55.7558, Lon: 37.6173})
+		require.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.NotContains(t, err.Error(), "db error")
+	})
+}
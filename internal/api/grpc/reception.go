@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	context "context"
+
+	"github.com/google/uuid"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/metrics"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *PVZServer) CreateReception(ctx context.Context, req *pvz_v1.CreateReceptionRequest) (*pvz_v1.CreateReceptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	receptionRes, err := s.receptionUseCase.Create(ctx, dto.ReceptionCreate{
+		PvzID:     uuid.MustParse(req.GetPvzId()),
+		CreatedBy: userIDFromContext(ctx),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+
+	metrics.CreatedReceptionsInc()
+
+	return &pvz_v1.CreateReceptionResponse{Reception: receptionToResponse(receptionRes)}, nil
+}
+
+func (s *PVZServer) CloseLastReception(ctx context.Context, req *pvz_v1.CloseLastReceptionRequest) (*pvz_v1.CloseLastReceptionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	receptionRes, err := s.receptionUseCase.CloseLastReception(ctx, dto.ReceptionClose{
+		PvzID:    uuid.MustParse(req.GetPvzId()),
+		ClosedBy: userIDFromContext(ctx),
+	})
+	if err != nil {
+		return nil, mapErrorToGRPC(err)
+	}
+
+	return &pvz_v1.CloseLastReceptionResponse{Reception: receptionToResponse(receptionRes)}, nil
+}
+
+func receptionStatusFromProto(st pvz_v1.ReceptionStatus) domain.ReceptionStatusCode {
+	switch st {
+	case pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED:
+		return domain.ReceptionStatusClose
+	case pvz_v1.ReceptionStatus_RECEPTION_STATUS_CANCELLED:
+		return domain.ReceptionStatusCancelled
+	case pvz_v1.ReceptionStatus_RECEPTION_STATUS_REOPENED:
+		return domain.ReceptionStatusReopened
+	default:
+		return domain.ReceptionStatusInProgress
+	}
+}
+
+func receptionToResponse(reception *domain.Reception) *pvz_v1.Reception {
+	st := pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+	if reception.ReceptionStatus != nil {
+		switch reception.ReceptionStatus.Name {
+		case domain.ReceptionStatusClose:
+			st = pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED
+		case domain.ReceptionStatusCancelled:
+			st = pvz_v1.ReceptionStatus_RECEPTION_STATUS_CANCELLED
+		case domain.ReceptionStatusReopened:
+			st = pvz_v1.ReceptionStatus_RECEPTION_STATUS_REOPENED
+		}
+	}
+
+	return &pvz_v1.Reception{
+		Id:       reception.ID.String(),
+		DateTime: timestamppb.New(reception.DateTime),
+		PvzId:    reception.PvzID.String(),
+		Status:   st,
+	}
+}
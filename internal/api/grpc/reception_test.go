@@ -0,0 +1,172 @@
+package grpc
+
+import (
+	context "context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockReceptionService struct {
+	reception *domain.Reception
+	err       error
+
+	exportRows []domain.ReceptionExportRow
+
+	gotCreateIn dto.ReceptionCreate
+	gotCloseIn  dto.ReceptionClose
+	gotExportIn dto.ReceptionExport
+}
+
+func (m *mockReceptionService) Create(ctx context.Context, createIn dto.ReceptionCreate) (*domain.Reception, error) {
+	m.gotCreateIn = createIn
+	return m.reception, m.err
+}
+
+func (m *mockReceptionService) CloseLastReception(ctx context.Context, closeIn dto.ReceptionClose) (*domain.Reception, error) {
+	m.gotCloseIn = closeIn
+	return m.reception, m.err
+}
+
+func (m *mockReceptionService) Export(ctx context.Context, exportIn dto.ReceptionExport, fn func(domain.ReceptionExportRow) error) error {
+	m.gotExportIn = exportIn
+	for _, row := range m.exportRows {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return m.err
+}
+
+func TestCreateReception(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+	reception := &domain.Reception{
+		ID:              uuid.New(),
+		PvzID:           pvzID,
+		DateTime:        time.Now(),
+		ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusInProgress},
+	}
+
+	tests := []struct {
+		name     string
+		pvzID    string
+		mock     *mockReceptionService
+		wantCode codes.Code
+	}{
+		{
+			name:     "success",
+			pvzID:    pvzID.String(),
+			mock:     &mockReceptionService{reception: reception},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "invalid pvz id",
+			pvzID:    "123",
+			mock:     &mockReceptionService{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "pvz not found",
+			pvzID:    pvzID.String(),
+			mock:     &mockReceptionService{err: domain.ErrPVZNotFound},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "reception already in progress",
+			pvzID:    pvzID.String(),
+			mock:     &mockReceptionService{err: domain.ErrNoReceptionIsCurrentlyInProgress},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "internal error",
+			pvzID:    pvzID.String(),
+			mock:     &mockReceptionService{err: errors.New("db error")},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userID := uuid.New()
+			ctx := context.WithValue(context.Background(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole})
+
+			srv := NewPVZServer(nil, tt.mock, nil, nil, nil, nil)
+			resp, err := srv.CreateReception(ctx, &pvz_v1.CreateReceptionRequest{PvzId: tt.pvzID})
+
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, reception.ID.String(), resp.GetReception().GetId())
+			assert.Equal(t, pvzID.String(), resp.GetReception().GetPvzId())
+			assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS, resp.GetReception().GetStatus())
+			assert.Equal(t, dto.ReceptionCreate{PvzID: pvzID, CreatedBy: userID}, tt.mock.gotCreateIn)
+		})
+	}
+}
+
+func TestCloseLastReception(t *testing.T) {
+	t.Parallel()
+
+	pvzID := uuid.New()
+
+	tests := []struct {
+		name     string
+		mock     *mockReceptionService
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			mock: &mockReceptionService{reception: &domain.Reception{
+				ID:              uuid.New(),
+				PvzID:           pvzID,
+				ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusClose},
+			}},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "reception not found",
+			mock:     &mockReceptionService{err: domain.ErrReceptionNotFound},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userID := uuid.New()
+			ctx := context.WithValue(context.Background(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole})
+
+			srv := NewPVZServer(nil, tt.mock, nil, nil, nil, nil)
+			resp, err := srv.CloseLastReception(ctx, &pvz_v1.CloseLastReceptionRequest{PvzId: pvzID.String()})
+
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED, resp.GetReception().GetStatus())
+			assert.Equal(t, dto.ReceptionClose{PvzID: pvzID, ClosedBy: userID}, tt.mock.gotCloseIn)
+		})
+	}
+}
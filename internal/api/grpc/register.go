@@ -3,15 +3,46 @@ package grpc
 import (
 	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
 	"github.com/valeragav/avito-pvz-service/internal/app"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
 	grpc "google.golang.org/grpc"
 )
 
+// MethodRoles - роли, которым разрешён вызов метода. Повторяет правила HTTP роутов.
+var MethodRoles = map[string][]domain.Role{
+	pvz_v1.PVZService_GetPVZList_FullMethodName:    {domain.EmployeeRole, domain.ModeratorRole},
+	pvz_v1.PVZService_ListPVZ_FullMethodName:       {domain.EmployeeRole, domain.ModeratorRole},
+	pvz_v1.PVZService_ListNearbyPVZ_FullMethodName: {domain.EmployeeRole, domain.ModeratorRole},
+	pvz_v1.PVZService_CreatePVZ_FullMethodName:     {domain.ModeratorRole},
+
+	pvz_v1.PVZService_CreateReception_FullMethodName:    {domain.EmployeeRole},
+	pvz_v1.PVZService_CloseLastReception_FullMethodName: {domain.EmployeeRole},
+
+	pvz_v1.PVZService_AddProduct_FullMethodName:        {domain.EmployeeRole},
+	pvz_v1.PVZService_DeleteLastProduct_FullMethodName: {domain.EmployeeRole},
+	pvz_v1.PVZService_DeleteProduct_FullMethodName:     {domain.EmployeeRole},
+
+	pvz_v1.PVZService_IssueProduct_FullMethodName:  {domain.EmployeeRole},
+	pvz_v1.PVZService_ReturnProduct_FullMethodName: {domain.EmployeeRole},
+
+	pvz_v1.PVZService_ExportReceptions_FullMethodName: {domain.ModeratorRole},
+	pvz_v1.PVZService_WatchPVZ_FullMethodName:         {domain.EmployeeRole, domain.ModeratorRole},
+}
+
 func CollectRegisters(appService *app.App) []RegisterFunc {
 	registers := []RegisterFunc{
 		func(s *grpc.Server) {
-			pvz_v1.RegisterPVZServiceServer(s, NewPVZServer(appService.PVZUseCase))
+			pvz_v1.RegisterPVZServiceServer(s, NewPVZServer(appService.PVZUseCase, appService.ReceptionUseCase, appService.ProductUseCase, appService.IssuanceUseCase, appService.CursorCodec, appService.WatchBus))
 		},
 	}
 
 	return registers
 }
+
+func CollectServerOptions(appService *app.App) []grpc.ServerOption {
+	authInterceptor := NewAuthInterceptor(appService.JwtService, MethodRoles)
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(authInterceptor.Unary()),
+		grpc.ChainStreamInterceptor(authInterceptor.Stream()),
+	}
+}
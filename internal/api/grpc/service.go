@@ -66,9 +66,23 @@ func (s *Server) StartServer(ctx context.Context) error {
 	}
 }
 
+// Shutdown ждёт завершения активных вызовов, но не дольше ctx: стримы WatchPVZ
+// сами не заканчиваются, поэтому по истечении ctx соединения закрываются принудительно.
 func (s *Server) Shutdown(ctx context.Context) error {
-	s.grpcServer.GracefulStop()
-	return nil
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		<-stopped
+		return fmt.Errorf("grpc server shutdown: %w", ctx.Err())
+	}
 }
 
 func (s *Server) Addr() string {
@@ -12,6 +12,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	grpcserver "github.com/valeragav/avito-pvz-service/internal/api/grpc"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/watch"
 )
 
 func TestNewServer(t *testing.T) {
@@ -190,6 +192,48 @@ func TestShutdown_GracefulStop(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestShutdown_StopsOpenStreamsOnTimeout(t *testing.T) {
+	t.Parallel()
+
+	bus := watch.NewBus(1)
+	srv, err := grpcserver.NewServer(
+		context.Background(),
+		"test",
+		"127.0.0.1:0",
+		[]grpcserver.RegisterFunc{
+			func(s *googlegrpc.Server) {
+				pvz_v1.RegisterPVZServiceServer(s, grpcserver.NewPVZServer(nil, nil, nil, nil, nil, bus))
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go func() {
+		_ = srv.StartServer(ctx)
+	}()
+
+	conn, err := googlegrpc.NewClient(srv.Addr(), googlegrpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+
+	stream, err := pvz_v1.NewPVZServiceClient(conn).WatchPVZ(ctx, &pvz_v1.WatchPVZRequest{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return bus.Subscribers() == 1 }, time.Second, time.Millisecond)
+
+	// открытый стрим не должен блокировать остановку дольше таймаута
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer shutdownCancel()
+
+	err = srv.Shutdown(shutdownCtx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = stream.Recv()
+	assert.Error(t, err)
+}
+
 func TestShutdown_CanBeCalledMultipleTimes(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"github.com/google/uuid"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WatchPVZ отправляет события PVZ по мере их публикации из outbox.
+// Клиент, не успевающий читать, отключается с ResourceExhausted и должен переподключиться,
+// пропущенные за это время изменения можно дочитать через ListPVZ.
+// При остановке сервиса стрим завершается с Unavailable.
+func (s *PVZServer) WatchPVZ(req *pvz_v1.WatchPVZRequest, stream pvz_v1.PVZService_WatchPVZServer) error {
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := domain.PVZEventFilter{City: req.GetCity()}
+	for _, id := range req.GetPvzIds() {
+		filter.PvzIDs = append(filter.PvzIDs, uuid.MustParse(id))
+	}
+
+	sub := s.watchBus.Subscribe(filter)
+	defer s.watchBus.Unsubscribe(sub)
+
+	ctx := stream.Context()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-sub.Done():
+			// без причины подписка закрывается только при остановке, клиенту нужно переподключиться
+			if sub.Err() == nil {
+				return status.Error(codes.Unavailable, "watch subscription closed")
+			}
+			return mapErrorToGRPC(sub.Err())
+		case event := <-sub.Events():
+			if err := stream.Send(pvzEventToResponse(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func pvzEventToResponse(event domain.PVZEvent) *pvz_v1.PVZEvent {
+	return &pvz_v1.PVZEvent{
+		Id:          event.ID.String(),
+		Type:        string(event.Type),
+		AggregateId: event.AggregateID.String(),
+		PvzId:       event.PvzID.String(),
+		City:        event.City,
+		OccurredAt:  timestamppb.New(event.OccurredAt),
+		Payload:     string(event.Payload),
+	}
+}
@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pvz_v1 "github.com/valeragav/avito-pvz-service/internal/api/grpc/gen/v1"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/watch"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sent    chan *pvz_v1.PVZEvent
+	release chan struct{}
+}
+
+func newFakeWatchStream(ctx context.Context) *fakeWatchStream {
+	release := make(chan struct{})
+	close(release)
+
+	return &fakeWatchStream{ctx: ctx, sent: make(chan *pvz_v1.PVZEvent, 10), release: release}
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Send(event *pvz_v1.PVZEvent) error {
+	s.sent <- event
+	<-s.release
+	return nil
+}
+
+func watchAsync(srv *PVZServer, req *pvz_v1.WatchPVZRequest, stream *fakeWatchStream) <-chan error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.WatchPVZ(req, stream)
+	}()
+	return errCh
+}
+
+func waitSubscribers(t *testing.T, bus *watch.Bus, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool { return bus.Subscribers() == n }, time.Second, time.Millisecond)
+}
+
+func TestWatchPVZ(t *testing.T) {
+	t.Parallel()
+
+	t.Run("filtered events streamed", func(t *testing.T) {
+		t.Parallel()
+
+		bus := watch.NewBus(10)
+		srv := NewPVZServer(nil, nil, nil, nil, nil, bus)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := newFakeWatchStream(ctx)
+
+		pvzID := uuid.New()
+		errCh := watchAsync(srv, &pvz_v1.WatchPVZRequest{PvzIds: []string{pvzID.String()}}, stream)
+		waitSubscribers(t, bus, 1)
+
+		event := domain.PVZEvent{
+			ID:          uuid.New(),
+			Type:        domain.EventProductAdded,
+			AggregateID: uuid.New(),
+			PvzID:       pvzID,
+			City:        "Москва",
+			OccurredAt:  time.Now().UTC(),
+			Payload:     json.RawMessage(`{"barcode":"123"}`),
+		}
+		bus.Publish(domain.PVZEvent{ID: uuid.New(), PvzID: uuid.New()})
+		bus.Publish(event)
+
+		got := <-stream.sent
+		assert.Equal(t, event.ID.String(), got.GetId())
+		assert.Equal(t, "ProductAdded", got.GetType())
+		assert.Equal(t, pvzID.String(), got.GetPvzId())
+		assert.Equal(t, "Москва", got.GetCity())
+		assert.Equal(t, `{"barcode":"123"}`, got.GetPayload())
+		assert.Empty(t, stream.sent)
+
+		cancel()
+		assert.Equal(t, codes.Canceled, status.Code(<-errCh))
+		// подписка снимается при выходе из стрима
+		assert.Equal(t, 0, bus.Subscribers())
+	})
+
+	t.Run("slow consumer disconnected", func(t *testing.T) {
+		t.Parallel()
+
+		bus := watch.NewBus(1)
+		srv := NewPVZServer(nil, nil, nil, nil, nil, bus)
+
+		stream := newFakeWatchStream(context.Background())
+		stream.release = make(chan struct{})
+
+		errCh := watchAsync(srv, &pvz_v1.WatchPVZRequest{}, stream)
+		waitSubscribers(t, bus, 1)
+
+		// первое событие застряло в Send, второе заняло буфер, третье переполнило его
+		bus.Publish(domain.PVZEvent{ID: uuid.New()})
+		<-stream.sent
+		bus.Publish(domain.PVZEvent{ID: uuid.New()})
+		bus.Publish(domain.PVZEvent{ID: uuid.New()})
+		close(stream.release)
+
+		assert.Equal(t, codes.ResourceExhausted, status.Code(<-errCh))
+	})
+
+	t.Run("subscription closed without error", func(t *testing.T) {
+		t.Parallel()
+
+		bus := watch.NewBus(1)
+		srv := NewPVZServer(nil, nil, nil, nil, nil, bus)
+
+		errCh := watchAsync(srv, &pvz_v1.WatchPVZRequest{}, newFakeWatchStream(context.Background()))
+		waitSubscribers(t, bus, 1)
+
+		bus.Close()
+
+		assert.Equal(t, codes.Unavailable, status.Code(<-errCh))
+	})
+
+	t.Run("invalid pvz id", func(t *testing.T) {
+		t.Parallel()
+
+		bus := watch.NewBus(1)
+		srv := NewPVZServer(nil, nil, nil, nil, nil, bus)
+
+		err := srv.WatchPVZ(&pvz_v1.WatchPVZRequest{PvzIds: []string{"not-a-uuid"}}, newFakeWatchStream(context.Background()))
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, 0, bus.Subscribers())
+	})
+}
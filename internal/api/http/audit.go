@@ -0,0 +1,28 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/audit"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type AuditRoute struct {
+	authMiddleware *middleware.AuthMiddleware
+	auditHandlers  *audit.AuditHandlers
+}
+
+func NewAuditRoute(authMiddleware *middleware.AuthMiddleware, auditHandlers *audit.AuditHandlers) *AuditRoute {
+	return &AuditRoute{
+		authMiddleware,
+		auditHandlers,
+	}
+}
+
+func (router AuditRoute) Init(r chi.Router) {
+	r.Route("/audit", func(b chi.Router) {
+		b.Use(router.authMiddleware.Init())
+
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Get("/", router.auditHandlers.List)
+	})
+}
@@ -19,4 +19,6 @@ func (router AuthRoute) Init(r chi.Router) {
 	r.Post("/dummyLogin", router.authHandlers.DummyLogin)
 	r.Post("/register", router.authHandlers.Register)
 	r.Post("/login", router.authHandlers.Login)
+	r.Post("/token/refresh", router.authHandlers.Refresh)
+	r.Post("/logout", router.authHandlers.Logout)
 }
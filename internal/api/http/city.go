@@ -0,0 +1,32 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/city"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type CityRoute struct {
+	authMiddleware *middleware.AuthMiddleware
+	cityHandlers   *city.CityHandlers
+}
+
+func NewCityRoute(authMiddleware *middleware.AuthMiddleware, cityHandlers *city.CityHandlers) *CityRoute {
+	return &CityRoute{
+		authMiddleware,
+		cityHandlers,
+	}
+}
+
+func (router CityRoute) Init(r chi.Router) {
+	r.Route("/cities", func(b chi.Router) {
+		b.Use(router.authMiddleware.Init())
+
+		// PVZ регистрирует модератор, поэтому справочник городов нужен только ему
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Get("/", router.cityHandlers.List)
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Post("/", router.cityHandlers.Create)
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Patch("/{cityID}", router.cityHandlers.Update)
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Delete("/{cityID}", router.cityHandlers.Delete)
+	})
+}
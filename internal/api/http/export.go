@@ -0,0 +1,28 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/export"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type ExportRoute struct {
+	authMiddleware *middleware.AuthMiddleware
+	exportHandlers *export.ExportHandlers
+}
+
+func NewExportRoute(authMiddleware *middleware.AuthMiddleware, exportHandlers *export.ExportHandlers) *ExportRoute {
+	return &ExportRoute{
+		authMiddleware,
+		exportHandlers,
+	}
+}
+
+func (router ExportRoute) Init(r chi.Router) {
+	r.Route("/export", func(b chi.Router) {
+		b.Use(router.authMiddleware.Init())
+
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Get("/receptions", router.exportHandlers.Receptions)
+	})
+}
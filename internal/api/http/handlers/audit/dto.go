@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+)
+
+type EventResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	ActorID   *uuid.UUID `json:"actorId"`
+	Action    string     `json:"action"`
+	EntityID  uuid.UUID  `json:"entityId"`
+	PvzID     *uuid.UUID `json:"pvzId"`
+	RequestID string     `json:"requestId,omitempty"`
+	Before    any        `json:"before" swaggertype:"object"`
+	After     any        `json:"after" swaggertype:"object"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func ToBuildAuditListParams(params AuditListParams) dto.AuditListParams {
+	return dto.AuditListParams{
+		Filter:     params.Filter,
+		Pagination: &params.Pagination,
+	}
+}
+
+func ToListResponse(events []*domain.AuditEvent) []EventResponse {
+	result := make([]EventResponse, 0, len(events))
+	for _, e := range events {
+		result = append(result, EventResponse{
+			ID:        e.ID,
+			ActorID:   nullableID(e.ActorID),
+			Action:    string(e.Action),
+			EntityID:  e.EntityID,
+			PvzID:     nullableID(e.PvzID),
+			RequestID: e.RequestID,
+			Before:    e.Before,
+			After:     e.After,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+	return result
+}
+
+func nullableID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
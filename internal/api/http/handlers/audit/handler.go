@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
+type auditService interface {
+	List(ctx context.Context, params *dto.AuditListParams) ([]*domain.AuditEvent, error)
+}
+
+type AuditHandlers struct {
+	auditService auditService
+}
+
+func New(auditService auditService) *AuditHandlers {
+	return &AuditHandlers{
+		auditService,
+	}
+}
+
+// @Summary List audit events
+// @Description Get state-changing operations journal, newest first. Requires JWT-Token with Moderator role.
+// @ID ListAudit
+// @Tags Audit
+// @Security ApiKeyAuth
+// @Produce json
+// @Param pvzId query string false "Filter by PVZ ID"
+// @Param actorId query string false "Filter by actor user ID"
+// @Param action query string false "Filter by action" Enums(pvz.created, reception.opened, reception.closed, product.added, product.removed)
+// @Param startDate query string false "Start of time range (RFC3339)"
+// @Param endDate query string false "End of time range (RFC3339)"
+// @Param limit query int false "Limit number of results"
+// @Param page query int false "Page for pagination"
+// @Success 200 {array} EventResponse "Audit events"
+// @Failure 400 {object} response.Error "Bad request"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /audit [get]
+func (h *AuditHandlers) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	params, err := getParseAuditParam(r)
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	paramsDto := ToBuildAuditListParams(params)
+
+	events, err := h.auditService.List(ctx, &paramsDto)
+	if err != nil {
+		logger.ErrorCtx(ctx, "internal server error", "error", err)
+		response.WriteError(w, ctx, http.StatusInternalServerError, "internal server error", err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToListResponse(events))
+}
@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/audit/mocks"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuditHandlers_List(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzID := uuid.New()
+	events := []*domain.AuditEvent{
+		{
+			ID:        uuid.New(),
+			ActorID:   uuid.New(),
+			Action:    domain.AuditActionReceptionOpened,
+			EntityID:  uuid.New(),
+			PvzID:     pvzID,
+			RequestID: "req-1",
+			CreatedAt: time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			ID:        uuid.New(),
+			Action:    domain.AuditActionPVZCreated,
+			EntityID:  pvzID,
+			CreatedAt: time.Date(2026, time.February, 10, 10, 30, 0, 0, time.UTC),
+		},
+	}
+
+	testcases := []struct {
+		name             string
+		requestQuery     string
+		auditServiceMock func(*mocks.MockauditService)
+		expectedCode     int
+		expected         []EventResponse
+		expectedError    *response.Error
+	}{
+		{
+			name:         "successful list",
+			requestQuery: "?pvzId=" + pvzID.String() + "&action=reception.opened",
+			expectedCode: http.StatusOK,
+			auditServiceMock: func(service *mocks.MockauditService) {
+				service.
+					EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, params *dto.AuditListParams) ([]*domain.AuditEvent, error) {
+						require.Equal(t, pvzID, *params.Filter.PvzID)
+						require.Equal(t, domain.AuditActionReceptionOpened, *params.Filter.Action)
+						return events, nil
+					})
+			},
+			expected: ToListResponse(events),
+		},
+		{
+			name:         "invalid action",
+			requestQuery: "?action=unknown",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid action",
+			},
+		},
+		{
+			name:         "service error",
+			requestQuery: "",
+			expectedCode: http.StatusInternalServerError,
+			auditServiceMock: func(service *mocks.MockauditService) {
+				service.
+					EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("storage error"))
+			},
+			expectedError: &response.Error{
+				Message: "internal server error",
+				Details: "storage error",
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			auditServiceMock := mocks.NewMockauditService(ctrl)
+			handler := New(auditServiceMock)
+
+			if tt.auditServiceMock != nil {
+				tt.auditServiceMock(auditServiceMock)
+			}
+
+			req := httptest.NewRequest("GET", "/audit"+tt.requestQuery, http.NoBody)
+
+			w := httptest.NewRecorder()
+			handler.List(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res []EventResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expected, res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
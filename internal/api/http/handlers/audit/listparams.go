@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+)
+
+type AuditListParams struct {
+	Filter     domain.AuditFilter
+	Pagination listparams.Pagination
+}
+
+func getParseAuditParam(r *http.Request) (AuditListParams, error) {
+	q := r.URL.Query()
+
+	filter, err := parseAuditFilter(q)
+	if err != nil {
+		return AuditListParams{}, err
+	}
+
+	pagination, err := listparams.ParsePagination(q, listparams.Pagination{})
+	if err != nil {
+		return AuditListParams{}, err
+	}
+
+	return AuditListParams{
+		Filter:     filter,
+		Pagination: pagination,
+	}, nil
+}
+
+func parseAuditFilter(q url.Values) (domain.AuditFilter, error) {
+	var f domain.AuditFilter
+
+	if v := q.Get("pvzId"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return f, errors.New("invalid pvzId")
+		}
+		f.PvzID = &id
+	}
+
+	if v := q.Get("actorId"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return f, errors.New("invalid actorId")
+		}
+		f.ActorID = &id
+	}
+
+	if v := q.Get("action"); v != "" {
+		action := domain.AuditAction(v)
+		if !action.IsValid() {
+			return f, errors.New("invalid action")
+		}
+		f.Action = &action
+	}
+
+	if v := q.Get("startDate"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return f, errors.New("invalid startDate")
+		}
+		f.StartDate = &t
+	}
+
+	if v := q.Get("endDate"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return f, errors.New("invalid endDate")
+		}
+		f.EndDate = &t
+	}
+
+	if f.StartDate != nil && f.EndDate != nil && f.EndDate.Before(*f.StartDate) {
+		return f, errors.New("endDate must be after startDate")
+	}
+
+	return f, nil
+}
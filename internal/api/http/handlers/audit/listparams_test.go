@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+func Test_parseAuditFilter(t *testing.T) {
+	validStart := "2026-02-11T10:30:00Z"
+	validEnd := "2026-02-12T10:30:00Z"
+
+	startTime, _ := time.Parse(time.RFC3339, validStart)
+	endTime, _ := time.Parse(time.RFC3339, validEnd)
+
+	pvzID := uuid.New()
+	actorID := uuid.New()
+	action := domain.AuditActionReceptionClosed
+
+	tests := []struct {
+		name        string
+		query       url.Values
+		expect      domain.AuditFilter
+		expectError string
+	}{
+		{
+			name: "all filters",
+			query: url.Values{
+				"pvzId":     []string{pvzID.String()},
+				"actorId":   []string{actorID.String()},
+				"action":    []string{string(action)},
+				"startDate": []string{validStart},
+				"endDate":   []string{validEnd},
+			},
+			expect: domain.AuditFilter{
+				PvzID:     &pvzID,
+				ActorID:   &actorID,
+				Action:    &action,
+				StartDate: &startTime,
+				EndDate:   &endTime,
+			},
+		},
+		{
+			name:   "empty query",
+			query:  url.Values{},
+			expect: domain.AuditFilter{},
+		},
+		{
+			name:        "invalid pvzId",
+			query:       url.Values{"pvzId": []string{"not-uuid"}},
+			expectError: "invalid pvzId",
+		},
+		{
+			name:        "invalid actorId",
+			query:       url.Values{"actorId": []string{"not-uuid"}},
+			expectError: "invalid actorId",
+		},
+		{
+			name:        "unknown action",
+			query:       url.Values{"action": []string{"pvz.deleted"}},
+			expectError: "invalid action",
+		},
+		{
+			name:        "invalid start date",
+			query:       url.Values{"startDate": []string{"2026-01-01"}},
+			expectError: "invalid startDate",
+		},
+		{
+			name: "end before start",
+			query: url.Values{
+				"startDate": []string{validEnd},
+				"endDate":   []string{validStart},
+			},
+			expectError: "endDate must be after startDate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := parseAuditFilter(tt.query)
+
+			if tt.expectError != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectError, err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, filter)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/valeragav/avito-pvz-service/internal/domain"
+	dto "github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockauditService is a mock of auditService interface.
+type MockauditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockauditServiceMockRecorder is the mock recorder for MockauditService.
+type MockauditServiceMockRecorder struct {
+	mock *MockauditService
+}
+
+// NewMockauditService creates a new mock instance.
+func NewMockauditService(ctrl *gomock.Controller) *MockauditService {
+	mock := &MockauditService{ctrl: ctrl}
+	mock.recorder = &MockauditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditService) EXPECT() *MockauditServiceMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockauditService) List(ctx context.Context, params *dto.AuditListParams) ([]*domain.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, params)
+	ret0, _ := ret[0].([]*domain.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockauditServiceMockRecorder) List(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockauditService)(nil).List), ctx, params)
+}
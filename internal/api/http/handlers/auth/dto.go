@@ -27,6 +27,19 @@ type LoginRequest struct {
 	Password string `json:"password" validate:"required,min=6"`
 }
 
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required,max=255"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required,max=255"`
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
 func ToRegisterIn(req RegisterRequest) dto.RegisterIn {
 	return dto.RegisterIn{
 		Email:    req.Email,
@@ -49,3 +62,23 @@ func ToRegisterResponse(out domain.User) RegisterResponse {
 		Role:  string(out.Role),
 	}
 }
+
+func ToRefreshIn(req RefreshRequest) dto.RefreshIn {
+	return dto.RefreshIn{
+		RefreshToken: req.RefreshToken,
+	}
+}
+
+func ToLogoutIn(req LogoutRequest, accessToken string) dto.LogoutIn {
+	return dto.LogoutIn{
+		RefreshToken: req.RefreshToken,
+		AccessToken:  accessToken,
+	}
+}
+
+func ToTokenResponse(out domain.TokenPair) TokenResponse {
+	return TokenResponse{
+		AccessToken:  string(out.AccessToken),
+		RefreshToken: string(out.RefreshToken),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
@@ -14,11 +15,15 @@ import (
 	"github.com/valeragav/avito-pvz-service/pkg/validation"
 )
 
+const prefixAuth = "Bearer "
+
 //go:generate ${LOCAL_BIN}/mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
 type authService interface {
 	GenerateToken(role domain.Role) (*domain.Token, error)
-	Login(ctx context.Context, loginReq dto.LoginIn) (*domain.Token, error)
+	Login(ctx context.Context, loginReq dto.LoginIn) (*domain.TokenPair, error)
 	Register(ctx context.Context, registerReq dto.RegisterIn) (*domain.User, error)
+	Refresh(ctx context.Context, refreshIn dto.RefreshIn) (*domain.TokenPair, error)
+	Logout(ctx context.Context, logoutIn dto.LogoutIn) error
 }
 
 type AuthHandlers struct {
@@ -118,13 +123,13 @@ func (h *AuthHandlers) Register(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary User login
-// @Description Authenticate user and returns a JWT Bearer token.
+// @Description Authenticate user and returns a JWT Bearer access token and a refresh token.
 // @ID Login
 // @Tags Auth
 // @Accept json
 // @Produce json
 // @Param input body LoginRequest true "User credentials (email and password)"
-// @Success 200 {string} string "JWT access token"
+// @Success 200 {object} TokenResponse "Access and refresh tokens"
 // @Failure 400 {object} response.Error "Invalid request or validation failed"
 // @Failure 401 {object} response.Error "Invalid email or password"
 // @Failure 500 {object} response.Error "Internal server error"
@@ -146,7 +151,7 @@ func (h *AuthHandlers) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token, err := h.authService.Login(ctx, ToLoginIn(req))
+	tokens, err := h.authService.Login(ctx, ToLoginIn(req))
 	if err != nil {
 		mess, code := mapErrorToHTTP(err)
 
@@ -155,7 +160,92 @@ func (h *AuthHandlers) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response.WriteString(w, ctx, http.StatusOK, string(*token))
+	response.WriteJSON(w, ctx, http.StatusOK, ToTokenResponse(*tokens))
+}
+
+// @Summary Refresh tokens
+// @Description Exchanges a refresh token for a new access and refresh token pair. The used refresh token is revoked.
+// @ID RefreshToken
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param input body RefreshRequest true "Refresh token"
+// @Success 200 {object} TokenResponse "New access and refresh tokens"
+// @Failure 400 {object} response.Error "Invalid request or validation failed"
+// @Failure 401 {object} response.Error "Invalid refresh token"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /token/refresh [post]
+func (h *AuthHandlers) Refresh(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		if errors.Is(err, io.EOF) {
+			response.WriteError(w, ctx, http.StatusBadRequest, "request body is empty", nil)
+			return
+		}
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	tokens, err := h.authService.Refresh(ctx, ToRefreshIn(req))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToTokenResponse(*tokens))
+}
+
+// @Summary Logout
+// @Description Revokes the refresh token. If a Bearer access token is passed in Authorization header, it is revoked too.
+// @ID Logout
+// @Tags Auth
+// @Accept json
+// @Param input body LogoutRequest true "Refresh token"
+// @Success 204 "Tokens revoked"
+// @Failure 400 {object} response.Error "Invalid request or validation failed"
+// @Failure 401 {object} response.Error "Invalid refresh token"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /logout [post]
+func (h *AuthHandlers) Logout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req LogoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		if errors.Is(err, io.EOF) {
+			response.WriteError(w, ctx, http.StatusBadRequest, "request body is empty", nil)
+			return
+		}
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), prefixAuth)
+
+	err := h.authService.Logout(ctx, ToLogoutIn(req, accessToken))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
 }
 
 func mapErrorToHTTP(err error) (msg string, statusCode int) {
@@ -172,6 +262,10 @@ func mapErrorToHTTP(err error) (msg string, statusCode int) {
 		msg = err.Error()
 		statusCode = http.StatusBadRequest
 
+	case errors.Is(err, domain.ErrInvalidRefreshToken):
+		msg = err.Error()
+		statusCode = http.StatusUnauthorized
+
 	default:
 		statusCode = http.StatusInternalServerError
 		msg = "internal server error"
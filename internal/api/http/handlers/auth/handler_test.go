@@ -15,6 +15,7 @@ import (
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/auth/mocks"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
 	"github.com/valeragav/avito-pvz-service/pkg/testutils"
 	"github.com/valeragav/avito-pvz-service/pkg/validation"
 	"go.uber.org/mock/gomock"
@@ -335,13 +336,12 @@ func TestAuthHandlers_Login(t *testing.T) {
 			},
 			expectedCode: http.StatusOK,
 			authServiceMock: func(authService *mocks.MockauthService) {
-				tkn := domain.Token("token")
 				authService.
 					EXPECT().
 					Login(gomock.Any(), gomock.Any()).
-					Return(&tkn, nil)
+					Return(&domain.TokenPair{AccessToken: "token", RefreshToken: "refresh"}, nil)
 			},
-			expected: "token",
+			expected: `{"accessToken":"token","refreshToken":"refresh"}`,
 		},
 		{
 			name: "validation failed - missing email",
@@ -441,3 +441,179 @@ func TestAuthHandlers_Login(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthHandlers_Refresh(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+
+	testcases := []struct {
+		name            string
+		requestBody     map[string]any
+		expectedCode    int
+		authServiceMock func(*mocks.MockauthService)
+		expected        string
+		expectedError   *response.Error
+	}{
+		{
+			name:         "successful refresh",
+			requestBody:  map[string]any{"refreshToken": "old"},
+			expectedCode: http.StatusOK,
+			authServiceMock: func(authService *mocks.MockauthService) {
+				authService.
+					EXPECT().
+					Refresh(gomock.Any(), dto.RefreshIn{RefreshToken: "old"}).
+					Return(&domain.TokenPair{AccessToken: "access", RefreshToken: "new"}, nil)
+			},
+			expected: `{"accessToken":"access","refreshToken":"new"}`,
+		},
+		{
+			name:         "validation failed - missing refresh token",
+			requestBody:  map[string]any{},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "field 'RefreshToken' failed on the 'required' validation",
+			},
+		},
+		{
+			name:         "service error - invalid refresh token",
+			requestBody:  map[string]any{"refreshToken": "old"},
+			expectedCode: http.StatusUnauthorized,
+			authServiceMock: func(authService *mocks.MockauthService) {
+				authService.
+					EXPECT().
+					Refresh(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInvalidRefreshToken)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrInvalidRefreshToken.Error(),
+				Details: domain.ErrInvalidRefreshToken.Error(),
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			authService := mocks.NewMockauthService(ctrl)
+			handler := New(valid, authService)
+
+			if tt.authServiceMock != nil {
+				tt.authServiceMock(authService)
+			}
+
+			bodyReader, err := testutils.MakeRequestBody(tt.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest("POST", "/token/refresh", bodyReader)
+
+			w := httptest.NewRecorder()
+			handler.Refresh(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != "" {
+				assert.Contains(t, w.Body.String(), tt.expected)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestAuthHandlers_Logout(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+
+	testcases := []struct {
+		name            string
+		requestBody     map[string]any
+		authHeader      string
+		expectedCode    int
+		authServiceMock func(*mocks.MockauthService)
+		expectedError   *response.Error
+	}{
+		{
+			name:         "successful logout with access token",
+			requestBody:  map[string]any{"refreshToken": "refresh"},
+			authHeader:   "Bearer access",
+			expectedCode: http.StatusNoContent,
+			authServiceMock: func(authService *mocks.MockauthService) {
+				authService.
+					EXPECT().
+					Logout(gomock.Any(), dto.LogoutIn{RefreshToken: "refresh", AccessToken: "access"}).
+					Return(nil)
+			},
+		},
+		{
+			name:         "successful logout without access token",
+			requestBody:  map[string]any{"refreshToken": "refresh"},
+			expectedCode: http.StatusNoContent,
+			authServiceMock: func(authService *mocks.MockauthService) {
+				authService.
+					EXPECT().
+					Logout(gomock.Any(), dto.LogoutIn{RefreshToken: "refresh"}).
+					Return(nil)
+			},
+		},
+		{
+			name:         "service error - invalid refresh token",
+			requestBody:  map[string]any{"refreshToken": "refresh"},
+			expectedCode: http.StatusUnauthorized,
+			authServiceMock: func(authService *mocks.MockauthService) {
+				authService.
+					EXPECT().
+					Logout(gomock.Any(), gomock.Any()).
+					Return(domain.ErrInvalidRefreshToken)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrInvalidRefreshToken.Error(),
+				Details: domain.ErrInvalidRefreshToken.Error(),
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			authService := mocks.NewMockauthService(ctrl)
+			handler := New(valid, authService)
+
+			if tt.authServiceMock != nil {
+				tt.authServiceMock(authService)
+			}
+
+			bodyReader, err := testutils.MakeRequestBody(tt.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest("POST", "/logout", bodyReader)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+
+			w := httptest.NewRecorder()
+			handler.Logout(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
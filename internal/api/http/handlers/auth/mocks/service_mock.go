@@ -58,10 +58,10 @@ func (mr *MockauthServiceMockRecorder) GenerateToken(role any) *gomock.Call {
 }
 
 // Login mocks base method.
-func (m *MockauthService) Login(ctx context.Context, loginReq dto.LoginIn) (*domain.Token, error) {
+func (m *MockauthService) Login(ctx context.Context, loginReq dto.LoginIn) (*domain.TokenPair, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Login", ctx, loginReq)
-	ret0, _ := ret[0].(*domain.Token)
+	ret0, _ := ret[0].(*domain.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -72,6 +72,35 @@ func (mr *MockauthServiceMockRecorder) Login(ctx, loginReq any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockauthService)(nil).Login), ctx, loginReq)
 }
 
+// Logout mocks base method.
+func (m *MockauthService) Logout(ctx context.Context, logoutIn dto.LogoutIn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, logoutIn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockauthServiceMockRecorder) Logout(ctx, logoutIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockauthService)(nil).Logout), ctx, logoutIn)
+}
+
+// Refresh mocks base method.
+func (m *MockauthService) Refresh(ctx context.Context, refreshIn dto.RefreshIn) (*domain.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshIn)
+	ret0, _ := ret[0].(*domain.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockauthServiceMockRecorder) Refresh(ctx, refreshIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockauthService)(nil).Refresh), ctx, refreshIn)
+}
+
 // Register mocks base method.
 func (m *MockauthService) Register(ctx context.Context, registerReq dto.RegisterIn) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,41 @@
+package city
+
+import (
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type CreateRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+type UpdateRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+type CityResponse struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+func ToUpdateIn(cityID uuid.UUID, req UpdateRequest) domain.City {
+	return domain.City{
+		ID:   cityID,
+		Name: req.Name,
+	}
+}
+
+func ToCityResponse(city domain.City) CityResponse {
+	return CityResponse{
+		ID:   city.ID,
+		Name: city.Name,
+	}
+}
+
+func ToListResponse(cities []*domain.City) []CityResponse {
+	result := make([]CityResponse, 0, len(cities))
+	for _, city := range cities {
+		result = append(result, ToCityResponse(*city))
+	}
+	return result
+}
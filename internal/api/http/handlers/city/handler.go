@@ -0,0 +1,215 @@
+package city
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+	"github.com/valeragav/avito-pvz-service/pkg/validation"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
+type cityService interface {
+	Create(ctx context.Context, name string) (*domain.City, error)
+	List(ctx context.Context, pagination *listparams.Pagination) ([]*domain.City, error)
+	Update(ctx context.Context, city domain.City) (*domain.City, error)
+	Delete(ctx context.Context, cityID uuid.UUID) error
+}
+
+type CityHandlers struct {
+	validator   *validation.Validator
+	cityService cityService
+}
+
+func New(validator *validation.Validator, cityService cityService) *CityHandlers {
+	return &CityHandlers{
+		validator,
+		cityService,
+	}
+}
+
+// @Summary List cities
+// @Description Get cities in which PVZ can be registered, ordered by name. Requires JWT-Token with Moderator role.
+// @ID ListCities
+// @Tags Cities
+// @Security ApiKeyAuth
+// @Produce json
+// @Param limit query int false "Limit number of results"
+// @Param page query int false "Page for pagination"
+// @Success 200 {array} CityResponse "Cities"
+// @Failure 400 {object} response.Error "Bad request"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /cities [get]
+func (h *CityHandlers) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := listparams.ParsePagination(r.URL.Query(), listparams.Pagination{})
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	cities, err := h.cityService.List(ctx, &pagination)
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToListResponse(cities))
+}
+
+// @Summary Create city
+// @Description Add a city in which PVZ can be registered. Requires JWT-Token with Moderator role.
+// @ID CreateCity
+// @Tags Cities
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param input body CreateRequest true "City data"
+// @Success 201 {object} CityResponse "City created"
+// @Failure 400 {object} response.Error "Invalid request or validation failed"
+// @Failure 409 {object} response.Error "City already exists"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /cities [post]
+func (h *CityHandlers) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		if errors.Is(err, io.EOF) {
+			response.WriteError(w, ctx, http.StatusBadRequest, "request body is empty", nil)
+			return
+		}
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	city, err := h.cityService.Create(ctx, req.Name)
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusCreated, ToCityResponse(*city))
+}
+
+// @Summary Rename city
+// @Description Rename a city, its PVZ stay attached to it. Requires JWT-Token with Moderator role.
+// @ID UpdateCity
+// @Tags Cities
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param cityID path string true "City ID"
+// @Param input body UpdateRequest true "City data"
+// @Success 200 {object} CityResponse "City updated"
+// @Failure 400 {object} response.Error "Invalid request or validation failed"
+// @Failure 404 {object} response.Error "City not found"
+// @Failure 409 {object} response.Error "City already exists"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /cities/{cityID} [patch]
+func (h *CityHandlers) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cityID, err := uuid.Parse(chi.URLParam(r, "cityID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid cityID format", nil)
+		return
+	}
+
+	var req UpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		if errors.Is(err, io.EOF) {
+			response.WriteError(w, ctx, http.StatusBadRequest, "request body is empty", nil)
+			return
+		}
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	city, err := h.cityService.Update(ctx, ToUpdateIn(cityID, req))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToCityResponse(*city))
+}
+
+// @Summary Delete city
+// @Description Delete a city without PVZ. Requires JWT-Token with Moderator role.
+// @ID DeleteCity
+// @Tags Cities
+// @Security ApiKeyAuth
+// @Param cityID path string true "City ID"
+// @Success 204 "City deleted"
+// @Failure 400 {object} response.Error "Invalid city ID"
+// @Failure 404 {object} response.Error "City not found"
+// @Failure 409 {object} response.Error "City has PVZ"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /cities/{cityID} [delete]
+func (h *CityHandlers) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cityID, err := uuid.Parse(chi.URLParam(r, "cityID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid cityID format", nil)
+		return
+	}
+
+	err = h.cityService.Delete(ctx, cityID)
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func mapErrorToHTTP(err error) (msg string, statusCode int) {
+	switch {
+	case errors.Is(err, domain.ErrCityNotFound):
+		msg = err.Error()
+		statusCode = http.StatusNotFound
+
+	case errors.Is(err, domain.ErrCityDuplicate), errors.Is(err, domain.ErrCityHasPVZ):
+		msg = err.Error()
+		statusCode = http.StatusConflict
+
+	default:
+		statusCode = http.StatusInternalServerError
+		msg = "internal server error"
+	}
+
+	return msg, statusCode
+}
@@ -0,0 +1,322 @@
+package city
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/city/mocks"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"github.com/valeragav/avito-pvz-service/pkg/validation"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCityHandlers_List(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	cities := []*domain.City{{ID: uuid.New(), Name: "Казань"}, {ID: uuid.New(), Name: "Москва"}}
+
+	cityServiceMock := mocks.NewMockcityService(ctrl)
+	cityServiceMock.
+		EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, pagination *listparams.Pagination) ([]*domain.City, error) {
+			require.Equal(t, uint(2), pagination.Page)
+			require.Equal(t, uint(5), pagination.Limit)
+			return cities, nil
+		})
+
+	handler := New(valid, cityServiceMock)
+
+	req := httptest.NewRequest("GET", "/cities?page=2&limit=5", http.NoBody)
+	w := httptest.NewRecorder()
+	handler.List(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var res []CityResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	assert.Equal(t, ToListResponse(cities), res)
+}
+
+func TestCityHandlers_Create(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	cityID := uuid.New()
+
+	testcases := []struct {
+		name          string
+		body          string
+		serviceMock   func(*mocks.MockcityService)
+		expectedCode  int
+		expected      *CityResponse
+		expectedError *response.Error
+	}{
+		{
+			name:         "successful create",
+			body:         `{"name":"Новосибирск"}`,
+			expectedCode: http.StatusCreated,
+			serviceMock: func(service *mocks.MockcityService) {
+				service.
+					EXPECT().
+					Create(gomock.Any(), "Новосибирск").
+					Return(&domain.City{ID: cityID, Name: "Новосибирск"}, nil)
+			},
+			expected: &CityResponse{ID: cityID, Name: "Новосибирск"},
+		},
+		{
+			name:         "empty name",
+			body:         `{"name":""}`,
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "field 'Name' failed on the 'required' validation",
+			},
+		},
+		{
+			name:         "empty body",
+			body:         "",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "request body is empty",
+			},
+		},
+		{
+			name:         "duplicate",
+			body:         `{"name":"Москва"}`,
+			expectedCode: http.StatusConflict,
+			serviceMock: func(service *mocks.MockcityService) {
+				service.
+					EXPECT().
+					Create(gomock.Any(), "Москва").
+					Return(nil, domain.ErrCityDuplicate)
+			},
+			expectedError: &response.Error{
+				Message: "city already exists",
+				Details: "city already exists",
+			},
+		},
+		{
+			name:         "service error",
+			body:         `{"name":"Омск"}`,
+			expectedCode: http.StatusInternalServerError,
+			serviceMock: func(service *mocks.MockcityService) {
+				service.
+					EXPECT().
+					Create(gomock.Any(), "Омск").
+					Return(nil, errors.New("storage error"))
+			},
+			expectedError: &response.Error{
+				Message: "internal server error",
+				Details: "storage error",
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			cityServiceMock := mocks.NewMockcityService(ctrl)
+			handler := New(valid, cityServiceMock)
+
+			if tt.serviceMock != nil {
+				tt.serviceMock(cityServiceMock)
+			}
+
+			req := httptest.NewRequest("POST", "/cities", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			handler.Create(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res CityResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+				assert.Equal(t, *tt.expected, res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errorRes))
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestCityHandlers_Update(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	cityID := uuid.New()
+
+	testcases := []struct {
+		name         string
+		cityID       string
+		body         string
+		serviceMock  func(*mocks.MockcityService)
+		expectedCode int
+		expected     *CityResponse
+	}{
+		{
+			name:         "successful update",
+			cityID:       cityID.String(),
+			body:         `{"name":"Санкт-Петербург"}`,
+			expectedCode: http.StatusOK,
+			serviceMock: func(service *mocks.MockcityService) {
+				service.
+					EXPECT().
+					Update(gomock.Any(), domain.City{ID: cityID, Name: "Санкт-Петербург"}).
+					Return(&domain.City{ID: cityID, Name: "Санкт-Петербург"}, nil)
+			},
+			expected: &CityResponse{ID: cityID, Name: "Санкт-Петербург"},
+		},
+		{
+			name:         "invalid id",
+			cityID:       "not-a-uuid",
+			body:         `{"name":"Санкт-Петербург"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "not found",
+			cityID:       cityID.String(),
+			body:         `{"name":"Санкт-Петербург"}`,
+			expectedCode: http.StatusNotFound,
+			serviceMock: func(service *mocks.MockcityService) {
+				service.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrCityNotFound)
+			},
+		},
+		{
+			name:         "duplicate name",
+			cityID:       cityID.String(),
+			body:         `{"name":"Москва"}`,
+			expectedCode: http.StatusConflict,
+			serviceMock: func(service *mocks.MockcityService) {
+				service.
+					EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrCityDuplicate)
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			cityServiceMock := mocks.NewMockcityService(ctrl)
+			handler := New(valid, cityServiceMock)
+
+			if tt.serviceMock != nil {
+				tt.serviceMock(cityServiceMock)
+			}
+
+			req := httptest.NewRequest("PATCH", "/cities/"+tt.cityID, strings.NewReader(tt.body))
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("cityID", tt.cityID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.Update(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res CityResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+				assert.Equal(t, *tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestCityHandlers_Delete(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	cityID := uuid.New()
+
+	testcases := []struct {
+		name         string
+		cityID       string
+		serviceMock  func(*mocks.MockcityService)
+		expectedCode int
+	}{
+		{
+			name:         "successful delete",
+			cityID:       cityID.String(),
+			expectedCode: http.StatusNoContent,
+			serviceMock: func(service *mocks.MockcityService) {
+				service.EXPECT().Delete(gomock.Any(), cityID).Return(nil)
+			},
+		},
+		{
+			name:         "invalid id",
+			cityID:       "not-a-uuid",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "not found",
+			cityID:       cityID.String(),
+			expectedCode: http.StatusNotFound,
+			serviceMock: func(service *mocks.MockcityService) {
+				service.EXPECT().Delete(gomock.Any(), cityID).Return(domain.ErrCityNotFound)
+			},
+		},
+		{
+			name:         "city has pvz",
+			cityID:       cityID.String(),
+			expectedCode: http.StatusConflict,
+			serviceMock: func(service *mocks.MockcityService) {
+				service.EXPECT().Delete(gomock.Any(), cityID).Return(domain.ErrCityHasPVZ)
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			cityServiceMock := mocks.NewMockcityService(ctrl)
+			handler := New(valid, cityServiceMock)
+
+			if tt.serviceMock != nil {
+				tt.serviceMock(cityServiceMock)
+			}
+
+			req := httptest.NewRequest("DELETE", "/cities/"+tt.cityID, http.NoBody)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("cityID", tt.cityID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.Delete(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	domain "github.com/valeragav/avito-pvz-service/internal/domain"
+	listparams "github.com/valeragav/avito-pvz-service/pkg/listparams"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockcityService is a mock of cityService interface.
+type MockcityService struct {
+	ctrl     *gomock.Controller
+	recorder *MockcityServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockcityServiceMockRecorder is the mock recorder for MockcityService.
+type MockcityServiceMockRecorder struct {
+	mock *MockcityService
+}
+
+// NewMockcityService creates a new mock instance.
+func NewMockcityService(ctrl *gomock.Controller) *MockcityService {
+	mock := &MockcityService{ctrl: ctrl}
+	mock.recorder = &MockcityServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcityService) EXPECT() *MockcityServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockcityService) Create(ctx context.Context, name string) (*domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name)
+	ret0, _ := ret[0].(*domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockcityServiceMockRecorder) Create(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockcityService)(nil).Create), ctx, name)
+}
+
+// Delete mocks base method.
+func (m *MockcityService) Delete(ctx context.Context, cityID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, cityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockcityServiceMockRecorder) Delete(ctx, cityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockcityService)(nil).Delete), ctx, cityID)
+}
+
+// List mocks base method.
+func (m *MockcityService) List(ctx context.Context, pagination *listparams.Pagination) ([]*domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, pagination)
+	ret0, _ := ret[0].([]*domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockcityServiceMockRecorder) List(ctx, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockcityService)(nil).List), ctx, pagination)
+}
+
+// Update mocks base method.
+func (m *MockcityService) Update(ctx context.Context, city domain.City) (*domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, city)
+	ret0, _ := ret[0].(*domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockcityServiceMockRecorder) Update(ctx, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockcityService)(nil).Update), ctx, city)
+}
@@ -0,0 +1,50 @@
+package export
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+)
+
+// RowResponse строка NDJSON выгрузки, поля товара пустые у приёмки без товаров.
+type RowResponse struct {
+	ReceptionID       uuid.UUID  `json:"receptionId"`
+	PvzID             uuid.UUID  `json:"pvzId"`
+	City              string     `json:"city"`
+	ReceptionDateTime time.Time  `json:"receptionDateTime"`
+	Status            string     `json:"status"`
+	ProductID         *uuid.UUID `json:"productId,omitempty"`
+	ProductDateTime   *time.Time `json:"productDateTime,omitempty"`
+	ProductType       string     `json:"productType,omitempty"`
+	Barcode           string     `json:"barcode,omitempty"`
+	Issued            *bool      `json:"issued,omitempty"`
+}
+
+func ToExportIn(params ExportParams) dto.ReceptionExport {
+	return dto.ReceptionExport{
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+	}
+}
+
+func ToRowResponse(row domain.ReceptionExportRow) RowResponse {
+	res := RowResponse{
+		ReceptionID:       row.ReceptionID,
+		PvzID:             row.PvzID,
+		City:              row.City,
+		ReceptionDateTime: row.ReceptionDateTime,
+		Status:            string(row.Status),
+	}
+
+	if row.ProductID != uuid.Nil {
+		res.ProductID = &row.ProductID
+		res.ProductDateTime = &row.ProductDateTime
+		res.ProductType = row.ProductType
+		res.Barcode = row.Barcode
+		res.Issued = &row.Issued
+	}
+
+	return res
+}
@@ -0,0 +1,138 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+)
+
+// flushEvery через сколько строк отправлять накопленное клиенту.
+const flushEvery = 500
+
+//go:generate ${LOCAL_BIN}/mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
+type exportService interface {
+	Export(ctx context.Context, exportIn dto.ReceptionExport, fn func(domain.ReceptionExportRow) error) error
+}
+
+type ExportHandlers struct {
+	exportService exportService
+}
+
+func New(exportService exportService) *ExportHandlers {
+	return &ExportHandlers{
+		exportService,
+	}
+}
+
+// @Summary Export receptions
+// @Description Stream all receptions with their products over a date range, one row per product. Receptions without products are exported as a single row with empty product fields. Requires JWT-Token with Moderator role.
+// @ID ExportReceptions
+// @Tags Export
+// @Security ApiKeyAuth
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param startDate query string false "Start of reception time range (RFC3339)"
+// @Param endDate query string false "End of reception time range (RFC3339)"
+// @Param format query string false "Output format, csv by default" Enums(csv, ndjson)
+// @Success 200 {array} RowResponse "Receptions export, rows of RowResponse in CSV or NDJSON"
+// @Failure 400 {object} response.Error "Bad request"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /export/receptions [get]
+func (h *ExportHandlers) Receptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	params, err := getParseExportParam(r)
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	// выгрузка может писаться дольше WriteTimeout сервера
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		logger.WarnCtx(ctx, "failed to reset write deadline", "error", err)
+	}
+
+	writer := newRowWriter(params.Format, w)
+	rows := 0
+
+	// заголовки отправляются с первой строкой, чтобы ошибку до начала выгрузки можно было вернуть статусом
+	begin := func() error {
+		w.Header().Set("Content-Type", contentType(params.Format))
+		w.Header().Set("Content-Disposition", `attachment; filename="receptions.`+string(params.Format)+`"`)
+		w.WriteHeader(http.StatusOK)
+		return writer.Begin()
+	}
+
+	flush := func() error {
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	}
+
+	err = h.exportService.Export(ctx, ToExportIn(params), func(row domain.ReceptionExportRow) error {
+		if rows == 0 {
+			if err := begin(); err != nil {
+				return err
+			}
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+		rows++
+
+		if rows%flushEvery == 0 {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		if rows > 0 {
+			// статус уже отправлен, клиент получит оборванную выгрузку
+			logger.ErrorCtx(ctx, "export interrupted", "error", err, "rows", rows)
+			return
+		}
+
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	if rows == 0 {
+		if err := begin(); err != nil {
+			logger.ErrorCtx(ctx, "failed to write export", "error", err)
+			return
+		}
+	}
+
+	if err := flush(); err != nil {
+		logger.ErrorCtx(ctx, "failed to write export", "error", err, "rows", rows)
+	}
+}
+
+func mapErrorToHTTP(err error) (msg string, statusCode int) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidExportPeriod):
+		msg = err.Error()
+		statusCode = http.StatusBadRequest
+
+	default:
+		statusCode = http.StatusInternalServerError
+		msg = "internal server error"
+	}
+
+	return msg, statusCode
+}
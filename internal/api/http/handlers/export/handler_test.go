@@ -0,0 +1,228 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/export/mocks"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExportHandlers_Receptions(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dateTime := time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC)
+
+	rows := []domain.ReceptionExportRow{
+		{
+			ReceptionID:       uuid.New(),
+			PvzID:             uuid.New(),
+			City:              "Москва",
+			ReceptionDateTime: dateTime,
+			Status:            domain.ReceptionStatusClose,
+			ProductID:         uuid.New(),
+			ProductDateTime:   dateTime.Add(time.Minute),
+			ProductType:       "обувь",
+			Barcode:           "4600000000001",
+			Issued:            true,
+		},
+		{
+			ReceptionID:       uuid.New(),
+			PvzID:             uuid.New(),
+			City:              "Казань",
+			ReceptionDateTime: dateTime,
+			Status:            domain.ReceptionStatusInProgress,
+		},
+	}
+
+	streamRows := func(rows []domain.ReceptionExportRow, err error) func(context.Context, dto.ReceptionExport, func(domain.ReceptionExportRow) error) error {
+		return func(_ context.Context, _ dto.ReceptionExport, fn func(domain.ReceptionExportRow) error) error {
+			for _, row := range rows {
+				if err := fn(row); err != nil {
+					return err
+				}
+			}
+			return err
+		}
+	}
+
+	testcases := []struct {
+		name                string
+		requestQuery        string
+		exportServiceMock   func(*mocks.MockexportService)
+		expectedCode        int
+		expectedContentType string
+		checkBody           func(t *testing.T, body string)
+		expectedError       *response.Error
+	}{
+		{
+			name:         "csv by default",
+			requestQuery: "?startDate=2026-02-01T00:00:00Z&endDate=2026-02-28T00:00:00Z",
+			exportServiceMock: func(service *mocks.MockexportService) {
+				service.
+					EXPECT().
+					Export(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, exportIn dto.ReceptionExport, fn func(domain.ReceptionExportRow) error) error {
+						require.NotNil(t, exportIn.StartDate)
+						require.NotNil(t, exportIn.EndDate)
+						return streamRows(rows, nil)(ctx, exportIn, fn)
+					})
+			},
+			expectedCode:        http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			checkBody: func(t *testing.T, body string) {
+				records, err := csv.NewReader(strings.NewReader(body)).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 3)
+
+				assert.Equal(t, csvHeader, records[0])
+				assert.Equal(t, []string{
+					rows[0].ReceptionID.String(), rows[0].PvzID.String(), "Москва", "2026-02-11T10:30:00Z", "close",
+					rows[0].ProductID.String(), "2026-02-11T10:31:00Z", "обувь", "4600000000001", "true",
+				}, records[1])
+				assert.Equal(t, []string{
+					rows[1].ReceptionID.String(), rows[1].PvzID.String(), "Казань", "2026-02-11T10:30:00Z", "in_progress",
+					"", "", "", "", "",
+				}, records[2])
+			},
+		},
+		{
+			name:         "ndjson",
+			requestQuery: "?format=ndjson",
+			exportServiceMock: func(service *mocks.MockexportService) {
+				service.
+					EXPECT().
+					Export(gomock.Any(), dto.ReceptionExport{}, gomock.Any()).
+					DoAndReturn(streamRows(rows, nil))
+			},
+			expectedCode:        http.StatusOK,
+			expectedContentType: "application/x-ndjson",
+			checkBody: func(t *testing.T, body string) {
+				var got []RowResponse
+				sc := bufio.NewScanner(strings.NewReader(body))
+				for sc.Scan() {
+					var row RowResponse
+					require.NoError(t, json.Unmarshal(sc.Bytes(), &row))
+					got = append(got, row)
+				}
+				require.NoError(t, sc.Err())
+
+				assert.Equal(t, []RowResponse{ToRowResponse(rows[0]), ToRowResponse(rows[1])}, got)
+				assert.Nil(t, got[1].ProductID)
+			},
+		},
+		{
+			name:         "empty export has csv header",
+			requestQuery: "",
+			exportServiceMock: func(service *mocks.MockexportService) {
+				service.
+					EXPECT().
+					Export(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+			expectedCode:        http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			checkBody: func(t *testing.T, body string) {
+				assert.Equal(t, strings.Join(csvHeader, ",")+"\n", body)
+			},
+		},
+		{
+			name:         "error after rows truncates export",
+			requestQuery: "?format=ndjson",
+			exportServiceMock: func(service *mocks.MockexportService) {
+				service.
+					EXPECT().
+					Export(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(streamRows(rows[:1], errors.New("connection reset")))
+			},
+			expectedCode:        http.StatusOK,
+			expectedContentType: "application/x-ndjson",
+		},
+		{
+			name:              "invalid format",
+			requestQuery:      "?format=xml",
+			exportServiceMock: func(service *mocks.MockexportService) {},
+			expectedCode:      http.StatusBadRequest,
+			expectedError:     &response.Error{Message: "invalid format"},
+		},
+		{
+			name:              "invalid startDate",
+			requestQuery:      "?startDate=yesterday",
+			exportServiceMock: func(service *mocks.MockexportService) {},
+			expectedCode:      http.StatusBadRequest,
+			expectedError:     &response.Error{Message: "invalid startDate"},
+		},
+		{
+			name:         "start after end",
+			requestQuery: "?startDate=2026-03-01T00:00:00Z&endDate=2026-02-01T00:00:00Z",
+			exportServiceMock: func(service *mocks.MockexportService) {
+				service.
+					EXPECT().
+					Export(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(domain.ErrInvalidExportPeriod)
+			},
+			expectedCode:  http.StatusBadRequest,
+			expectedError: &response.Error{Message: domain.ErrInvalidExportPeriod.Error()},
+		},
+		{
+			name:         "service error before rows",
+			requestQuery: "",
+			exportServiceMock: func(service *mocks.MockexportService) {
+				service.
+					EXPECT().
+					Export(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("db error"))
+			},
+			expectedCode:  http.StatusInternalServerError,
+			expectedError: &response.Error{Message: "internal server error"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			exportService := mocks.NewMockexportService(ctrl)
+			tc.exportServiceMock(exportService)
+
+			handler := New(exportService)
+
+			req := httptest.NewRequest(http.MethodGet, "/export/receptions"+tc.requestQuery, nil)
+			w := httptest.NewRecorder()
+
+			handler.Receptions(w, req)
+
+			require.Equal(t, tc.expectedCode, w.Code)
+
+			if tc.expectedError != nil {
+				var resp response.Error
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tc.expectedError.Message, resp.Message)
+				return
+			}
+
+			assert.Equal(t, tc.expectedContentType, w.Header().Get("Content-Type"))
+			assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment")
+
+			if tc.checkBody != nil {
+				tc.checkBody(t, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package export
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+)
+
+type ExportParams struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	Format    Format
+}
+
+func getParseExportParam(r *http.Request) (ExportParams, error) {
+	q := r.URL.Query()
+
+	params := ExportParams{Format: FormatCSV}
+
+	if v := q.Get("startDate"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return params, errors.New("invalid startDate")
+		}
+		params.StartDate = &t
+	}
+
+	if v := q.Get("endDate"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return params, errors.New("invalid endDate")
+		}
+		params.EndDate = &t
+	}
+
+	format, err := parseFormat(q)
+	if err != nil {
+		return params, err
+	}
+	params.Format = format
+
+	return params, nil
+}
+
+func parseFormat(q url.Values) (Format, error) {
+	switch v := Format(q.Get("format")); v {
+	case "":
+		return FormatCSV, nil
+	case FormatCSV, FormatNDJSON:
+		return v, nil
+	default:
+		return "", errors.New("invalid format")
+	}
+}
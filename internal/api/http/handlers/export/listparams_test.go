@@ -0,0 +1,58 @@
+package export
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getParseExportParam(t *testing.T) {
+	start := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		query       string
+		expect      ExportParams
+		expectError string
+	}{
+		{
+			name:   "defaults",
+			query:  "",
+			expect: ExportParams{Format: FormatCSV},
+		},
+		{
+			name:   "start date and ndjson",
+			query:  "?startDate=2026-02-01T00:00:00Z&format=ndjson",
+			expect: ExportParams{StartDate: &start, Format: FormatNDJSON},
+		},
+		{
+			name:        "invalid endDate",
+			query:       "?endDate=2026-02-01",
+			expectError: "invalid endDate",
+		},
+		{
+			name:        "invalid format",
+			query:       "?format=CSV",
+			expectError: "invalid format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/export/receptions"+tt.query, nil)
+
+			got, err := getParseExportParam(req)
+			if tt.expectError != "" {
+				require.EqualError(t, err, tt.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, got)
+		})
+	}
+}
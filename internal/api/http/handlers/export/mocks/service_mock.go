@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/valeragav/avito-pvz-service/internal/domain"
+	dto "github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockexportService is a mock of exportService interface.
+type MockexportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockexportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockexportServiceMockRecorder is the mock recorder for MockexportService.
+type MockexportServiceMockRecorder struct {
+	mock *MockexportService
+}
+
+// NewMockexportService creates a new mock instance.
+func NewMockexportService(ctrl *gomock.Controller) *MockexportService {
+	mock := &MockexportService{ctrl: ctrl}
+	mock.recorder = &MockexportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexportService) EXPECT() *MockexportServiceMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockexportService) Export(ctx context.Context, exportIn dto.ReceptionExport, fn func(domain.ReceptionExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, exportIn, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockexportServiceMockRecorder) Export(ctx, exportIn, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockexportService)(nil).Export), ctx, exportIn, fn)
+}
@@ -0,0 +1,91 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+// rowWriter пишет строки выгрузки в буфер, Flush отправляет накопленное в w.
+type rowWriter interface {
+	Begin() error
+	Write(row domain.ReceptionExportRow) error
+	Flush() error
+}
+
+func newRowWriter(format Format, w io.Writer) rowWriter {
+	if format == FormatNDJSON {
+		buf := bufio.NewWriter(w)
+		return &ndjsonWriter{buf: buf, enc: json.NewEncoder(buf)}
+	}
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func contentType(format Format) string {
+	if format == FormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+var csvHeader = []string{
+	"receptionId", "pvzId", "city", "receptionDateTime", "status",
+	"productId", "productDateTime", "productType", "barcode", "issued",
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) Begin() error {
+	return c.w.Write(csvHeader)
+}
+
+func (c *csvWriter) Write(row domain.ReceptionExportRow) error {
+	record := []string{
+		row.ReceptionID.String(),
+		row.PvzID.String(),
+		row.City,
+		row.ReceptionDateTime.Format(time.RFC3339Nano),
+		string(row.Status),
+		"", "", "", "", "",
+	}
+
+	if row.ProductID != uuid.Nil {
+		record[5] = row.ProductID.String()
+		record[6] = row.ProductDateTime.Format(time.RFC3339Nano)
+		record[7] = row.ProductType
+		record[8] = row.Barcode
+		record[9] = strconv.FormatBool(row.Issued)
+	}
+
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type ndjsonWriter struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func (n *ndjsonWriter) Begin() error {
+	return nil
+}
+
+func (n *ndjsonWriter) Write(row domain.ReceptionExportRow) error {
+	return n.enc.Encode(ToRowResponse(row))
+}
+
+func (n *ndjsonWriter) Flush() error {
+	return n.buf.Flush()
+}
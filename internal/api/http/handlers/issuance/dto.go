@@ -0,0 +1,74 @@
+package issuance
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+)
+
+type IssueRequest struct {
+	ProductID uuid.UUID `json:"productId" validate:"required,uuid"`
+	Customer  string    `json:"customer" validate:"required,max=255"`
+}
+
+type ReturnRequest struct {
+	ProductID uuid.UUID `json:"productId" validate:"required,uuid"`
+	Reason    string    `json:"reason,omitempty" validate:"omitempty,max=1000"`
+}
+
+type Response struct {
+	ID        uuid.UUID  `json:"id"`
+	ProductID uuid.UUID  `json:"productId"`
+	PvzID     uuid.UUID  `json:"pvzId"`
+	Kind      string     `json:"kind" enums:"issue,return"`
+	IssueID   *uuid.UUID `json:"issueId,omitempty"`
+	Customer  string     `json:"customer,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+	ActorID   uuid.UUID  `json:"actorId"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func ToIssueIn(req IssueRequest, issuedBy uuid.UUID) dto.IssuanceCreate {
+	return dto.IssuanceCreate{
+		ProductID: req.ProductID,
+		Customer:  req.Customer,
+		IssuedBy:  issuedBy,
+	}
+}
+
+func ToReturnIn(req ReturnRequest, returnedBy uuid.UUID) dto.IssuanceReturn {
+	return dto.IssuanceReturn{
+		ProductID:  req.ProductID,
+		Reason:     req.Reason,
+		ReturnedBy: returnedBy,
+	}
+}
+
+func ToResponse(out domain.Issuance) Response {
+	var issueID *uuid.UUID
+	if out.IssueID != uuid.Nil {
+		issueID = &out.IssueID
+	}
+
+	return Response{
+		ID:        out.ID,
+		ProductID: out.ProductID,
+		PvzID:     out.PvzID,
+		Kind:      string(out.Kind),
+		IssueID:   issueID,
+		Customer:  out.Customer,
+		Reason:    out.Reason,
+		ActorID:   out.ActorID,
+		CreatedAt: out.CreatedAt,
+	}
+}
+
+func ToListResponse(outs []*domain.Issuance) []Response {
+	res := make([]Response, 0, len(outs))
+	for _, out := range outs {
+		res = append(res, ToResponse(*out))
+	}
+	return res
+}
@@ -0,0 +1,181 @@
+package issuance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+	"github.com/valeragav/avito-pvz-service/pkg/validation"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
+type issuanceService interface {
+	Issue(ctx context.Context, issueIn dto.IssuanceCreate) (*domain.Issuance, error)
+	Return(ctx context.Context, returnIn dto.IssuanceReturn) (*domain.Issuance, error)
+	ListByProduct(ctx context.Context, productID uuid.UUID) ([]*domain.Issuance, error)
+}
+
+type IssuanceHandlers struct {
+	validator       *validation.Validator
+	issuanceService issuanceService
+}
+
+func New(validator *validation.Validator, issuanceService issuanceService) *IssuanceHandlers {
+	return &IssuanceHandlers{
+		validator,
+		issuanceService,
+	}
+}
+
+// @Summary Issue product to customer
+// @Description Hands a received product over to a customer. Only products of closed receptions can be issued. Requires JWT-Token with Employee role.
+// @ID IssueProduct
+// @Tags Issuances
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param input body IssueRequest true "Issuance data"
+// @Success 201 {object} Response "Product issued"
+// @Failure 400 {object} response.Error "Invalid request or validation failed"
+// @Failure 404 {object} response.Error "Product not found"
+// @Failure 409 {object} response.Error "Product is already issued or not received yet"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /issuances [post]
+func (h *IssuanceHandlers) Issue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req IssueRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	issuance, err := h.issuanceService.Issue(ctx, ToIssueIn(req, claims.UserID))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusCreated, ToResponse(*issuance))
+}
+
+// @Summary Return issued product
+// @Description Accepts an issued product back from the customer, the product is on hand in the PVZ again. The return links to the original issuance. Requires JWT-Token with Employee role.
+// @ID ReturnProduct
+// @Tags Issuances
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param input body ReturnRequest true "Return data"
+// @Success 201 {object} Response "Product returned"
+// @Failure 400 {object} response.Error "Invalid request or validation failed"
+// @Failure 404 {object} response.Error "Product not found"
+// @Failure 409 {object} response.Error "Product is not issued"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /issuances/returns [post]
+func (h *IssuanceHandlers) Return(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req ReturnRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	issuance, err := h.issuanceService.Return(ctx, ToReturnIn(req, claims.UserID))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusCreated, ToResponse(*issuance))
+}
+
+// @Summary List product issuances
+// @Description Get issue and return history of the product in chronological order. Requires JWT-Token with Employee or Moderator role.
+// @ID ListIssuances
+// @Tags Issuances
+// @Security ApiKeyAuth
+// @Produce json
+// @Param productId query string true "Product ID (UUID)"
+// @Success 200 {array} Response "Issuance history"
+// @Failure 400 {object} response.Error "Invalid productId format"
+// @Failure 404 {object} response.Error "Product not found"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /issuances [get]
+func (h *IssuanceHandlers) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := uuid.Parse(r.URL.Query().Get("productId"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid productId format", nil)
+		return
+	}
+
+	issuances, err := h.issuanceService.ListByProduct(ctx, productID)
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToListResponse(issuances))
+}
+
+func (h *IssuanceHandlers) decode(w http.ResponseWriter, r *http.Request, req any) bool {
+	ctx := r.Context()
+
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		if errors.Is(err, io.EOF) {
+			response.WriteError(w, ctx, http.StatusBadRequest, "request body is empty", nil)
+			return false
+		}
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid request body", err)
+		return false
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return false
+	}
+
+	return true
+}
+
+func mapErrorToHTTP(err error) (msg string, statusCode int) {
+	switch {
+	case errors.Is(err, domain.ErrProductNotFound):
+		msg = err.Error()
+		statusCode = http.StatusNotFound
+
+	case errors.Is(err, domain.ErrProductAlreadyIssued),
+		errors.Is(err, domain.ErrProductNotIssued),
+		errors.Is(err, domain.ErrProductNotReceived):
+		msg = err.Error()
+		statusCode = http.StatusConflict
+
+	default:
+		statusCode = http.StatusInternalServerError
+		msg = "internal server error"
+	}
+
+	return msg, statusCode
+}
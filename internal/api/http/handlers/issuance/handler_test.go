@@ -0,0 +1,359 @@
+package issuance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/issuance/mocks"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"github.com/valeragav/avito-pvz-service/pkg/validation"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIssuanceHandlers_Issue(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	userID := uuid.New()
+	productID := uuid.New()
+
+	issuance := &domain.Issuance{
+		ID:        uuid.New(),
+		ProductID: productID,
+		PvzID:     uuid.New(),
+		Kind:      domain.IssuanceKindIssue,
+		Customer:  "Ivanov",
+		ActorID:   userID,
+		CreatedAt: time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC),
+	}
+	issued := ToResponse(*issuance)
+
+	testcases := []struct {
+		name          string
+		body          string
+		serviceMock   func(*mocks.MockissuanceService)
+		expectedCode  int
+		expected      *Response
+		expectedError *response.Error
+	}{
+		{
+			name:         "successful issue",
+			body:         `{"productId":"` + productID.String() + `","customer":"Ivanov"}`,
+			expectedCode: http.StatusCreated,
+			serviceMock: func(service *mocks.MockissuanceService) {
+				service.
+					EXPECT().
+					Issue(gomock.Any(), dto.IssuanceCreate{
+						ProductID: productID,
+						Customer:  "Ivanov",
+						IssuedBy:  userID,
+					}).
+					Return(issuance, nil)
+			},
+			expected: &issued,
+		},
+		{
+			name:         "empty body",
+			body:         ``,
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "request body is empty",
+			},
+		},
+		{
+			name:         "missing customer",
+			body:         `{"productId":"` + productID.String() + `"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "product not received",
+			body:         `{"productId":"` + productID.String() + `","customer":"Ivanov"}`,
+			expectedCode: http.StatusConflict,
+			serviceMock: func(service *mocks.MockissuanceService) {
+				service.EXPECT().Issue(gomock.Any(), gomock.Any()).Return(nil, domain.ErrProductNotReceived)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrProductNotReceived.Error(),
+				Details: domain.ErrProductNotReceived.Error(),
+			},
+		},
+		{
+			name:         "product already issued",
+			body:         `{"productId":"` + productID.String() + `","customer":"Ivanov"}`,
+			expectedCode: http.StatusConflict,
+			serviceMock: func(service *mocks.MockissuanceService) {
+				service.EXPECT().Issue(gomock.Any(), gomock.Any()).Return(nil, domain.ErrProductAlreadyIssued)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrProductAlreadyIssued.Error(),
+				Details: domain.ErrProductAlreadyIssued.Error(),
+			},
+		},
+		{
+			name:         "product not found",
+			body:         `{"productId":"` + productID.String() + `","customer":"Ivanov"}`,
+			expectedCode: http.StatusNotFound,
+			serviceMock: func(service *mocks.MockissuanceService) {
+				service.EXPECT().Issue(gomock.Any(), gomock.Any()).Return(nil, domain.ErrProductNotFound)
+			},
+		},
+		{
+			name:         "service error",
+			body:         `{"productId":"` + productID.String() + `","customer":"Ivanov"}`,
+			expectedCode: http.StatusInternalServerError,
+			serviceMock: func(service *mocks.MockissuanceService) {
+				service.EXPECT().Issue(gomock.Any(), gomock.Any()).Return(nil, errors.New("storage error"))
+			},
+			expectedError: &response.Error{
+				Message: "internal server error",
+				Details: "storage error",
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			issuanceServiceMock := mocks.NewMockissuanceService(ctrl)
+			handler := New(valid, issuanceServiceMock)
+
+			if tt.serviceMock != nil {
+				tt.serviceMock(issuanceServiceMock)
+			}
+
+			req := httptest.NewRequest("POST", "/issuances", strings.NewReader(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole}))
+
+			w := httptest.NewRecorder()
+			handler.Issue(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res Response
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+
+				assert.Equal(t, *tt.expected, res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestIssuanceHandlers_Return(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	userID := uuid.New()
+	productID := uuid.New()
+	issueID := uuid.New()
+
+	returned := &domain.Issuance{
+		ID:        uuid.New(),
+		ProductID: productID,
+		PvzID:     uuid.New(),
+		Kind:      domain.IssuanceKindReturn,
+		IssueID:   issueID,
+		Customer:  "Ivanov",
+		Reason:    "damaged",
+		ActorID:   userID,
+		CreatedAt: time.Date(2026, time.March, 3, 12, 0, 0, 0, time.UTC),
+	}
+
+	testcases := []struct {
+		name          string
+		body          string
+		serviceMock   func(*mocks.MockissuanceService)
+		expectedCode  int
+		expected      *Response
+		expectedError *response.Error
+	}{
+		{
+			name:         "successful return",
+			body:         `{"productId":"` + productID.String() + `","reason":"damaged"}`,
+			expectedCode: http.StatusCreated,
+			serviceMock: func(service *mocks.MockissuanceService) {
+				service.
+					EXPECT().
+					Return(gomock.Any(), dto.IssuanceReturn{
+						ProductID:  productID,
+						Reason:     "damaged",
+						ReturnedBy: userID,
+					}).
+					Return(returned, nil)
+			},
+			expected: &Response{
+				ID:        returned.ID,
+				ProductID: productID,
+				PvzID:     returned.PvzID,
+				Kind:      "return",
+				IssueID:   &issueID,
+				Customer:  "Ivanov",
+				Reason:    "damaged",
+				ActorID:   userID,
+				CreatedAt: returned.CreatedAt,
+			},
+		},
+		{
+			name:         "invalid body",
+			body:         `{"productId":`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "product not issued",
+			body:         `{"productId":"` + productID.String() + `"}`,
+			expectedCode: http.StatusConflict,
+			serviceMock: func(service *mocks.MockissuanceService) {
+				service.EXPECT().Return(gomock.Any(), gomock.Any()).Return(nil, domain.ErrProductNotIssued)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrProductNotIssued.Error(),
+				Details: domain.ErrProductNotIssued.Error(),
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			issuanceServiceMock := mocks.NewMockissuanceService(ctrl)
+			handler := New(valid, issuanceServiceMock)
+
+			if tt.serviceMock != nil {
+				tt.serviceMock(issuanceServiceMock)
+			}
+
+			req := httptest.NewRequest("POST", "/issuances/returns", strings.NewReader(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole}))
+
+			w := httptest.NewRecorder()
+			handler.Return(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res Response
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+
+				assert.Equal(t, *tt.expected, res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestIssuanceHandlers_List(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	productID := uuid.New()
+
+	issuances := []*domain.Issuance{
+		{ID: uuid.New(), ProductID: productID, Kind: domain.IssuanceKindIssue, Customer: "Ivanov"},
+	}
+
+	testcases := []struct {
+		name          string
+		query         string
+		serviceMock   func(*mocks.MockissuanceService)
+		expectedCode  int
+		expected      []Response
+		expectedError *response.Error
+	}{
+		{
+			name:         "successful list",
+			query:        "?productId=" + productID.String(),
+			expectedCode: http.StatusOK,
+			serviceMock: func(service *mocks.MockissuanceService) {
+				service.EXPECT().ListByProduct(gomock.Any(), productID).Return(issuances, nil)
+			},
+			expected: ToListResponse(issuances),
+		},
+		{
+			name:         "missing product id",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid productId format",
+			},
+		},
+		{
+			name:         "product not found",
+			query:        "?productId=" + productID.String(),
+			expectedCode: http.StatusNotFound,
+			serviceMock: func(service *mocks.MockissuanceService) {
+				service.EXPECT().ListByProduct(gomock.Any(), productID).Return(nil, domain.ErrProductNotFound)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrProductNotFound.Error(),
+				Details: domain.ErrProductNotFound.Error(),
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			issuanceServiceMock := mocks.NewMockissuanceService(ctrl)
+			handler := New(valid, issuanceServiceMock)
+
+			if tt.serviceMock != nil {
+				tt.serviceMock(issuanceServiceMock)
+			}
+
+			req := httptest.NewRequest("GET", "/issuances"+tt.query, nil)
+
+			w := httptest.NewRecorder()
+			handler.List(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res []Response
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expected, res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
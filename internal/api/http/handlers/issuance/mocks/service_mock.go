@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: handler.go
+//
+// Generated by this command:
+//
+//	mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	domain "github.com/valeragav/avito-pvz-service/internal/domain"
+	dto "github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockissuanceService is a mock of issuanceService interface.
+type MockissuanceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockissuanceServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockissuanceServiceMockRecorder is the mock recorder for MockissuanceService.
+type MockissuanceServiceMockRecorder struct {
+	mock *MockissuanceService
+}
+
+// NewMockissuanceService creates a new mock instance.
+func NewMockissuanceService(ctrl *gomock.Controller) *MockissuanceService {
+	mock := &MockissuanceService{ctrl: ctrl}
+	mock.recorder = &MockissuanceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockissuanceService) EXPECT() *MockissuanceServiceMockRecorder {
+	return m.recorder
+}
+
+// Issue mocks base method.
+func (m *MockissuanceService) Issue(ctx context.Context, issueIn dto.IssuanceCreate) (*domain.Issuance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, issueIn)
+	ret0, _ := ret[0].(*domain.Issuance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockissuanceServiceMockRecorder) Issue(ctx, issueIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockissuanceService)(nil).Issue), ctx, issueIn)
+}
+
+// ListByProduct mocks base method.
+func (m *MockissuanceService) ListByProduct(ctx context.Context, productID uuid.UUID) ([]*domain.Issuance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByProduct", ctx, productID)
+	ret0, _ := ret[0].([]*domain.Issuance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByProduct indicates an expected call of ListByProduct.
+func (mr *MockissuanceServiceMockRecorder) ListByProduct(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProduct", reflect.TypeOf((*MockissuanceService)(nil).ListByProduct), ctx, productID)
+}
+
+// Return mocks base method.
+func (m *MockissuanceService) Return(ctx context.Context, returnIn dto.IssuanceReturn) (*domain.Issuance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Return", ctx, returnIn)
+	ret0, _ := ret[0].(*domain.Issuance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Return indicates an expected call of Return.
+func (mr *MockissuanceServiceMockRecorder) Return(ctx, returnIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Return", reflect.TypeOf((*MockissuanceService)(nil).Return), ctx, returnIn)
+}
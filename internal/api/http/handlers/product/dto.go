@@ -9,8 +9,9 @@ import (
 )
 
 type CreateRequest struct {
-	Type  string    `json:"type" validate:"required,max=255"`
-	PvzID uuid.UUID `json:"pvzId" validate:"required,uuid"`
+	Type    string    `json:"type" validate:"required,max=255"`
+	Barcode string    `json:"barcode,omitempty" validate:"omitempty,max=64"`
+	PvzID   uuid.UUID `json:"pvzId" validate:"required,uuid"`
 }
 
 type CreateResponse struct {
@@ -18,12 +19,84 @@ type CreateResponse struct {
 	Type        string    `json:"type"`
 	ReceptionID uuid.UUID `json:"receptionId"`
 	DateTime    time.Time `json:"dateTime"`
+	Barcode     string    `json:"barcode,omitempty"`
 }
 
-func ToCreateIn(req CreateRequest) dto.ProductCreate {
+type BatchItemRequest struct {
+	Type    string `json:"type" validate:"required,max=255"`
+	Barcode string `json:"barcode,omitempty" validate:"omitempty,max=64"`
+}
+
+type BatchCreateRequest struct {
+	PvzID        uuid.UUID          `json:"pvzId" validate:"required,uuid"`
+	Items        []BatchItemRequest `json:"items" validate:"required,min=1,max=100,dive"`
+	AllOrNothing bool               `json:"allOrNothing"`
+}
+
+type BatchItemResponse struct {
+	Index   int             `json:"index"`
+	Product *CreateResponse `json:"product,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+type BatchCreateResponse struct {
+	Created int                 `json:"created"`
+	Failed  int                 `json:"failed"`
+	Items   []BatchItemResponse `json:"items"`
+}
+
+type LocationResponse struct {
+	Product   CreateResponse            `json:"product"`
+	Reception LocationReceptionResponse `json:"reception"`
+	Pvz       LocationPvzResponse       `json:"pvz"`
+}
+
+type LocationReceptionResponse struct {
+	ID       uuid.UUID `json:"id"`
+	DateTime time.Time `json:"dateTime"`
+	Status   string    `json:"status"`
+}
+
+type LocationPvzResponse struct {
+	ID               uuid.UUID `json:"id"`
+	RegistrationDate time.Time `json:"registrationDate"`
+	CityID           uuid.UUID `json:"cityId"`
+}
+
+func ToCreateIn(req CreateRequest, createdBy uuid.UUID) dto.ProductCreate {
 	return dto.ProductCreate{
-		TypeName: req.Type,
-		PvzID:    req.PvzID,
+		TypeName:  req.Type,
+		Barcode:   req.Barcode,
+		PvzID:     req.PvzID,
+		CreatedBy: createdBy,
+	}
+}
+
+func ToBatchCreateIn(req BatchCreateRequest, createdBy uuid.UUID) dto.ProductBatchCreate {
+	items := make([]dto.ProductBatchItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, dto.ProductBatchItem{TypeName: item.Type, Barcode: item.Barcode})
+	}
+
+	return dto.ProductBatchCreate{
+		PvzID:        req.PvzID,
+		Items:        items,
+		CreatedBy:    createdBy,
+		AllOrNothing: req.AllOrNothing,
+	}
+}
+
+func ToDeleteLastIn(pvzID, deletedBy uuid.UUID) dto.ProductDeleteLast {
+	return dto.ProductDeleteLast{
+		PvzID:     pvzID,
+		DeletedBy: deletedBy,
+	}
+}
+
+func ToDeleteIn(productID, deletedBy uuid.UUID) dto.ProductDelete {
+	return dto.ProductDelete{
+		ProductID: productID,
+		DeletedBy: deletedBy,
 	}
 }
 
@@ -38,5 +111,48 @@ func ToCreateResponse(out domain.Product) CreateResponse {
 		Type:        typeName,
 		ReceptionID: out.ReceptionID,
 		DateTime:    out.DateTime,
+		Barcode:     out.Barcode,
+	}
+}
+
+func ToLocationResponse(out dto.ProductLocation) LocationResponse {
+	var status string
+	if out.Reception.ReceptionStatus != nil {
+		status = string(out.Reception.ReceptionStatus.Name)
+	}
+
+	return LocationResponse{
+		Product: ToCreateResponse(*out.Product),
+		Reception: LocationReceptionResponse{
+			ID:       out.Reception.ID,
+			DateTime: out.Reception.DateTime,
+			Status:   status,
+		},
+		Pvz: LocationPvzResponse{
+			ID:               out.PVZ.ID,
+			RegistrationDate: out.PVZ.RegistrationDate,
+			CityID:           out.PVZ.CityID,
+		},
 	}
 }
+
+func ToBatchCreateResponse(out []dto.ProductBatchResult) BatchCreateResponse {
+	res := BatchCreateResponse{
+		Items: make([]BatchItemResponse, 0, len(out)),
+	}
+
+	for i, item := range out {
+		itemRes := BatchItemResponse{Index: i}
+		if item.Err != nil {
+			itemRes.Error = item.Err.Error()
+			res.Failed++
+		} else {
+			product := ToCreateResponse(*item.Product)
+			itemRes.Product = &product
+			res.Created++
+		}
+		res.Items = append(res.Items, itemRes)
+	}
+
+	return res
+}
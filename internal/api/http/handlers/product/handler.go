@@ -10,6 +10,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/internal/metrics"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
@@ -20,7 +21,10 @@ import (
 //go:generate ${LOCAL_BIN}/mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
 type productService interface {
 	Create(ctx context.Context, createIn dto.ProductCreate) (*domain.Product, error)
-	DeleteLastProduct(ctx context.Context, pvzID uuid.UUID) (*domain.Product, error)
+	CreateBatch(ctx context.Context, createIn dto.ProductBatchCreate) ([]dto.ProductBatchResult, error)
+	GetByBarcode(ctx context.Context, barcode string) (*dto.ProductLocation, error)
+	DeleteLastProduct(ctx context.Context, deleteIn dto.ProductDeleteLast) (*domain.Product, error)
+	DeleteProduct(ctx context.Context, deleteIn dto.ProductDelete) (*domain.Product, error)
 }
 
 type ProductHandlers struct {
@@ -65,7 +69,9 @@ func (h *ProductHandlers) DeleteLastProduct(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	_, err = h.productService.DeleteLastProduct(ctx, pvzID)
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	_, err = h.productService.DeleteLastProduct(ctx, ToDeleteLastIn(pvzID, claims.UserID))
 	if err != nil {
 		mess, code := mapErrorToHTTP(err)
 
@@ -77,8 +83,44 @@ func (h *ProductHandlers) DeleteLastProduct(w http.ResponseWriter, r *http.Reque
 	response.WriteJSON(w, ctx, http.StatusOK, nil)
 }
 
+// @Summary Delete product by ID
+// @Description Deletes any product of the currently open reception. Products of closed or cancelled receptions and products with issuance history can not be deleted. Requires JWT-Token with Employee role.
+// @ID DeleteProduct
+// @Tags Product
+// @Security ApiKeyAuth
+// @Produce json
+// @Param productID path string true "Product ID (UUID)"
+// @Success 204 "Successfully deleted"
+// @Failure 400 {object} response.Error "Invalid productID format"
+// @Failure 404 {object} response.Error "Product not found"
+// @Failure 409 {object} response.Error "Reception of the product is not open or product has issuance history"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /products/{productID} [delete]
+func (h *ProductHandlers) DeleteProduct(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	productID, err := uuid.Parse(chi.URLParam(r, "productID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid productID format", nil)
+		return
+	}
+
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	_, err = h.productService.DeleteProduct(ctx, ToDeleteIn(productID, claims.UserID))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // @Summary Create a new product
-// @Description Creates a new product in the PVZ system.
+// @Description Creates a new product in the PVZ system. Fails with 409 when the PVZ capacity or the capacity for the product type is already reached by products on hand.
 // @ID CreateProduct
 // @Tags Product
 // @Security ApiKeyAuth
@@ -88,7 +130,7 @@ func (h *ProductHandlers) DeleteLastProduct(w http.ResponseWriter, r *http.Reque
 // @Success 201 {object} CreateResponse "Product successfully created"
 // @Failure 400 {object} response.Error "Invalid request or validation failed"
 // @Failure 400 {object} response.Error "No reception is currently in progress"
-// @Failure 409 {object} response.Error "Product already exists or conflict error"
+// @Failure 409 {object} response.Error "Product already exists, barcode is already scanned, PVZ is not active or PVZ capacity is exceeded"
 // @Failure 500 {object} response.Error "Internal server error"
 // @Router /products [post]
 func (h *ProductHandlers) Create(w http.ResponseWriter, r *http.Request) {
@@ -109,7 +151,9 @@ func (h *ProductHandlers) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	productRes, err := h.productService.Create(ctx, ToCreateIn(req))
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	productRes, err := h.productService.Create(ctx, ToCreateIn(req, claims.UserID))
 	if err != nil {
 		mess, code := mapErrorToHTTP(err)
 
@@ -124,6 +168,94 @@ func (h *ProductHandlers) Create(w http.ResponseWriter, r *http.Request) {
 	response.WriteJSON(w, ctx, http.StatusCreated, res)
 }
 
+// @Summary Create products in batch
+// @Description Adds up to 100 products to the open reception of one PVZ in a single transaction. With allOrNothing the whole batch fails on the first invalid item, otherwise invalid items are reported in the per-item results. Items beyond the PVZ capacity or the capacity for their product type are rejected.
+// @ID CreateProductBatch
+// @Tags Product
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param input body BatchCreateRequest true "Product batch payload"
+// @Success 201 {object} BatchCreateResponse "All products created"
+// @Success 200 {object} BatchCreateResponse "Some products were not created"
+// @Failure 400 {object} response.Error "Invalid request or unknown product type"
+// @Failure 404 {object} response.Error "PVZ not found"
+// @Failure 409 {object} response.Error "No reception is currently in progress, PVZ is not active or PVZ capacity is exceeded"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /products/batch [post]
+func (h *ProductHandlers) CreateBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req BatchCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		if errors.Is(err, io.EOF) {
+			response.WriteError(w, ctx, http.StatusBadRequest, "request body is empty", nil)
+			return
+		}
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	results, err := h.productService.CreateBatch(ctx, ToBatchCreateIn(req, claims.UserID))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	res := ToBatchCreateResponse(results)
+	metrics.CreatedProductsAdd(res.Created)
+
+	code := http.StatusCreated
+	if res.Failed > 0 {
+		code = http.StatusOK
+	}
+
+	response.WriteJSON(w, ctx, code, res)
+}
+
+// @Summary Find product by barcode
+// @Description Returns the last accepted product with the barcode together with the reception and PVZ that accepted it.
+// @ID GetProductByBarcode
+// @Tags Product
+// @Security ApiKeyAuth
+// @Produce json
+// @Param code path string true "Product barcode"
+// @Success 200 {object} LocationResponse "Product location"
+// @Failure 400 {object} response.Error "Invalid barcode"
+// @Failure 404 {object} response.Error "Product not found"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /products/by-barcode/{code} [get]
+func (h *ProductHandlers) GetByBarcode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	code := chi.URLParam(r, "code")
+	if code == "" || len(code) > 64 {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid barcode", nil)
+		return
+	}
+
+	location, err := h.productService.GetByBarcode(ctx, code)
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToLocationResponse(*location))
+}
+
 func mapErrorToHTTP(err error) (msg string, statusCode int) {
 	switch {
 	case errors.Is(err, domain.ErrNoReceptionIsCurrentlyInProgress):
@@ -134,6 +266,27 @@ func mapErrorToHTTP(err error) (msg string, statusCode int) {
 		msg = err.Error()
 		statusCode = http.StatusConflict
 
+	case errors.Is(err, domain.ErrProductBatchSize), errors.Is(err, domain.ErrProductTypeNotFound):
+		msg = err.Error()
+		statusCode = http.StatusBadRequest
+
+	case errors.Is(err, domain.ErrProductBarcodeDuplicate):
+		msg = err.Error()
+		statusCode = http.StatusConflict
+
+	case errors.Is(err, domain.ErrProductReceptionClosed), errors.Is(err, domain.ErrProductAlreadyIssued),
+		errors.Is(err, domain.ErrProductHasIssuances):
+		msg = err.Error()
+		statusCode = http.StatusConflict
+
+	case errors.Is(err, domain.ErrPVZNotActive), errors.Is(err, domain.ErrPVZCapacityExceeded):
+		msg = err.Error()
+		statusCode = http.StatusConflict
+
+	case errors.Is(err, domain.ErrPVZNotFound), errors.Is(err, domain.ErrProductNotFound):
+		msg = err.Error()
+		statusCode = http.StatusNotFound
+
 	default:
 		statusCode = http.StatusInternalServerError
 		msg = "internal server error"
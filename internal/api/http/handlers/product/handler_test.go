@@ -1,9 +1,11 @@
 package product
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -15,7 +17,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/product/mocks"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
 	"github.com/valeragav/avito-pvz-service/pkg/testutils"
 	"github.com/valeragav/avito-pvz-service/pkg/validation"
 	"go.uber.org/mock/gomock"
@@ -29,6 +33,7 @@ func TestProductsHandlers_DeleteLastProduct(t *testing.T) {
 
 	valid := validation.New()
 	productID := uuid.New()
+	userID := uuid.New()
 
 	testcases := []struct {
 		name          string
@@ -44,7 +49,7 @@ func TestProductsHandlers_DeleteLastProduct(t *testing.T) {
 			productMock: func(service *mocks.MockproductService) {
 				service.
 					EXPECT().
-					DeleteLastProduct(gomock.Any(), productID).
+					DeleteLastProduct(gomock.Any(), dto.ProductDeleteLast{PvzID: productID, DeletedBy: userID}).
 					Return(nil, nil)
 			},
 		},
@@ -71,7 +76,7 @@ func TestProductsHandlers_DeleteLastProduct(t *testing.T) {
 			productMock: func(service *mocks.MockproductService) {
 				service.
 					EXPECT().
-					DeleteLastProduct(gomock.Any(), productID).
+					DeleteLastProduct(gomock.Any(), dto.ProductDeleteLast{PvzID: productID, DeletedBy: userID}).
 					Return(nil, errors.New("storage error"))
 			},
 			expectedError: &response.Error{
@@ -95,6 +100,7 @@ func TestProductsHandlers_DeleteLastProduct(t *testing.T) {
 			rctx := chi.NewRouteContext()
 			rctx.URLParams.Add("pvzID", tt.pvzIDParam)
 			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole}))
 
 			w := httptest.NewRecorder()
 			handler.DeleteLastProduct(w, req)
@@ -194,6 +200,24 @@ func TestProductsHandlers_CreateProduct(t *testing.T) {
 				Details: "storage error",
 			},
 		},
+		{
+			name: "pvz capacity exceeded",
+			requestBody: CreateRequest{
+				Type:  validTypeName,
+				PvzID: validPvzID,
+			},
+			expectedCode: http.StatusConflict,
+			productMock: func(service *mocks.MockproductService) {
+				service.
+					EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: 10 of 10 products on hand", domain.ErrPVZCapacityExceeded))
+			},
+			expectedError: &response.Error{
+				Message: "pvz capacity exceeded: 10 of 10 products on hand",
+				Details: "pvz capacity exceeded: 10 of 10 products on hand",
+			},
+		},
 		// TODO: еще сделать
 	}
 
@@ -237,3 +261,351 @@ func TestProductsHandlers_CreateProduct(t *testing.T) {
 		})
 	}
 }
+
+func TestProductsHandlers_DeleteProduct(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	productID := uuid.New()
+	userID := uuid.New()
+
+	testcases := []struct {
+		name           string
+		productIDParam string
+		expectedCode   int
+		productMock    func(*mocks.MockproductService)
+		expectedError  *response.Error
+	}{
+		{
+			name:           "successful delete",
+			productIDParam: productID.String(),
+			expectedCode:   http.StatusNoContent,
+			productMock: func(service *mocks.MockproductService) {
+				service.
+					EXPECT().
+					DeleteProduct(gomock.Any(), dto.ProductDelete{ProductID: productID, DeletedBy: userID}).
+					Return(&domain.Product{ID: productID}, nil)
+			},
+		},
+		{
+			name:           "invalid productID format",
+			productIDParam: "not-uuid",
+			expectedCode:   http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid productID format",
+			},
+		},
+		{
+			name:           "product not found",
+			productIDParam: productID.String(),
+			expectedCode:   http.StatusNotFound,
+			productMock: func(service *mocks.MockproductService) {
+				service.
+					EXPECT().
+					DeleteProduct(gomock.Any(), dto.ProductDelete{ProductID: productID, DeletedBy: userID}).
+					Return(nil, domain.ErrProductNotFound)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrProductNotFound.Error(),
+				Details: domain.ErrProductNotFound.Error(),
+			},
+		},
+		{
+			name:           "reception closed",
+			productIDParam: productID.String(),
+			expectedCode:   http.StatusConflict,
+			productMock: func(service *mocks.MockproductService) {
+				service.
+					EXPECT().
+					DeleteProduct(gomock.Any(), dto.ProductDelete{ProductID: productID, DeletedBy: userID}).
+					Return(nil, domain.ErrProductReceptionClosed)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrProductReceptionClosed.Error(),
+				Details: domain.ErrProductReceptionClosed.Error(),
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			productServiceMock := mocks.NewMockproductService(ctrl)
+			handler := New(valid, productServiceMock)
+
+			if tt.productMock != nil {
+				tt.productMock(productServiceMock)
+			}
+
+			req := httptest.NewRequest("DELETE", "/products/"+tt.productIDParam, http.NoBody)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("productID", tt.productIDParam)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole}))
+
+			w := httptest.NewRecorder()
+			handler.DeleteProduct(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestProductsHandlers_CreateBatch(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	pvzID := uuid.New()
+	userID := uuid.New()
+	product := &domain.Product{
+		ID:          uuid.New(),
+		ReceptionID: uuid.New(),
+		ProductType: &domain.ProductType{Name: "обувь"},
+	}
+
+	testcases := []struct {
+		name          string
+		requestBody   any
+		expectedCode  int
+		productMock   func(*mocks.MockproductService)
+		expected      *BatchCreateResponse
+		expectedError *response.Error
+	}{
+		{
+			name: "all created",
+			requestBody: BatchCreateRequest{
+				PvzID: pvzID,
+				Items: []BatchItemRequest{{Type: "обувь"}},
+			},
+			expectedCode: http.StatusCreated,
+			productMock: func(service *mocks.MockproductService) {
+				service.
+					EXPECT().
+					CreateBatch(gomock.Any(), dto.ProductBatchCreate{
+						PvzID:     pvzID,
+						Items:     []dto.ProductBatchItem{{TypeName: "обувь"}},
+						CreatedBy: userID,
+					}).
+					Return([]dto.ProductBatchResult{{Product: product}}, nil)
+			},
+			expected: &BatchCreateResponse{
+				Created: 1,
+				Items: []BatchItemResponse{
+					{Index: 0, Product: &CreateResponse{ID: product.ID, Type: "обувь", ReceptionID: product.ReceptionID}},
+				},
+			},
+		},
+		{
+			name: "partial failure",
+			requestBody: BatchCreateRequest{
+				PvzID: pvzID,
+				Items: []BatchItemRequest{{Type: "обувь"}, {Type: "unknown"}},
+			},
+			expectedCode: http.StatusOK,
+			productMock: func(service *mocks.MockproductService) {
+				service.
+					EXPECT().
+					CreateBatch(gomock.Any(), gomock.Any()).
+					Return([]dto.ProductBatchResult{{Product: product}, {Err: domain.ErrProductTypeNotFound}}, nil)
+			},
+			expected: &BatchCreateResponse{
+				Created: 1,
+				Failed:  1,
+				Items: []BatchItemResponse{
+					{Index: 0, Product: &CreateResponse{ID: product.ID, Type: "обувь", ReceptionID: product.ReceptionID}},
+					{Index: 1, Error: domain.ErrProductTypeNotFound.Error()},
+				},
+			},
+		},
+		{
+			name:         "empty items",
+			requestBody:  BatchCreateRequest{PvzID: pvzID},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "all or nothing failed",
+			requestBody: BatchCreateRequest{
+				PvzID:        pvzID,
+				Items:        []BatchItemRequest{{Type: "unknown"}},
+				AllOrNothing: true,
+			},
+			expectedCode: http.StatusBadRequest,
+			productMock: func(service *mocks.MockproductService) {
+				service.
+					EXPECT().
+					CreateBatch(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrProductTypeNotFound)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrProductTypeNotFound.Error(),
+				Details: domain.ErrProductTypeNotFound.Error(),
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			productServiceMock := mocks.NewMockproductService(ctrl)
+			handler := New(valid, productServiceMock)
+
+			if tt.productMock != nil {
+				tt.productMock(productServiceMock)
+			}
+
+			body, err := json.Marshal(tt.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest("POST", "/products/batch", bytes.NewReader(body))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole}))
+
+			w := httptest.NewRecorder()
+			handler.CreateBatch(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res BatchCreateResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expected, &res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestProductsHandlers_GetByBarcode(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	location := &dto.ProductLocation{
+		Product: &domain.Product{
+			ID:          uuid.New(),
+			ReceptionID: uuid.New(),
+			Barcode:     "4601234567890",
+			ProductType: &domain.ProductType{Name: "обувь"},
+		},
+		Reception: &domain.Reception{
+			ID:              uuid.New(),
+			ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusClose},
+		},
+		PVZ: &domain.PVZ{ID: uuid.New(), CityID: uuid.New()},
+	}
+
+	testcases := []struct {
+		name          string
+		code          string
+		expectedCode  int
+		productMock   func(*mocks.MockproductService)
+		expected      *LocationResponse
+		expectedError *response.Error
+	}{
+		{
+			name:         "found",
+			code:         "4601234567890",
+			expectedCode: http.StatusOK,
+			productMock: func(service *mocks.MockproductService) {
+				service.
+					EXPECT().
+					GetByBarcode(gomock.Any(), "4601234567890").
+					Return(location, nil)
+			},
+			expected: &LocationResponse{
+				Product: CreateResponse{
+					ID:          location.Product.ID,
+					Type:        "обувь",
+					ReceptionID: location.Product.ReceptionID,
+					Barcode:     "4601234567890",
+				},
+				Reception: LocationReceptionResponse{ID: location.Reception.ID, Status: "close"},
+				Pvz:       LocationPvzResponse{ID: location.PVZ.ID, CityID: location.PVZ.CityID},
+			},
+		},
+		{
+			name:         "not found",
+			code:         "unknown",
+			expectedCode: http.StatusNotFound,
+			productMock: func(service *mocks.MockproductService) {
+				service.
+					EXPECT().
+					GetByBarcode(gomock.Any(), "unknown").
+					Return(nil, domain.ErrProductNotFound)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrProductNotFound.Error(),
+				Details: domain.ErrProductNotFound.Error(),
+			},
+		},
+		{
+			name:         "empty code",
+			code:         "",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid barcode",
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			productServiceMock := mocks.NewMockproductService(ctrl)
+			handler := New(valid, productServiceMock)
+
+			if tt.productMock != nil {
+				tt.productMock(productServiceMock)
+			}
+
+			req := httptest.NewRequest("GET", "/products/by-barcode/"+tt.code, http.NoBody)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("code", tt.code)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.GetByBarcode(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res LocationResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expected, &res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
@@ -13,7 +13,6 @@ import (
 	context "context"
 	reflect "reflect"
 
-	uuid "github.com/google/uuid"
 	domain "github.com/valeragav/avito-pvz-service/internal/domain"
 	dto "github.com/valeragav/avito-pvz-service/internal/usecase/dto"
 	gomock "go.uber.org/mock/gomock"
@@ -58,17 +57,62 @@ func (mr *MockproductServiceMockRecorder) Create(ctx, createIn any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockproductService)(nil).Create), ctx, createIn)
 }
 
+// CreateBatch mocks base method.
+func (m *MockproductService) CreateBatch(ctx context.Context, createIn dto.ProductBatchCreate) ([]dto.ProductBatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, createIn)
+	ret0, _ := ret[0].([]dto.ProductBatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockproductServiceMockRecorder) CreateBatch(ctx, createIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockproductService)(nil).CreateBatch), ctx, createIn)
+}
+
 // DeleteLastProduct mocks base method.
-func (m *MockproductService) DeleteLastProduct(ctx context.Context, pvzID uuid.UUID) (*domain.Product, error) {
+func (m *MockproductService) DeleteLastProduct(ctx context.Context, deleteIn dto.ProductDeleteLast) (*domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLastProduct", ctx, pvzID)
+	ret := m.ctrl.Call(m, "DeleteLastProduct", ctx, deleteIn)
 	ret0, _ := ret[0].(*domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteLastProduct indicates an expected call of DeleteLastProduct.
-func (mr *MockproductServiceMockRecorder) DeleteLastProduct(ctx, pvzID any) *gomock.Call {
+func (mr *MockproductServiceMockRecorder) DeleteLastProduct(ctx, deleteIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockproductService)(nil).DeleteLastProduct), ctx, deleteIn)
+}
+
+// DeleteProduct mocks base method.
+func (m *MockproductService) DeleteProduct(ctx context.Context, deleteIn dto.ProductDelete) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, deleteIn)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockproductServiceMockRecorder) DeleteProduct(ctx, deleteIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockproductService)(nil).DeleteProduct), ctx, deleteIn)
+}
+
+// GetByBarcode mocks base method.
+func (m *MockproductService) GetByBarcode(ctx context.Context, barcode string) (*dto.ProductLocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByBarcode", ctx, barcode)
+	ret0, _ := ret[0].(*dto.ProductLocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByBarcode indicates an expected call of GetByBarcode.
+func (mr *MockproductServiceMockRecorder) GetByBarcode(ctx, barcode any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockproductService)(nil).DeleteLastProduct), ctx, pvzID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByBarcode", reflect.TypeOf((*MockproductService)(nil).GetByBarcode), ctx, barcode)
 }
@@ -1,6 +1,9 @@
 package pvz
 
 import (
+	"bytes"
+	"encoding/json"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,26 +12,135 @@ import (
 )
 
 type CreateRequest struct {
-	ID               uuid.UUID `json:"id" validate:"required,uuid"`
-	City             string    `json:"city" validate:"required,max=255"`
-	RegistrationDate time.Time `json:"registrationDate" validate:"required"`
+	ID               uuid.UUID         `json:"id" validate:"required,uuid"`
+	City             string            `json:"city" validate:"required,max=255"`
+	RegistrationDate time.Time         `json:"registrationDate" validate:"required"`
+	Address          string            `json:"address" validate:"max=500"`
+	Location         *LocationRequest  `json:"location"`
+	WorkingHours     []WorkingInterval `json:"workingHours" validate:"omitempty,max=50,dive"`
+	Capacity         *int              `json:"capacity" validate:"omitempty,gt=0"`
+	TypeCapacities   map[string]int    `json:"typeCapacities" validate:"omitempty,max=50,dive,keys,required,max=255,endkeys,gt=0"`
 }
 
 type CreateResponse struct {
-	ID               uuid.UUID `json:"id"`
-	City             string    `json:"city"`
-	RegistrationDate time.Time `json:"registrationDate"`
+	ID               uuid.UUID         `json:"id"`
+	City             string            `json:"city"`
+	RegistrationDate time.Time         `json:"registrationDate"`
+	Address          string            `json:"address,omitempty"`
+	Location         *Location         `json:"location,omitempty"`
+	WorkingHours     []WorkingInterval `json:"workingHours,omitempty"`
+	Capacity         *int              `json:"capacity,omitempty"`
+	TypeCapacities   map[string]int    `json:"typeCapacities,omitempty"`
+}
+
+// UpdateRequest частичное обновление профиля PVZ, не переданные поля не меняются.
+// Пустой массив workingHours очищает расписание, пустой объект typeCapacities снимает лимиты по типам,
+// явный null в location и capacity удаляет координаты и общий лимит вместимости.
+type UpdateRequest struct {
+	Address        *string           `json:"address" validate:"omitempty,max=500"`
+	Location       *LocationRequest  `json:"location" extensions:"x-nullable"`
+	WorkingHours   []WorkingInterval `json:"workingHours" validate:"omitempty,max=50,dive"`
+	Capacity       *int              `json:"capacity" validate:"omitempty,gt=0" extensions:"x-nullable"`
+	TypeCapacities map[string]int    `json:"typeCapacities" validate:"omitempty,max=50,dive,keys,required,max=255,endkeys,gt=0"`
+
+	// nullFields поля, переданные явным null: для указателей это не отличить от отсутствия поля
+	nullFields map[string]bool
+}
+
+func (r *UpdateRequest) UnmarshalJSON(data []byte) error {
+	type plain UpdateRequest
+	if err := json.Unmarshal(data, (*plain)(r)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	r.nullFields = make(map[string]bool)
+	for name, value := range fields {
+		if bytes.Equal(value, []byte("null")) {
+			r.nullFields[name] = true
+		}
+	}
+
+	return nil
+}
+
+func (r UpdateRequest) ClearLocation() bool {
+	return r.nullFields["location"]
+}
+
+func (r UpdateRequest) ClearCapacity() bool {
+	return r.nullFields["capacity"]
+}
+
+func (r UpdateRequest) IsEmpty() bool {
+	return r.Address == nil && r.Location == nil && r.WorkingHours == nil && r.Capacity == nil && r.TypeCapacities == nil &&
+		!r.ClearLocation() && !r.ClearCapacity()
+}
+
+// LocationRequest координаты указателями, чтобы отличать нулевые от не переданных.
+type LocationRequest struct {
+	Lat *float64 `json:"lat" validate:"required,min=-90,max=90"`
+	Lon *float64 `json:"lon" validate:"required,min=-180,max=180"`
+}
+
+type Location struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// WorkingInterval время работы в день недели, open и close в формате HH:MM, close может быть 24:00.
+type WorkingInterval struct {
+	Day   string `json:"day" validate:"required,oneof=mon tue wed thu fri sat sun" example:"mon"`
+	Open  string `json:"open" validate:"required" example:"09:00"`
+	Close string `json:"close" validate:"required" example:"21:00"`
 }
 
 type PVZListResponse struct {
-	Pvz        PvzResponse             `json:"pvz"`
-	Receptions []ReceptionsWithProduct `json:"receptions"`
+	Pvz         PvzResponse             `json:"pvz"`
+	Receptions  []ReceptionsWithProduct `json:"receptions"`
+	StockOnHand int                     `json:"stockOnHand"`
+}
+
+// PVZListPageResponse ответ списка PVZ с envelope=true.
+// Page не заполняется при пагинации курсором, NextCursor пустой на последней странице.
+type PVZListPageResponse struct {
+	Items      []PVZListResponse `json:"items"`
+	Page       *uint             `json:"page,omitempty"`
+	Limit      uint              `json:"limit"`
+	Total      int               `json:"total"`
+	HasNext    bool              `json:"hasNext"`
+	NextCursor string            `json:"nextCursor,omitempty"`
 }
 
 type PvzResponse struct {
-	ID               uuid.UUID `json:"id"`
-	RegistrationDate time.Time `json:"registrationDate"`
-	City             string    `json:"city"`
+	ID                uuid.UUID         `json:"id"`
+	RegistrationDate  time.Time         `json:"registrationDate"`
+	City              string            `json:"city"`
+	Status            string            `json:"status"`
+	StatusReason      string            `json:"statusReason,omitempty"`
+	StatusEffectiveAt *time.Time        `json:"statusEffectiveAt,omitempty"`
+	Address           string            `json:"address,omitempty"`
+	Location          *Location         `json:"location,omitempty"`
+	WorkingHours      []WorkingInterval `json:"workingHours,omitempty"`
+	Capacity          *int              `json:"capacity,omitempty"`
+	TypeCapacities    map[string]int    `json:"typeCapacities,omitempty"`
+}
+
+// ChangeStatusRequest смена статуса PVZ, причина обязательна для suspended и closed.
+// effectiveDate необязателен и должен совпадать с текущим временем: отложенная смена статуса не поддерживается.
+type ChangeStatusRequest struct {
+	Status        string     `json:"status" validate:"required,oneof=active suspended closed"`
+	Reason        string     `json:"reason" validate:"required_unless=Status active,max=1000"`
+	EffectiveDate *time.Time `json:"effectiveDate"`
+}
+
+type NearbyResponse struct {
+	Pvz        PvzResponse `json:"pvz"`
+	DistanceKm float64     `json:"distanceKm"`
 }
 
 type ReceptionsWithProduct struct {
@@ -43,29 +155,144 @@ type ReceptionsResponse struct {
 	Status   string    `json:"status"`
 }
 
+// DetailResponse PVZ со страницей приёмок, товары приёмок есть только при include=products.
+type DetailResponse struct {
+	Pvz         PvzResponse       `json:"pvz"`
+	Receptions  []ReceptionDetail `json:"receptions"`
+	StockOnHand int               `json:"stockOnHand"`
+}
+
+type ReceptionDetail struct {
+	Reception ReceptionsResponse `json:"reception"`
+	Products  []ProductsResponse `json:"products,omitempty"`
+}
+
 type ProductsResponse struct {
 	ID          uuid.UUID `json:"id"`
 	DateTime    time.Time `json:"dateTime"`
 	Type        string    `json:"type"`
 	ReceptionID uuid.UUID `json:"receptionId"`
+	Issued      bool      `json:"issued"`
 }
 
 func ToBuildPvzListParams(pvzListParams PvzListParams) dto.PVZListParams {
 	return dto.PVZListParams{
 		Filter: &dto.PVZFilter{
-			StartDate: pvzListParams.Filter.StartDate,
-			EndDate:   pvzListParams.Filter.EndDate,
+			StartDate:         pvzListParams.Filter.StartDate,
+			EndDate:           pvzListParams.Filter.EndDate,
+			Cities:            pvzListParams.ListFilter.Cities,
+			ReceptionStatuses: pvzListParams.ListFilter.ReceptionStatuses,
+			ProductTypes:      pvzListParams.ListFilter.ProductTypes,
 		},
+		Sort:       pvzListParams.Sort,
 		Pagination: &pvzListParams.Pagination,
+		Cursor:     pvzListParams.Cursor,
 	}
 }
 
-func ToCreateIn(req CreateRequest) dto.PVZCreate {
+func ToDetailParams(pvzID uuid.UUID, params PvzDetailParams) dto.PVZDetailParams {
+	return dto.PVZDetailParams{
+		PvzID: pvzID,
+		Filter: &dto.PVZFilter{
+			StartDate: params.Filter.StartDate,
+			EndDate:   params.Filter.EndDate,
+		},
+		Pagination:      &params.Pagination,
+		IncludeProducts: params.IncludeProducts,
+	}
+}
+
+func ToCreateIn(req CreateRequest, createdBy uuid.UUID) dto.PVZCreate {
 	return dto.PVZCreate{
 		ID:               req.ID,
 		CityName:         req.City,
 		RegistrationDate: req.RegistrationDate,
+		CreatedBy:        createdBy,
+		Address:          req.Address,
+		Location:         toDomainGeoPoint(req.Location),
+		WorkingHours:     toDomainWorkingHours(req.WorkingHours),
+		Capacity:         req.Capacity,
+		TypeCapacities:   req.TypeCapacities,
+	}
+}
+
+func ToUpdateIn(pvzID uuid.UUID, req UpdateRequest, actorID uuid.UUID) dto.PVZProfileUpdate {
+	return dto.PVZProfileUpdate{
+		PvzID:          pvzID,
+		Address:        req.Address,
+		Location:       toDomainGeoPoint(req.Location),
+		ClearLocation:  req.ClearLocation(),
+		WorkingHours:   toDomainWorkingHours(req.WorkingHours),
+		Capacity:       req.Capacity,
+		ClearCapacity:  req.ClearCapacity(),
+		TypeCapacities: req.TypeCapacities,
+		ActorID:        actorID,
+	}
+}
+
+func ToUpdateResponse(out domain.PVZ) PvzResponse {
+	return toPvzResponse(&out)
+}
+
+func toDomainGeoPoint(location *LocationRequest) *domain.GeoPoint {
+	if location == nil {
+		return nil
+	}
+	return &domain.GeoPoint{Lat: *location.Lat, Lon: *location.Lon}
+}
+
+// toDomainWorkingHours сохраняет различие между nil и пустым расписанием
+func toDomainWorkingHours(intervals []WorkingInterval) domain.WorkingHours {
+	if intervals == nil {
+		return nil
+	}
+
+	res := make(domain.WorkingHours, 0, len(intervals))
+	for _, interval := range intervals {
+		res = append(res, domain.WorkingInterval{
+			Day:   domain.Weekday(interval.Day),
+			Open:  interval.Open,
+			Close: interval.Close,
+		})
+	}
+	return res
+}
+
+func toLocation(point *domain.GeoPoint) *Location {
+	if point == nil {
+		return nil
+	}
+	return &Location{Lat: point.Lat, Lon: point.Lon}
+}
+
+func toWorkingIntervals(hours domain.WorkingHours) []WorkingInterval {
+	if len(hours) == 0 {
+		return nil
+	}
+
+	res := make([]WorkingInterval, 0, len(hours))
+	for _, interval := range hours {
+		res = append(res, WorkingInterval{
+			Day:   string(interval.Day),
+			Open:  interval.Open,
+			Close: interval.Close,
+		})
 	}
+	return res
+}
+
+func ToStatusChangeIn(pvzID uuid.UUID, req ChangeStatusRequest, actorID uuid.UUID) dto.PVZStatusChange {
+	return dto.PVZStatusChange{
+		PvzID:       pvzID,
+		Status:      domain.PVZStatus(req.Status),
+		Reason:      req.Reason,
+		EffectiveAt: req.EffectiveDate,
+		ActorID:     actorID,
+	}
+}
+
+func ToStatusChangeResponse(out domain.PVZ) PvzResponse {
+	return toPvzResponse(&out)
 }
 
 func ToCreateResponse(out domain.PVZ) CreateResponse {
@@ -77,61 +304,155 @@ func ToCreateResponse(out domain.PVZ) CreateResponse {
 		ID:               out.ID,
 		City:             city,
 		RegistrationDate: out.RegistrationDate,
+		Address:          out.Address,
+		Location:         toLocation(out.Location),
+		WorkingHours:     toWorkingIntervals(out.WorkingHours),
+		Capacity:         out.Capacity,
+		TypeCapacities:   out.TypeCapacities,
 	}
 }
 
 func ToListResponse(pvzs []*domain.PVZ) []PVZListResponse {
 	result := make([]PVZListResponse, 0, len(pvzs))
 	for _, pvz := range pvzs {
-		var city string
-		if pvz.City != nil {
-			city = pvz.City.Name
-		}
-
-		pvzResp := PvzResponse{
-			ID:               pvz.ID,
-			RegistrationDate: pvz.RegistrationDate,
-			City:             city,
-		}
-
 		receptionsResp := make([]ReceptionsWithProduct, 0, len(pvz.Receptions))
 		for _, rwp := range pvz.Receptions {
-			productsResp := make([]ProductsResponse, 0, len(rwp.Products))
-			for _, p := range rwp.Products {
-				var productTypeName string
-				if p.ProductType != nil {
-					productTypeName = p.ProductType.Name
-				}
-
-				productsResp = append(productsResp, ProductsResponse{
-					ID:          p.ID,
-					DateTime:    p.DateTime,
-					Type:        productTypeName,
-					ReceptionID: p.ReceptionID,
-				})
-			}
-
-			var status string
-			if rwp.ReceptionStatus != nil {
-				status = string(rwp.ReceptionStatus.Name)
-			}
-
 			receptionsResp = append(receptionsResp, ReceptionsWithProduct{
-				Reception: ReceptionsResponse{
-					ID:       rwp.ID,
-					DateTime: rwp.DateTime,
-					PvzID:    rwp.PvzID,
-					Status:   status,
-				},
-				Products: productsResp,
+				Reception: toReceptionResponse(rwp),
+				Products:  toProductsResponse(rwp.Products),
 			})
 		}
 
 		result = append(result, PVZListResponse{
-			Pvz:        pvzResp,
-			Receptions: receptionsResp,
+			Pvz:         toPvzResponse(pvz),
+			Receptions:  receptionsResp,
+			StockOnHand: pvz.StockOnHand,
 		})
 	}
 
 	return result
 }
+
+func ToDetailResponse(pvz domain.PVZ, includeProducts bool) DetailResponse {
+	receptionsResp := make([]ReceptionDetail, 0, len(pvz.Receptions))
+	for _, rwp := range pvz.Receptions {
+		detail := ReceptionDetail{
+			Reception: toReceptionResponse(rwp),
+		}
+		if includeProducts {
+			detail.Products = toProductsResponse(rwp.Products)
+		}
+
+		receptionsResp = append(receptionsResp, detail)
+	}
+
+	return DetailResponse{
+		Pvz:         toPvzResponse(&pvz),
+		Receptions:  receptionsResp,
+		StockOnHand: pvz.StockOnHand,
+	}
+}
+
+func toPvzResponse(pvz *domain.PVZ) PvzResponse {
+	var city string
+	if pvz.City != nil {
+		city = pvz.City.Name
+	}
+
+	return PvzResponse{
+		ID:                pvz.ID,
+		RegistrationDate:  pvz.RegistrationDate,
+		City:              city,
+		Status:            string(pvz.Status),
+		StatusReason:      pvz.StatusReason,
+		StatusEffectiveAt: pvz.StatusEffectiveAt,
+		Address:           pvz.Address,
+		Location:          toLocation(pvz.Location),
+		WorkingHours:      toWorkingIntervals(pvz.WorkingHours),
+		Capacity:          pvz.Capacity,
+		TypeCapacities:    pvz.TypeCapacities,
+	}
+}
+
+func toReceptionResponse(reception *domain.Reception) ReceptionsResponse {
+	var status string
+	if reception.ReceptionStatus != nil {
+		status = string(reception.ReceptionStatus.Name)
+	}
+
+	return ReceptionsResponse{
+		ID:       reception.ID,
+		DateTime: reception.DateTime,
+		PvzID:    reception.PvzID,
+		Status:   status,
+	}
+}
+
+func toProductsResponse(products []*domain.Product) []ProductsResponse {
+	productsResp := make([]ProductsResponse, 0, len(products))
+	for _, p := range products {
+		var productTypeName string
+		if p.ProductType != nil {
+			productTypeName = p.ProductType.Name
+		}
+
+		productsResp = append(productsResp, ProductsResponse{
+			ID:          p.ID,
+			DateTime:    p.DateTime,
+			Type:        productTypeName,
+			ReceptionID: p.ReceptionID,
+			Issued:      p.Issued,
+		})
+	}
+
+	return productsResp
+}
+
+type InventoryResponse struct {
+	PvzID uuid.UUID               `json:"pvzId"`
+	AsOf  time.Time               `json:"asOf"`
+	Total int                     `json:"total"`
+	Items []InventoryItemResponse `json:"items"`
+}
+
+type InventoryItemResponse struct {
+	Type  string `json:"type"`
+	Count int    `json:"count"`
+}
+
+func ToNearbyParams(params PvzNearbyParams) dto.PVZNearbyParams {
+	return dto.PVZNearbyParams{
+		Point:    params.Point,
+		RadiusKm: params.RadiusKm,
+		Limit:    params.Limit,
+	}
+}
+
+// ToNearbyResponse округляет расстояние до метров.
+func ToNearbyResponse(pvzs []*domain.PVZ) []NearbyResponse {
+	result := make([]NearbyResponse, 0, len(pvzs))
+	for _, pvz := range pvzs {
+		result = append(result, NearbyResponse{
+			Pvz:        toPvzResponse(pvz),
+			DistanceKm: math.Round(pvz.DistanceKm*1000) / 1000,
+		})
+	}
+	return result
+}
+
+func ToInventoryResponse(out domain.PVZInventory) InventoryResponse {
+	items := make([]InventoryItemResponse, 0, len(out.Items))
+	for _, item := range out.Items {
+		items = append(items, InventoryItemResponse{
+			Type:  item.TypeName,
+			Count: item.Count,
+		})
+	}
+
+	return InventoryResponse{
+		PvzID: out.PvzID,
+		AsOf:  out.AsOf,
+		Total: out.Total,
+		Items: items,
+	}
+}
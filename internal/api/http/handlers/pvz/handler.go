@@ -6,11 +6,17 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/internal/metrics"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 	"github.com/valeragav/avito-pvz-service/pkg/logger"
 	"github.com/valeragav/avito-pvz-service/pkg/validation"
 )
@@ -18,35 +24,55 @@ import (
 //go:generate ${LOCAL_BIN}/mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
 type pvzService interface {
 	Create(ctx context.Context, createIn dto.PVZCreate) (*domain.PVZ, error)
-	List(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, error)
+	List(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, *listparams.Cursor, error)
+	Count(ctx context.Context, pvzListParams *dto.PVZListParams) (int, error)
+	Get(ctx context.Context, params dto.PVZDetailParams) (*domain.PVZ, error)
+	Inventory(ctx context.Context, params dto.PVZInventoryParams) (*domain.PVZInventory, error)
+	ChangeStatus(ctx context.Context, changeIn dto.PVZStatusChange) (*domain.PVZ, error)
+	UpdateProfile(ctx context.Context, updateIn dto.PVZProfileUpdate) (*domain.PVZ, error)
+	ListNearby(ctx context.Context, params dto.PVZNearbyParams) ([]*domain.PVZ, error)
 }
 
+// NextCursorHeader заголовок с курсором следующей страницы списка PVZ.
+const NextCursorHeader = "X-Next-Cursor"
+
 type PVZHandlers struct {
-	validator  *validation.Validator
-	pvzService pvzService
+	validator   *validation.Validator
+	pvzService  pvzService
+	cursorCodec *listparams.CursorCodec
 }
 
-func New(validator *validation.Validator, pvzService pvzService) *PVZHandlers {
+func New(validator *validation.Validator, pvzService pvzService, cursorCodec *listparams.CursorCodec) *PVZHandlers {
 	return &PVZHandlers{
 		validator,
 		pvzService,
+		cursorCodec,
 	}
 }
 
 // @Summary List PVZ points
-// @Description Get a list of PVZ points with optional filters. Requires JWT-Token with Employee or Moderator role.
+// @Description Get a list of PVZ points with optional filters, newest first by default. Date range, reception status and product type filters must match the same reception. Supports page based and keyset pagination: pass the X-Next-Cursor value of the previous response as cursor, keyset pagination works only with the default sort. Requires JWT-Token with Employee or Moderator role.
 // @Tags PVZ
 // @Security ApiKeyAuth
-// @Param city query string false "Filter by city"
+// @Param city query []string false "Filter by city, repeated or comma separated" collectionFormat(multi)
+// @Param startDate query string false "Reception date from (RFC3339)"
+// @Param endDate query string false "Reception date to (RFC3339)"
+// @Param receptionStatus query []string false "Filter by reception status" Enums(in_progress, close, cancelled, reopened) collectionFormat(multi)
+// @Param productType query []string false "Filter by product type in reception" collectionFormat(multi)
+// @Param sort query string false "Comma separated sort fields: registrationDate, city, lastReceptionAt; prefix - for desc" example(city,-registrationDate)
 // @Param limit query int false "Limit number of results"
 // @Param page query int false "Page for pagination"
+// @Param cursor query string false "Opaque cursor of the next page, cannot be used with page"
+// @Param envelope query bool false "Return an object {items, page, limit, total, hasNext, nextCursor} instead of the array"
 // @Success 200 {array} PVZListResponse "List of PVZ points"
+// @Header 200 {string} X-Next-Cursor "Cursor of the next page, absent on the last page"
+// @Header 200 {string} Link "RFC 5988 links to the first, prev, next and, with envelope=true, last pages"
 // @Failure 400 {object} response.Error "Bad request"
 // @Failure 500 {object} response.Error "Internal server error"
 // @Router /pvz [get]
 func (h *PVZHandlers) List(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	pvzListParams, err := getParsePvzParam(r)
+	pvzListParams, err := getParsePvzParam(r, h.cursorCodec)
 	if err != nil {
 		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
 		return
@@ -54,7 +80,7 @@ func (h *PVZHandlers) List(w http.ResponseWriter, r *http.Request) {
 
 	pvzListParamsDto := ToBuildPvzListParams(pvzListParams)
 
-	pvzRes, err := h.pvzService.List(ctx, &pvzListParamsDto)
+	pvzRes, next, err := h.pvzService.List(ctx, &pvzListParamsDto)
 	if err != nil {
 		mess, code := mapErrorToHTTP(err)
 
@@ -63,9 +89,122 @@ func (h *PVZHandlers) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// общее количество считается отдельным запросом, поэтому только по запросу клиента
+	var total *int
+	if pvzListParams.Envelope {
+		count, err := h.pvzService.Count(ctx, &pvzListParamsDto)
+		if err != nil {
+			mess, code := mapErrorToHTTP(err)
+
+			logger.ErrorCtx(ctx, mess, "error", err)
+			response.WriteError(w, ctx, code, mess, err)
+			return
+		}
+		total = &count
+	}
+
+	var nextCursor string
+	if next != nil {
+		nextCursor = h.cursorCodec.Encode(*next)
+		w.Header().Set(NextCursorHeader, nextCursor)
+	}
+
+	pagination := pvzListParams.Pagination
+	byCursor := pvzListParams.Cursor != nil
+
+	hasNext := len(pvzRes) == int(pagination.Limit)
+	switch {
+	case byCursor:
+		hasNext = next != nil
+	case total != nil:
+		hasNext = int(pagination.Offset())+len(pvzRes) < *total
+	}
+
+	if links := pageLinks(r.URL, pagination, byCursor, hasNext, nextCursor, total); links != "" {
+		w.Header().Set("Link", links)
+	}
+
 	res := ToListResponse(pvzRes)
 
-	response.WriteJSON(w, ctx, http.StatusOK, res)
+	if !pvzListParams.Envelope {
+		response.WriteJSON(w, ctx, http.StatusOK, res)
+		return
+	}
+
+	page := PVZListPageResponse{
+		Items:      res,
+		Limit:      pagination.Limit,
+		Total:      *total,
+		HasNext:    hasNext,
+		NextCursor: nextCursor,
+	}
+	if !byCursor {
+		page.Page = &pagination.Page
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, page)
+}
+
+// pageLinks ссылки на соседние страницы списка. При пагинации курсором следующая страница
+// доступна только по курсору, а prev и last не строятся.
+func pageLinks(u *url.URL, pagination listparams.Pagination, byCursor, hasNext bool, nextCursor string, total *int) string {
+	links := listparams.NewLinks(u)
+	links.Add("first", map[string]string{"page": "", "cursor": ""})
+
+	if byCursor {
+		if hasNext {
+			links.Add("next", map[string]string{"cursor": nextCursor})
+		}
+		return links.String()
+	}
+
+	if pagination.Page > 1 {
+		links.Add("prev", map[string]string{"page": strconv.Itoa(int(pagination.Page) - 1)})
+	}
+	if hasNext {
+		links.Add("next", map[string]string{"page": strconv.Itoa(int(pagination.Page) + 1)})
+	}
+	if total != nil && *total > 0 {
+		lastPage := (*total + int(pagination.Limit) - 1) / int(pagination.Limit)
+		links.Add("last", map[string]string{"page": strconv.Itoa(lastPage)})
+	}
+
+	return links.String()
+}
+
+// @Summary Nearby PVZ points
+// @Description Get active PVZ points within radius of the point, nearest first. Distance is great-circle distance in kilometers, PVZ without coordinates are not returned. Requires JWT-Token with Employee or Moderator role.
+// @ID NearbyPVZ
+// @Tags PVZ
+// @Security ApiKeyAuth
+// @Produce json
+// @Param lat query number true "Latitude, -90..90"
+// @Param lon query number true "Longitude, -180..180"
+// @Param radius query number false "Radius in kilometers, 5 by default, at most 100"
+// @Param limit query int false "Limit number of results"
+// @Success 200 {array} NearbyResponse "PVZ points ordered by distance"
+// @Failure 400 {object} response.Error "Invalid coordinates, radius or limit"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /pvz/nearby [get]
+func (h *PVZHandlers) Nearby(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	params, err := getParsePvzNearbyParam(r)
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	pvzRes, err := h.pvzService.ListNearby(ctx, ToNearbyParams(params))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToNearbyResponse(pvzRes))
 }
 
 // @Summary Create PVZ
@@ -77,7 +216,7 @@ func (h *PVZHandlers) List(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param input body CreateRequest true "PVZ creation data"
 // @Success 200 {object} CreateResponse "PVZ successfully created"
-// @Failure 400 {object} response.Error "Invalid request or validation failed"
+// @Failure 400 {object} response.Error "Invalid request, validation failed, invalid location, working hours, capacity or unknown product type"
 // @Failure 404 {object} response.Error "City not found"
 // @Failure 409 {object} response.Error "Pvz with this id already exists"
 // @Failure 500 {object} response.Error "Internal server error"
@@ -100,7 +239,9 @@ func (h *PVZHandlers) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pvzRes, err := h.pvzService.Create(ctx, ToCreateIn(req))
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	pvzRes, err := h.pvzService.Create(ctx, ToCreateIn(req, claims.UserID))
 	if err != nil {
 		mess, code := mapErrorToHTTP(err)
 
@@ -115,9 +256,204 @@ func (h *PVZHandlers) Create(w http.ResponseWriter, r *http.Request) {
 	response.WriteJSON(w, ctx, http.StatusCreated, res)
 }
 
+// @Summary Get PVZ
+// @Description Get a single PVZ with its city, stock on hand and a paginated list of its receptions, newest first. Products of receptions are returned only with include=products. Requires JWT-Token with Employee or Moderator role.
+// @ID GetPVZ
+// @Tags PVZ
+// @Security ApiKeyAuth
+// @Produce json
+// @Param pvzID path string true "PVZ ID (UUID)"
+// @Param startDate query string false "Receptions from date (RFC3339)"
+// @Param endDate query string false "Receptions to date (RFC3339)"
+// @Param limit query int false "Limit number of receptions"
+// @Param page query int false "Page for pagination"
+// @Param include query string false "Expansions, comma separated" Enums(products)
+// @Success 200 {object} DetailResponse "PVZ with receptions"
+// @Failure 400 {object} response.Error "Bad request"
+// @Failure 404 {object} response.Error "PVZ not found"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /pvz/{pvzID} [get]
+func (h *PVZHandlers) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pvzID, err := uuid.Parse(chi.URLParam(r, "pvzID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid pvzID format", nil)
+		return
+	}
+
+	params, err := getParsePvzDetailParam(r)
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	pvzRes, err := h.pvzService.Get(ctx, ToDetailParams(pvzID, params))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToDetailResponse(*pvzRes, params.IncludeProducts))
+}
+
+// @Summary PVZ inventory
+// @Description Get counts by product type of products currently on the shelf: received, not removed and not issued. With asOf returns historical inventory at the given moment. Requires JWT-Token with Employee or Moderator role.
+// @ID GetPVZInventory
+// @Tags PVZ
+// @Security ApiKeyAuth
+// @Produce json
+// @Param pvzID path string true "PVZ ID (UUID)"
+// @Param asOf query string false "Moment of inventory (RFC3339), now by default"
+// @Success 200 {object} InventoryResponse "Inventory by product type"
+// @Failure 400 {object} response.Error "Invalid pvzID or asOf"
+// @Failure 404 {object} response.Error "PVZ not found"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /pvz/{pvzID}/inventory [get]
+func (h *PVZHandlers) Inventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pvzID, err := uuid.Parse(chi.URLParam(r, "pvzID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid pvzID format", nil)
+		return
+	}
+
+	asOf, err := parseAsOf(r.URL.Query())
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	inventory, err := h.pvzService.Inventory(ctx, dto.PVZInventoryParams{PvzID: pvzID, AsOf: asOf})
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToInventoryResponse(*inventory))
+}
+
+// @Summary Update PVZ profile
+// @Description Partially update PVZ address, location, working hours and capacity limits, fields not passed stay unchanged, an empty workingHours array clears the schedule, an empty typeCapacities object removes per product type limits and an explicit null location or capacity removes the coordinates or the total capacity limit. Working hours are a weekly schedule of non-overlapping intervals per day in HH:MM, close may be 24:00. Requires JWT-Token with Moderator role.
+// @ID UpdatePVZ
+// @Tags PVZ
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param pvzID path string true "PVZ ID (UUID)"
+// @Param input body UpdateRequest true "Profile fields to update"
+// @Success 200 {object} PvzResponse "Updated PVZ"
+// @Failure 400 {object} response.Error "Invalid request, validation failed, invalid location, working hours, capacity or unknown product type"
+// @Failure 404 {object} response.Error "PVZ not found"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /pvz/{pvzID} [patch]
+func (h *PVZHandlers) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pvzID, err := uuid.Parse(chi.URLParam(r, "pvzID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid pvzID format", nil)
+		return
+	}
+
+	var req UpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		if errors.Is(err, io.EOF) {
+			response.WriteError(w, ctx, http.StatusBadRequest, "request body is empty", nil)
+			return
+		}
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	if req.IsEmpty() {
+		response.WriteError(w, ctx, http.StatusBadRequest, "no fields to update", nil)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	pvzRes, err := h.pvzService.UpdateProfile(ctx, ToUpdateIn(pvzID, req, claims.UserID))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToUpdateResponse(*pvzRes))
+}
+
+// @Summary Change PVZ status
+// @Description Change PVZ status: active, suspended or closed. Reason is required for suspended and closed, the new status takes effect immediately: effectiveDate is optional and, when passed, must be the current time within one minute, scheduled and backdated changes are rejected with 400. Closed is terminal, PVZ with an open reception can not be suspended or closed. Receptions and products can be created only in active PVZ. Requires JWT-Token with Moderator role.
+// @ID ChangePVZStatus
+// @Tags PVZ
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param pvzID path string true "PVZ ID (UUID)"
+// @Param input body ChangeStatusRequest true "New status"
+// @Success 200 {object} PvzResponse "PVZ with the new status"
+// @Failure 400 {object} response.Error "Invalid request or validation failed"
+// @Failure 404 {object} response.Error "PVZ not found"
+// @Failure 409 {object} response.Error "Status transition is not allowed or PVZ has an open reception"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /pvz/{pvzID}/status [post]
+func (h *PVZHandlers) ChangeStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pvzID, err := uuid.Parse(chi.URLParam(r, "pvzID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid pvzID format", nil)
+		return
+	}
+
+	var req ChangeStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		if errors.Is(err, io.EOF) {
+			response.WriteError(w, ctx, http.StatusBadRequest, "request body is empty", nil)
+			return
+		}
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	pvzRes, err := h.pvzService.ChangeStatus(ctx, ToStatusChangeIn(pvzID, req, claims.UserID))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToStatusChangeResponse(*pvzRes))
+}
+
 func mapErrorToHTTP(err error) (msg string, statusCode int) {
 	switch {
-	case errors.Is(err, domain.ErrCityNotFound):
+	case errors.Is(err, domain.ErrCityNotFound),
+		errors.Is(err, domain.ErrPVZNotFound):
 		msg = err.Error()
 		statusCode = http.StatusNotFound
 
@@ -125,6 +461,30 @@ func mapErrorToHTTP(err error) (msg string, statusCode int) {
 		msg = "pvz with this id already exists"
 		statusCode = http.StatusConflict
 
+	case errors.Is(err, domain.ErrPVZCursorSort):
+		msg = domain.ErrPVZCursorSort.Error()
+		statusCode = http.StatusBadRequest
+
+	case errors.Is(err, domain.ErrInvalidPVZLocation),
+		errors.Is(err, domain.ErrInvalidWorkingHours),
+		errors.Is(err, domain.ErrInvalidPVZCapacity),
+		errors.Is(err, domain.ErrInvalidNearbyRadius),
+		errors.Is(err, domain.ErrProductTypeNotFound):
+		msg = err.Error()
+		statusCode = http.StatusBadRequest
+
+	case errors.Is(err, domain.ErrPVZStatusEffectiveDate):
+		msg = domain.ErrPVZStatusEffectiveDate.Error()
+		statusCode = http.StatusBadRequest
+
+	case errors.Is(err, domain.ErrInvalidPVZStatusTransition):
+		msg = domain.ErrInvalidPVZStatusTransition.Error()
+		statusCode = http.StatusConflict
+
+	case errors.Is(err, domain.ErrPVZHasOpenReception):
+		msg = domain.ErrPVZHasOpenReception.Error()
+		statusCode = http.StatusConflict
+
 	default:
 		statusCode = http.StatusInternalServerError
 		msg = "internal server error"
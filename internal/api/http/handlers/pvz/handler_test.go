@@ -1,19 +1,24 @@
 package pvz
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/pvz/mocks"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 	"github.com/valeragav/avito-pvz-service/pkg/testutils"
 	"github.com/valeragav/avito-pvz-service/pkg/validation"
 	"go.uber.org/mock/gomock"
@@ -77,12 +82,19 @@ func TestPvzHandlers_List(t *testing.T) {
 
 	expectedDto := ToListResponse(samplePvzList)
 
+	codec := listparams.NewCursorCodec([]byte("test-secret"))
+	nextCursor := listparams.Cursor{Time: validDateTime, ID: samplePvzList[0].ID}
+	page2, page3 := uint(2), uint(3)
+
 	testcases := []struct {
 		name           string
 		requestQuery   string
 		pvzServiceMock func(*mocks.MockpvzService)
 		expectedCode   int
 		expected       []PVZListResponse
+		expectedPage   *PVZListPageResponse
+		expectedCursor string
+		expectedLink   string
 		expectedError  *response.Error
 	}{
 		{
@@ -93,10 +105,152 @@ func TestPvzHandlers_List(t *testing.T) {
 				service.
 					EXPECT().
 					List(gomock.Any(), gomock.Any()).
-					Return(samplePvzList, nil)
+					Return(samplePvzList, nil, nil)
 			},
 			expected: expectedDto,
 		},
+		{
+			name:         "next page by cursor",
+			requestQuery: "?limit=1&cursor=" + codec.Encode(nextCursor),
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, params *dto.PVZListParams) ([]*domain.PVZ, *listparams.Cursor, error) {
+						require.NotNil(t, params.Cursor)
+						assert.Equal(t, nextCursor.ID, params.Cursor.ID)
+						return samplePvzList, &nextCursor, nil
+					})
+			},
+			expected:       expectedDto,
+			expectedCursor: codec.Encode(nextCursor),
+		},
+		{
+			name:         "envelope with page",
+			requestQuery: "?limit=1&page=2&envelope=true",
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(samplePvzList, nil, nil)
+				service.
+					EXPECT().
+					Count(gomock.Any(), gomock.Any()).
+					Return(3, nil)
+			},
+			expectedPage: &PVZListPageResponse{
+				Items:   expectedDto,
+				Page:    &page2,
+				Limit:   1,
+				Total:   3,
+				HasNext: true,
+			},
+			expectedLink: `</pvz?envelope=true&limit=1>; rel="first", ` +
+				`</pvz?envelope=true&limit=1&page=1>; rel="prev", ` +
+				`</pvz?envelope=true&limit=1&page=3>; rel="next", ` +
+				`</pvz?envelope=true&limit=1&page=3>; rel="last"`,
+		},
+		{
+			name:         "envelope on last page",
+			requestQuery: "?limit=1&page=3&envelope=true",
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(samplePvzList, nil, nil)
+				service.
+					EXPECT().
+					Count(gomock.Any(), gomock.Any()).
+					Return(3, nil)
+			},
+			expectedPage: &PVZListPageResponse{
+				Items: expectedDto,
+				Page:  &page3,
+				Limit: 1,
+				Total: 3,
+			},
+			expectedLink: `</pvz?envelope=true&limit=1>; rel="first", ` +
+				`</pvz?envelope=true&limit=1&page=2>; rel="prev", ` +
+				`</pvz?envelope=true&limit=1&page=3>; rel="last"`,
+		},
+		{
+			name:         "envelope with cursor",
+			requestQuery: "?limit=1&envelope=true&cursor=" + codec.Encode(nextCursor),
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(samplePvzList, &nextCursor, nil)
+				service.
+					EXPECT().
+					Count(gomock.Any(), gomock.Any()).
+					Return(10, nil)
+			},
+			expectedPage: &PVZListPageResponse{
+				Items:      expectedDto,
+				Limit:      1,
+				Total:      10,
+				HasNext:    true,
+				NextCursor: codec.Encode(nextCursor),
+			},
+			expectedCursor: codec.Encode(nextCursor),
+			expectedLink: `</pvz?envelope=true&limit=1>; rel="first", ` +
+				`</pvz?cursor=` + codec.Encode(nextCursor) + `&envelope=true&limit=1>; rel="next"`,
+		},
+		{
+			name:         "count error",
+			requestQuery: "?envelope=true",
+			expectedCode: http.StatusInternalServerError,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(samplePvzList, nil, nil)
+				service.
+					EXPECT().
+					Count(gomock.Any(), gomock.Any()).
+					Return(0, errors.New("storage error"))
+			},
+			expectedError: &response.Error{
+				Message: "internal server error",
+				Details: "storage error",
+			},
+		},
+		{
+			name:         "invalid envelope",
+			requestQuery: "?envelope=maybe",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid envelope",
+			},
+		},
+		{
+			name:         "cursor with custom sort",
+			requestQuery: "?sort=city&cursor=" + codec.Encode(nextCursor),
+			expectedCode: http.StatusBadRequest,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(nil, nil, fmt.Errorf("pvz.List: %w", domain.ErrPVZCursorSort))
+			},
+			expectedError: &response.Error{
+				Message: "cursor can only be used with default sort",
+				Details: "pvz.List: cursor can only be used with default sort",
+			},
+		},
+		{
+			name:         "invalid cursor",
+			requestQuery: "?cursor=forged",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid cursor",
+			},
+		},
 		{
 			name:         "invalid time data",
 			requestQuery: "?startDate=2026-01-01&endDate=2026-02-01",
@@ -121,7 +275,7 @@ func TestPvzHandlers_List(t *testing.T) {
 				service.
 					EXPECT().
 					List(gomock.Any(), gomock.Any()).
-					Return(nil, errors.New("storage error"))
+					Return(nil, nil, errors.New("storage error"))
 			},
 			expectedError: &response.Error{
 				Message: "internal server error",
@@ -133,7 +287,7 @@ func TestPvzHandlers_List(t *testing.T) {
 	for _, tt := range testcases {
 		t.Run(tt.name, func(t *testing.T) {
 			pvzServiceMock := mocks.NewMockpvzService(ctrl)
-			handler := New(valid, pvzServiceMock)
+			handler := New(valid, pvzServiceMock, codec)
 
 			if tt.pvzServiceMock != nil {
 				tt.pvzServiceMock(pvzServiceMock)
@@ -148,6 +302,10 @@ func TestPvzHandlers_List(t *testing.T) {
 			t.Logf("Response body: %s", w.Body.String())
 
 			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedCursor, w.Header().Get(NextCursorHeader))
+			if tt.expectedLink != "" {
+				assert.Equal(t, tt.expectedLink, w.Header().Get("Link"))
+			}
 
 			if tt.expected != nil {
 				var res []PVZListResponse
@@ -157,6 +315,14 @@ func TestPvzHandlers_List(t *testing.T) {
 				assert.Equal(t, tt.expected, res)
 			}
 
+			if tt.expectedPage != nil {
+				var res PVZListPageResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedPage, &res)
+			}
+
 			if tt.expectedError != nil {
 				var errorRes response.Error
 				err := json.NewDecoder(w.Body).Decode(&errorRes)
@@ -244,6 +410,19 @@ func TestPvzHandlers_Create(t *testing.T) {
 				Details: "storage error",
 			},
 		},
+		{
+			name: "invalid latitude",
+			requestBody: map[string]any{
+				"id":               validPvzID,
+				"city":             cityName,
+				"registrationDate": validDateTime,
+				"location":         map[string]any{"lat": 95.5, "lon": 37.6},
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "field 'Lat' failed on the 'max' validation",
+			},
+		},
 		{
 			name: "validation failed",
 			requestBody: map[string]any{
@@ -259,7 +438,7 @@ func TestPvzHandlers_Create(t *testing.T) {
 	for _, tt := range testcases {
 		t.Run(tt.name, func(t *testing.T) {
 			pvzServiceMock := mocks.NewMockpvzService(ctrl)
-			handler := New(valid, pvzServiceMock)
+			handler := New(valid, pvzServiceMock, nil)
 
 			if tt.pvzServiceMock != nil {
 				tt.pvzServiceMock(pvzServiceMock)
@@ -295,3 +474,851 @@ func TestPvzHandlers_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestPvzHandlers_Inventory(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	pvzID := uuid.New()
+	asOf := time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name           string
+		pvzIDParam     string
+		query          string
+		pvzServiceMock func(*mocks.MockpvzService)
+		expectedCode   int
+		expected       *InventoryResponse
+		expectedError  *response.Error
+	}{
+		{
+			name:         "successful inventory",
+			pvzIDParam:   pvzID.String(),
+			query:        "?asOf=2026-02-11T10:30:00Z",
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					Inventory(gomock.Any(), dto.PVZInventoryParams{PvzID: pvzID, AsOf: &asOf}).
+					Return(&domain.PVZInventory{
+						PvzID: pvzID,
+						AsOf:  asOf,
+						Total: 3,
+						Items: []domain.InventoryItem{
+							{TypeName: "обувь", Count: 1},
+							{TypeName: "электроника", Count: 2},
+						},
+					}, nil)
+			},
+			expected: &InventoryResponse{
+				PvzID: pvzID,
+				AsOf:  asOf,
+				Total: 3,
+				Items: []InventoryItemResponse{
+					{Type: "обувь", Count: 1},
+					{Type: "электроника", Count: 2},
+				},
+			},
+		},
+		{
+			name:         "invalid pvz id",
+			pvzIDParam:   "bad",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid pvzID format",
+			},
+		},
+		{
+			name:         "invalid asOf",
+			pvzIDParam:   pvzID.String(),
+			query:        "?asOf=yesterday",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid asOf",
+			},
+		},
+		{
+			name:         "pvz not found",
+			pvzIDParam:   pvzID.String(),
+			expectedCode: http.StatusNotFound,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					Inventory(gomock.Any(), dto.PVZInventoryParams{PvzID: pvzID}).
+					Return(nil, domain.ErrPVZNotFound)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrPVZNotFound.Error(),
+				Details: domain.ErrPVZNotFound.Error(),
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			pvzServiceMock := mocks.NewMockpvzService(ctrl)
+			handler := New(valid, pvzServiceMock, nil)
+
+			if tt.pvzServiceMock != nil {
+				tt.pvzServiceMock(pvzServiceMock)
+			}
+
+			req := httptest.NewRequest("GET", "/pvz/"+tt.pvzIDParam+"/inventory"+tt.query, http.NoBody)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("pvzID", tt.pvzIDParam)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.Inventory(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res InventoryResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, &res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestPvzHandlers_ChangeStatus(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	pvzID := uuid.New()
+	registrationDate := time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC)
+	effectiveAt := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name           string
+		pvzIDParam     string
+		requestBody    any
+		pvzServiceMock func(*mocks.MockpvzService)
+		expectedCode   int
+		expected       *PvzResponse
+		expectedError  *response.Error
+	}{
+		{
+			name:       "successful suspend",
+			pvzIDParam: pvzID.String(),
+			requestBody: ChangeStatusRequest{
+				Status:        "suspended",
+				Reason:        "ремонт",
+				EffectiveDate: &effectiveAt,
+			},
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					ChangeStatus(gomock.Any(), dto.PVZStatusChange{
+						PvzID:       pvzID,
+						Status:      domain.PVZStatusSuspended,
+						Reason:      "ремонт",
+						EffectiveAt: &effectiveAt,
+					}).
+					Return(&domain.PVZ{
+						ID:                pvzID,
+						RegistrationDate:  registrationDate,
+						City:              &domain.City{Name: "Москва"},
+						Status:            domain.PVZStatusSuspended,
+						StatusReason:      "ремонт",
+						StatusEffectiveAt: &effectiveAt,
+					}, nil)
+			},
+			expected: &PvzResponse{
+				ID:                pvzID,
+				RegistrationDate:  registrationDate,
+				City:              "Москва",
+				Status:            "suspended",
+				StatusReason:      "ремонт",
+				StatusEffectiveAt: &effectiveAt,
+			},
+		},
+		{
+			name:         "invalid pvz id",
+			pvzIDParam:   "bad",
+			requestBody:  ChangeStatusRequest{Status: "active"},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid pvzID format",
+			},
+		},
+		{
+			name:         "empty body",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  "",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "request body is empty",
+			},
+		},
+		{
+			name:         "unknown status",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  ChangeStatusRequest{Status: "deleted", Reason: "ремонт"},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "field 'Status' failed on the 'oneof' validation",
+			},
+		},
+		{
+			name:         "reason required for closed",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  ChangeStatusRequest{Status: "closed"},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "field 'Reason' failed on the 'required_unless' validation",
+			},
+		},
+		{
+			name:         "transition not allowed",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  ChangeStatusRequest{Status: "active"},
+			expectedCode: http.StatusConflict,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					ChangeStatus(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("pvz.ChangeStatus: %w", domain.ErrInvalidPVZStatusTransition))
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrInvalidPVZStatusTransition.Error(),
+				Details: "pvz.ChangeStatus: " + domain.ErrInvalidPVZStatusTransition.Error(),
+			},
+		},
+		{
+			name:         "open reception",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  ChangeStatusRequest{Status: "closed", Reason: "переезд"},
+			expectedCode: http.StatusConflict,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					ChangeStatus(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrPVZHasOpenReception)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrPVZHasOpenReception.Error(),
+				Details: domain.ErrPVZHasOpenReception.Error(),
+			},
+		},
+		{
+			name:         "effective date not current",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  ChangeStatusRequest{Status: "closed", Reason: "переезд"},
+			expectedCode: http.StatusBadRequest,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					ChangeStatus(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrPVZStatusEffectiveDate)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrPVZStatusEffectiveDate.Error(),
+				Details: domain.ErrPVZStatusEffectiveDate.Error(),
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			pvzServiceMock := mocks.NewMockpvzService(ctrl)
+			handler := New(valid, pvzServiceMock, nil)
+
+			if tt.pvzServiceMock != nil {
+				tt.pvzServiceMock(pvzServiceMock)
+			}
+
+			bodyReader, err := testutils.MakeRequestBody(tt.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest("POST", "/pvz/"+tt.pvzIDParam+"/status", bodyReader)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("pvzID", tt.pvzIDParam)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.ChangeStatus(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res PvzResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, &res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestPvzHandlers_Update(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	pvzID := uuid.New()
+	registrationDate := time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC)
+	address := "ул. Тверская, 1"
+	capacity := 300
+
+	testcases := []struct {
+		name           string
+		pvzIDParam     string
+		requestBody    any
+		pvzServiceMock func(*mocks.MockpvzService)
+		expectedCode   int
+		expected       *PvzResponse
+		expectedError  *response.Error
+	}{
+		{
+			name:       "successful update",
+			pvzIDParam: pvzID.String(),
+			requestBody: map[string]any{
+				"address":      address,
+				"location":     map[string]any{"lat": 55.7558, "lon": 0},
+				"workingHours": []map[string]any{{"day": "mon", "open": "09:00", "close": "21:00"}},
+			},
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					UpdateProfile(gomock.Any(), dto.PVZProfileUpdate{
+						PvzID:        pvzID,
+						Address:      &address,
+						Location:     &domain.GeoPoint{Lat: 55.7558, Lon: 0},
+						WorkingHours: domain.WorkingHours{{Day: domain.Monday, Open: "09:00", Close: "21:00"}},
+					}).
+					Return(&domain.PVZ{
+						ID:               pvzID,
+						RegistrationDate: registrationDate,
+						Status:           domain.PVZStatusActive,
+						Address:          address,
+						Location:         &domain.GeoPoint{Lat: 55.7558, Lon: 0},
+						WorkingHours:     domain.WorkingHours{{Day: domain.Monday, Open: "09:00", Close: "21:00"}},
+						Capacity:         &capacity,
+					}, nil)
+			},
+			expected: &PvzResponse{
+				ID:               pvzID,
+				RegistrationDate: registrationDate,
+				Status:           "active",
+				Address:          address,
+				Location:         &Location{Lat: 55.7558, Lon: 0},
+				WorkingHours:     []WorkingInterval{{Day: "mon", Open: "09:00", Close: "21:00"}},
+				Capacity:         &capacity,
+			},
+		},
+		{
+			name:        "empty working hours clear schedule",
+			pvzIDParam:  pvzID.String(),
+			requestBody: map[string]any{"workingHours": []any{}},
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					UpdateProfile(gomock.Any(), dto.PVZProfileUpdate{
+						PvzID:        pvzID,
+						WorkingHours: domain.WorkingHours{},
+					}).
+					Return(&domain.PVZ{ID: pvzID, RegistrationDate: registrationDate}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expected: &PvzResponse{
+				ID:               pvzID,
+				RegistrationDate: registrationDate,
+			},
+		},
+		{
+			name:        "null capacity and location clear them",
+			pvzIDParam:  pvzID.String(),
+			requestBody: map[string]any{"capacity": nil, "location": nil},
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					UpdateProfile(gomock.Any(), dto.PVZProfileUpdate{
+						PvzID:         pvzID,
+						ClearLocation: true,
+						ClearCapacity: true,
+					}).
+					Return(&domain.PVZ{ID: pvzID, RegistrationDate: registrationDate}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expected: &PvzResponse{
+				ID:               pvzID,
+				RegistrationDate: registrationDate,
+			},
+		},
+		{
+			name:         "invalid pvz id",
+			pvzIDParam:   "bad",
+			requestBody:  map[string]any{"address": address},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid pvzID format",
+			},
+		},
+		{
+			name:         "no fields",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  map[string]any{},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "no fields to update",
+			},
+		},
+		{
+			name:         "location without longitude",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  map[string]any{"location": map[string]any{"lat": 55.7}},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "field 'Lon' failed on the 'required' validation",
+			},
+		},
+		{
+			name:         "unknown weekday",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  map[string]any{"workingHours": []map[string]any{{"day": "monday", "open": "09:00", "close": "21:00"}}},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "field 'Day' failed on the 'oneof' validation",
+			},
+		},
+		{
+			name:         "zero capacity",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  map[string]any{"capacity": 0},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "field 'Capacity' failed on the 'gt' validation",
+			},
+		},
+		{
+			name:        "type capacities",
+			pvzIDParam:  pvzID.String(),
+			requestBody: map[string]any{"typeCapacities": map[string]any{"обувь": 40}},
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					UpdateProfile(gomock.Any(), dto.PVZProfileUpdate{
+						PvzID:          pvzID,
+						TypeCapacities: map[string]int{"обувь": 40},
+					}).
+					Return(&domain.PVZ{ID: pvzID, RegistrationDate: registrationDate, TypeCapacities: map[string]int{"обувь": 40}}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expected: &PvzResponse{
+				ID:               pvzID,
+				RegistrationDate: registrationDate,
+				TypeCapacities:   map[string]int{"обувь": 40},
+			},
+		},
+		{
+			name:         "zero type capacity",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  map[string]any{"typeCapacities": map[string]any{"обувь": 0}},
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "field 'TypeCapacities[обувь]' failed on the 'gt' validation",
+			},
+		},
+		{
+			name:         "type capacity for unknown product type",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  map[string]any{"typeCapacities": map[string]any{"unknown": 5}},
+			expectedCode: http.StatusBadRequest,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					UpdateProfile(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: unknown", domain.ErrProductTypeNotFound))
+			},
+			expectedError: &response.Error{
+				Message: "product type not found: unknown",
+				Details: "product type not found: unknown",
+			},
+		},
+		{
+			name:         "malformed working hours",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  map[string]any{"workingHours": []map[string]any{{"day": "mon", "open": "9am", "close": "21:00"}}},
+			expectedCode: http.StatusBadRequest,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					UpdateProfile(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: invalid open time %q", domain.ErrInvalidWorkingHours, "9am"))
+			},
+			expectedError: &response.Error{
+				Message: `invalid working hours: invalid open time "9am"`,
+				Details: `invalid working hours: invalid open time "9am"`,
+			},
+		},
+		{
+			name:         "pvz not found",
+			pvzIDParam:   pvzID.String(),
+			requestBody:  map[string]any{"address": address},
+			expectedCode: http.StatusNotFound,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					UpdateProfile(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrPVZNotFound)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrPVZNotFound.Error(),
+				Details: domain.ErrPVZNotFound.Error(),
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			pvzServiceMock := mocks.NewMockpvzService(ctrl)
+			handler := New(valid, pvzServiceMock, nil)
+
+			if tt.pvzServiceMock != nil {
+				tt.pvzServiceMock(pvzServiceMock)
+			}
+
+			bodyReader, err := testutils.MakeRequestBody(tt.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest("PATCH", "/pvz/"+tt.pvzIDParam, bodyReader)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("pvzID", tt.pvzIDParam)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.Update(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res PvzResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, &res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestPvzHandlers_Nearby(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	pvzID := uuid.New()
+	registrationDate := time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC)
+	point := domain.GeoPoint{Lat: 55.7558, Lon: 37.6173}
+
+	testcases := []struct {
+		name           string
+		query          string
+		pvzServiceMock func(*mocks.MockpvzService)
+		expectedCode   int
+		expected       []NearbyResponse
+		expectedError  *response.Error
+	}{
+		{
+			name:         "successful nearby",
+			query:        "?lat=55.7558&lon=37.6173&radius=3",
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					ListNearby(gomock.Any(), dto.PVZNearbyParams{Point: point, RadiusKm: 3, Limit: listparams.DefaultLimit}).
+					Return([]*domain.PVZ{{
+						ID:               pvzID,
+						RegistrationDate: registrationDate,
+						City:             &domain.City{Name: "Москва"},
+						Status:           domain.PVZStatusActive,
+						Location:         &domain.GeoPoint{Lat: 55.76, Lon: 37.62},
+						DistanceKm:       0.51234,
+					}}, nil)
+			},
+			expected: []NearbyResponse{{
+				Pvz: PvzResponse{
+					ID:               pvzID,
+					RegistrationDate: registrationDate,
+					City:             "Москва",
+					Status:           "active",
+					Location:         &Location{Lat: 55.76, Lon: 37.62},
+				},
+				DistanceKm: 0.512,
+			}},
+		},
+		{
+			name:         "nothing nearby",
+			query:        "?lat=0&lon=0",
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					ListNearby(gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+			},
+			expected: []NearbyResponse{},
+		},
+		{
+			name:         "missing lat",
+			query:        "?lon=37.6173",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "lat is required",
+			},
+		},
+		{
+			name:         "radius too large",
+			query:        "?lat=55.7558&lon=37.6173&radius=500",
+			expectedCode: http.StatusBadRequest,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					ListNearby(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInvalidNearbyRadius)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrInvalidNearbyRadius.Error(),
+				Details: domain.ErrInvalidNearbyRadius.Error(),
+			},
+		},
+		{
+			name:         "service error",
+			query:        "?lat=55.7558&lon=37.6173",
+			expectedCode: http.StatusInternalServerError,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					ListNearby(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("storage error"))
+			},
+			expectedError: &response.Error{
+				Message: "internal server error",
+				Details: "storage error",
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			pvzServiceMock := mocks.NewMockpvzService(ctrl)
+			handler := New(valid, pvzServiceMock, nil)
+
+			if tt.pvzServiceMock != nil {
+				tt.pvzServiceMock(pvzServiceMock)
+			}
+
+			req := httptest.NewRequest("GET", "/pvz/nearby"+tt.query, http.NoBody)
+
+			w := httptest.NewRecorder()
+			handler.Nearby(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res []NearbyResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestPvzHandlers_Get(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	dateTime := time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC)
+
+	pvzRes := &domain.PVZ{
+		ID:               pvzID,
+		RegistrationDate: dateTime,
+		City:             &domain.City{Name: "Москва"},
+		StockOnHand:      1,
+		Receptions: []*domain.Reception{
+			{
+				ID:              receptionID,
+				PvzID:           pvzID,
+				DateTime:        dateTime,
+				ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusClose},
+				Products: []*domain.Product{
+					{ID: receptionID, DateTime: dateTime, ReceptionID: receptionID, ProductType: &domain.ProductType{Name: "обувь"}},
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name           string
+		pvzIDParam     string
+		query          string
+		pvzServiceMock func(*mocks.MockpvzService)
+		expectedCode   int
+		expected       *DetailResponse
+		expectedError  *response.Error
+	}{
+		{
+			name:         "successful get with products",
+			pvzIDParam:   pvzID.String(),
+			query:        "?include=products&limit=5",
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					Get(gomock.Any(), dto.PVZDetailParams{
+						PvzID:           pvzID,
+						Filter:          &dto.PVZFilter{},
+						Pagination:      &listparams.Pagination{Page: 1, Limit: 5},
+						IncludeProducts: true,
+					}).
+					Return(pvzRes, nil)
+			},
+			expected: &DetailResponse{
+				Pvz:         PvzResponse{ID: pvzID, RegistrationDate: dateTime, City: "Москва"},
+				StockOnHand: 1,
+				Receptions: []ReceptionDetail{
+					{
+						Reception: ReceptionsResponse{ID: receptionID, DateTime: dateTime, PvzID: pvzID, Status: "close"},
+						Products: []ProductsResponse{
+							{ID: receptionID, DateTime: dateTime, Type: "обувь", ReceptionID: receptionID},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:         "successful get without products",
+			pvzIDParam:   pvzID.String(),
+			expectedCode: http.StatusOK,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(pvzRes, nil)
+			},
+			expected: &DetailResponse{
+				Pvz:         PvzResponse{ID: pvzID, RegistrationDate: dateTime, City: "Москва"},
+				StockOnHand: 1,
+				Receptions: []ReceptionDetail{
+					{Reception: ReceptionsResponse{ID: receptionID, DateTime: dateTime, PvzID: pvzID, Status: "close"}},
+				},
+			},
+		},
+		{
+			name:         "invalid include",
+			pvzIDParam:   pvzID.String(),
+			query:        "?include=everything",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid include",
+			},
+		},
+		{
+			name:         "pvz not found",
+			pvzIDParam:   pvzID.String(),
+			expectedCode: http.StatusNotFound,
+			pvzServiceMock: func(service *mocks.MockpvzService) {
+				service.
+					EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrPVZNotFound)
+			},
+			expectedError: &response.Error{
+				Message: domain.ErrPVZNotFound.Error(),
+				Details: domain.ErrPVZNotFound.Error(),
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			pvzServiceMock := mocks.NewMockpvzService(ctrl)
+			handler := New(valid, pvzServiceMock, nil)
+
+			if tt.pvzServiceMock != nil {
+				tt.pvzServiceMock(pvzServiceMock)
+			}
+
+			req := httptest.NewRequest("GET", "/pvz/"+tt.pvzIDParam+tt.query, http.NoBody)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("pvzID", tt.pvzIDParam)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.Get(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res DetailResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, &res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
@@ -2,16 +2,38 @@ package pvz
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 )
 
 type PvzListParams struct {
 	Filter     PvzFilter
+	ListFilter PvzListFilter
+	Sort       []listparams.Sort
 	Pagination listparams.Pagination
+	Cursor     *listparams.Cursor
+	// Envelope ответ в обёртке с метаданными пагинации вместо массива
+	Envelope bool
+}
+
+type PvzDetailParams struct {
+	Filter          PvzFilter
+	Pagination      listparams.Pagination
+	IncludeProducts bool
+}
+
+// PvzNearbyParams радиус в километрах, 0 означает радиус по умолчанию.
+type PvzNearbyParams struct {
+	Point    domain.GeoPoint
+	RadiusKm float64
+	Limit    uint
 }
 
 type PvzFilter struct {
@@ -19,7 +41,14 @@ type PvzFilter struct {
 	EndDate   *time.Time
 }
 
-func getParsePvzParam(r *http.Request) (PvzListParams, error) {
+// PvzListFilter фильтры, доступные только в списке PVZ.
+type PvzListFilter struct {
+	Cities            []string
+	ReceptionStatuses []domain.ReceptionStatusCode
+	ProductTypes      []string
+}
+
+func getParsePvzParam(r *http.Request, cursorCodec *listparams.CursorCodec) (PvzListParams, error) {
 	q := r.URL.Query()
 
 	filter, err := parsePvzFilter(q)
@@ -27,17 +56,134 @@ func getParsePvzParam(r *http.Request) (PvzListParams, error) {
 		return PvzListParams{}, err
 	}
 
+	listFilter, err := parsePvzListFilter(q)
+	if err != nil {
+		return PvzListParams{}, err
+	}
+
+	sorts, err := listparams.ParseSorts(q, domain.PVZSortFields, nil)
+	if err != nil {
+		return PvzListParams{}, err
+	}
+
 	pagination, err := listparams.ParsePagination(q, listparams.Pagination{})
 	if err != nil {
 		return PvzListParams{}, err
 	}
 
+	cursor, err := listparams.ParseCursor(q, cursorCodec)
+	if err != nil {
+		return PvzListParams{}, err
+	}
+
+	envelope, err := parseEnvelope(q)
+	if err != nil {
+		return PvzListParams{}, err
+	}
+
 	return PvzListParams{
 		Filter:     filter,
+		ListFilter: listFilter,
+		Sort:       sorts,
 		Pagination: pagination,
+		Cursor:     cursor,
+		Envelope:   envelope,
+	}, nil
+}
+
+func getParsePvzDetailParam(r *http.Request) (PvzDetailParams, error) {
+	q := r.URL.Query()
+
+	filter, err := parsePvzFilter(q)
+	if err != nil {
+		return PvzDetailParams{}, err
+	}
+
+	pagination, err := listparams.ParsePagination(q, listparams.Pagination{})
+	if err != nil {
+		return PvzDetailParams{}, err
+	}
+
+	includeProducts, err := parseInclude(q)
+	if err != nil {
+		return PvzDetailParams{}, err
+	}
+
+	return PvzDetailParams{
+		Filter:          filter,
+		Pagination:      pagination,
+		IncludeProducts: includeProducts,
 	}, nil
 }
 
+func getParsePvzNearbyParam(r *http.Request) (PvzNearbyParams, error) {
+	q := r.URL.Query()
+
+	lat, err := parseRequiredFloat(q, "lat")
+	if err != nil {
+		return PvzNearbyParams{}, err
+	}
+
+	lon, err := parseRequiredFloat(q, "lon")
+	if err != nil {
+		return PvzNearbyParams{}, err
+	}
+
+	var radius float64
+	if v := q.Get("radius"); v != "" {
+		radius, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			return PvzNearbyParams{}, errors.New("radius must be a number")
+		}
+	}
+
+	// из пагинации нужен только limit, ближайшие PVZ отдаются одной страницей
+	pagination, err := listparams.ParsePagination(q, listparams.Pagination{})
+	if err != nil {
+		return PvzNearbyParams{}, err
+	}
+
+	return PvzNearbyParams{
+		Point:    domain.GeoPoint{Lat: lat, Lon: lon},
+		RadiusKm: radius,
+		Limit:    pagination.Limit,
+	}, nil
+}
+
+func parseRequiredFloat(q url.Values, key string) (float64, error) {
+	v := q.Get(key)
+	if v == "" {
+		return 0, fmt.Errorf("%s is required", key)
+	}
+
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number", key)
+	}
+
+	return f, nil
+}
+
+// parseInclude разбирает список расширений через запятую, пока поддерживается только products.
+func parseInclude(q url.Values) (includeProducts bool, err error) {
+	v := q.Get("include")
+	if v == "" {
+		return false, nil
+	}
+
+	for _, part := range strings.Split(v, ",") {
+		switch strings.TrimSpace(part) {
+		case "products":
+			includeProducts = true
+		case "":
+		default:
+			return false, errors.New("invalid include")
+		}
+	}
+
+	return includeProducts, nil
+}
+
 func parsePvzFilter(q url.Values) (PvzFilter, error) {
 	var f PvzFilter
 
@@ -59,3 +205,62 @@ func parsePvzFilter(q url.Values) (PvzFilter, error) {
 
 	return f, nil
 }
+
+func parsePvzListFilter(q url.Values) (PvzListFilter, error) {
+	f := PvzListFilter{
+		Cities:       parseMulti(q, "city"),
+		ProductTypes: parseMulti(q, "productType"),
+	}
+
+	for _, v := range parseMulti(q, "receptionStatus") {
+		status := domain.ReceptionStatusCode(v)
+		if !status.IsValid() {
+			return f, errors.New("invalid receptionStatus")
+		}
+		f.ReceptionStatuses = append(f.ReceptionStatuses, status)
+	}
+
+	return f, nil
+}
+
+// parseMulti читает значения параметра, переданные несколько раз или через запятую.
+func parseMulti(q url.Values, key string) []string {
+	var values []string
+	for _, v := range q[key] {
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				values = append(values, part)
+			}
+		}
+	}
+
+	return values
+}
+
+func parseEnvelope(q url.Values) (bool, error) {
+	v := q.Get("envelope")
+	if v == "" {
+		return false, nil
+	}
+
+	envelope, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, errors.New("invalid envelope")
+	}
+
+	return envelope, nil
+}
+
+func parseAsOf(q url.Values) (*time.Time, error) {
+	v := q.Get("asOf")
+	if v == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, errors.New("invalid asOf")
+	}
+
+	return &t, nil
+}
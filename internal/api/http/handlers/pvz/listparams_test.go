@@ -8,8 +8,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 )
 
 func Test_parsePvzFilter(t *testing.T) {
@@ -94,7 +97,7 @@ func Test_getParsePvzParam(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/pvz?startDate="+validStart+"&endDate="+validEnd+"&limit=10&page=5", http.NoBody)
 
-	params, err := getParsePvzParam(req)
+	params, err := getParsePvzParam(req, listparams.NewCursorCodec([]byte("secret")))
 	require.NoError(t, err)
 
 	expectedStart, _ := time.Parse(time.RFC3339, validStart)
@@ -114,7 +117,117 @@ func Test_getParsePvzParam(t *testing.T) {
 func Test_getParsePvzParam_InvalidDate(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/pvz?startDate=invalid", http.NoBody)
 
-	_, err := getParsePvzParam(req)
+	_, err := getParsePvzParam(req, listparams.NewCursorCodec([]byte("secret")))
 	require.Error(t, err)
 	assert.Equal(t, "invalid startDate", err.Error())
 }
+
+func Test_getParsePvzParam_Cursor(t *testing.T) {
+	codec := listparams.NewCursorCodec([]byte("secret"))
+	cursor := listparams.Cursor{Time: time.Date(2026, 2, 11, 10, 30, 0, 0, time.UTC), ID: uuid.New()}
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz?limit=10&cursor="+codec.Encode(cursor), http.NoBody)
+
+	params, err := getParsePvzParam(req, codec)
+	require.NoError(t, err)
+	require.NotNil(t, params.Cursor)
+	assert.Equal(t, cursor, *params.Cursor)
+
+	req = httptest.NewRequest(http.MethodGet, "/pvz?page=2&cursor="+codec.Encode(cursor), http.NoBody)
+
+	_, err = getParsePvzParam(req, codec)
+	require.Error(t, err)
+}
+
+func Test_getParsePvzParam_FilterAndSort(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet,
+		"/pvz?city=Москва,Казань&city=Санкт-Петербург&receptionStatus=close&productType=обувь&sort=city,-lastReceptionAt", http.NoBody)
+
+	params, err := getParsePvzParam(req, listparams.NewCursorCodec([]byte("secret")))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Москва", "Казань", "Санкт-Петербург"}, params.ListFilter.Cities)
+	assert.Equal(t, []domain.ReceptionStatusCode{domain.ReceptionStatusClose}, params.ListFilter.ReceptionStatuses)
+	assert.Equal(t, []string{"обувь"}, params.ListFilter.ProductTypes)
+	assert.Equal(t, []listparams.Sort{
+		{Field: domain.PVZSortCity, Order: listparams.SortAsc},
+		{Field: domain.PVZSortLastReceptionAt, Order: listparams.SortDesc},
+	}, params.Sort)
+
+	testcases := []struct {
+		query   string
+		wantErr string
+	}{
+		{query: "receptionStatus=open", wantErr: "invalid receptionStatus"},
+		{query: "sort=id", wantErr: "invalid sort field"},
+		{query: "field=city&order=sideways", wantErr: "invalid sort order"},
+		{query: "envelope=maybe", wantErr: "invalid envelope"},
+	}
+
+	for _, tt := range testcases {
+		req := httptest.NewRequest(http.MethodGet, "/pvz?"+tt.query, http.NoBody)
+
+		_, err := getParsePvzParam(req, listparams.NewCursorCodec([]byte("secret")))
+		require.EqualError(t, err, tt.wantErr, tt.query)
+	}
+}
+
+func Test_parseAsOf(t *testing.T) {
+	asOf, err := parseAsOf(url.Values{})
+	require.NoError(t, err)
+	assert.Nil(t, asOf)
+
+	asOf, err = parseAsOf(url.Values{"asOf": []string{"2026-02-11T10:30:00Z"}})
+	require.NoError(t, err)
+	require.NotNil(t, asOf)
+	assert.Equal(t, time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC), asOf.UTC())
+
+	_, err = parseAsOf(url.Values{"asOf": []string{"yesterday"}})
+	require.Error(t, err)
+	assert.Equal(t, "invalid asOf", err.Error())
+}
+
+func Test_parseInclude(t *testing.T) {
+	includeProducts, err := parseInclude(url.Values{})
+	require.NoError(t, err)
+	assert.False(t, includeProducts)
+
+	includeProducts, err = parseInclude(url.Values{"include": []string{"products"}})
+	require.NoError(t, err)
+	assert.True(t, includeProducts)
+
+	_, err = parseInclude(url.Values{"include": []string{"products,receptions"}})
+	require.Error(t, err)
+	assert.Equal(t, "invalid include", err.Error())
+}
+
+func Test_getParsePvzNearbyParam(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/pvz/nearby?lat=55.7558&lon=-37.6&radius=2.5&limit=5", http.NoBody)
+
+	params, err := getParsePvzNearbyParam(req)
+	require.NoError(t, err)
+	assert.Equal(t, PvzNearbyParams{
+		Point:    domain.GeoPoint{Lat: 55.7558, Lon: -37.6},
+		RadiusKm: 2.5,
+		Limit:    5,
+	}, params)
+
+	req = httptest.NewRequest(http.MethodGet, "/pvz/nearby?lat=0&lon=0", http.NoBody)
+
+	params, err = getParsePvzNearbyParam(req)
+	require.NoError(t, err)
+	assert.Equal(t, PvzNearbyParams{Limit: listparams.DefaultLimit}, params)
+
+	for query, expectError := range map[string]string{
+		"lon=37.6":                    "lat is required",
+		"lat=55.7&lon=east":           "lon must be a number",
+		"lat=55.7&lon=37.6&radius=km": "radius must be a number",
+		"lat=55.7&lon=37.6&limit=500": "limit must be between 1 and 100",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/pvz/nearby?"+query, http.NoBody)
+
+		_, err := getParsePvzNearbyParam(req)
+		require.Error(t, err, query)
+		assert.Equal(t, expectError, err.Error())
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	domain "github.com/valeragav/avito-pvz-service/internal/domain"
 	dto "github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	listparams "github.com/valeragav/avito-pvz-service/pkg/listparams"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +43,36 @@ func (m *MockpvzService) EXPECT() *MockpvzServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangeStatus mocks base method.
+func (m *MockpvzService) ChangeStatus(ctx context.Context, changeIn dto.PVZStatusChange) (*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", ctx, changeIn)
+	ret0, _ := ret[0].(*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeStatus indicates an expected call of ChangeStatus.
+func (mr *MockpvzServiceMockRecorder) ChangeStatus(ctx, changeIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockpvzService)(nil).ChangeStatus), ctx, changeIn)
+}
+
+// Count mocks base method.
+func (m *MockpvzService) Count(ctx context.Context, pvzListParams *dto.PVZListParams) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, pvzListParams)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockpvzServiceMockRecorder) Count(ctx, pvzListParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockpvzService)(nil).Count), ctx, pvzListParams)
+}
+
 // Create mocks base method.
 func (m *MockpvzService) Create(ctx context.Context, createIn dto.PVZCreate) (*domain.PVZ, error) {
 	m.ctrl.T.Helper()
@@ -57,13 +88,44 @@ func (mr *MockpvzServiceMockRecorder) Create(ctx, createIn any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockpvzService)(nil).Create), ctx, createIn)
 }
 
+// Get mocks base method.
+func (m *MockpvzService) Get(ctx context.Context, params dto.PVZDetailParams) (*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, params)
+	ret0, _ := ret[0].(*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockpvzServiceMockRecorder) Get(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockpvzService)(nil).Get), ctx, params)
+}
+
+// Inventory mocks base method.
+func (m *MockpvzService) Inventory(ctx context.Context, params dto.PVZInventoryParams) (*domain.PVZInventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Inventory", ctx, params)
+	ret0, _ := ret[0].(*domain.PVZInventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Inventory indicates an expected call of Inventory.
+func (mr *MockpvzServiceMockRecorder) Inventory(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Inventory", reflect.TypeOf((*MockpvzService)(nil).Inventory), ctx, params)
+}
+
 // List mocks base method.
-func (m *MockpvzService) List(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, error) {
+func (m *MockpvzService) List(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, *listparams.Cursor, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", ctx, pvzListParams)
 	ret0, _ := ret[0].([]*domain.PVZ)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*listparams.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // List indicates an expected call of List.
@@ -71,3 +133,33 @@ func (mr *MockpvzServiceMockRecorder) List(ctx, pvzListParams any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockpvzService)(nil).List), ctx, pvzListParams)
 }
+
+// ListNearby mocks base method.
+func (m *MockpvzService) ListNearby(ctx context.Context, params dto.PVZNearbyParams) ([]*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNearby", ctx, params)
+	ret0, _ := ret[0].([]*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNearby indicates an expected call of ListNearby.
+func (mr *MockpvzServiceMockRecorder) ListNearby(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNearby", reflect.TypeOf((*MockpvzService)(nil).ListNearby), ctx, params)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockpvzService) UpdateProfile(ctx context.Context, updateIn dto.PVZProfileUpdate) (*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, updateIn)
+	ret0, _ := ret[0].(*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockpvzServiceMockRecorder) UpdateProfile(ctx, updateIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockpvzService)(nil).UpdateProfile), ctx, updateIn)
+}
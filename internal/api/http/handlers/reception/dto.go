@@ -19,9 +19,17 @@ type CreateResponse struct {
 	Status   string    `json:"status"`
 }
 
-func ToCreateIn(req CreateRequest) dto.ReceptionCreate {
+func ToCreateIn(req CreateRequest, createdBy uuid.UUID) dto.ReceptionCreate {
 	return dto.ReceptionCreate{
-		PvzID: req.PvzID,
+		PvzID:     req.PvzID,
+		CreatedBy: createdBy,
+	}
+}
+
+func ToCloseIn(pvzID, closedBy uuid.UUID) dto.ReceptionClose {
+	return dto.ReceptionClose{
+		PvzID:    pvzID,
+		ClosedBy: closedBy,
 	}
 }
 
@@ -58,3 +66,50 @@ func ToCloseLastReceptionResponse(out domain.Reception) CloseLastReceptionRespon
 		Status:   status,
 	}
 }
+
+type ReceptionResponse struct {
+	ID       uuid.UUID `json:"id"`
+	DateTime time.Time `json:"dateTime"`
+	PvzID    uuid.UUID `json:"pvzID"`
+	Status   string    `json:"status"`
+}
+
+type TransitionResponse struct {
+	FromStatus string    `json:"fromStatus,omitempty"`
+	ToStatus   string    `json:"toStatus"`
+	ActorID    uuid.UUID `json:"actorId"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func ToTransitionIn(receptionID, actorID uuid.UUID) dto.ReceptionTransition {
+	return dto.ReceptionTransition{
+		ReceptionID: receptionID,
+		ActorID:     actorID,
+	}
+}
+
+func ToReceptionResponse(out domain.Reception) ReceptionResponse {
+	var status string
+	if out.ReceptionStatus != nil {
+		status = string(out.ReceptionStatus.Name)
+	}
+	return ReceptionResponse{
+		ID:       out.ID,
+		DateTime: out.DateTime,
+		PvzID:    out.PvzID,
+		Status:   status,
+	}
+}
+
+func ToTransitionListResponse(transitions []*domain.ReceptionTransition) []TransitionResponse {
+	result := make([]TransitionResponse, 0, len(transitions))
+	for _, t := range transitions {
+		result = append(result, TransitionResponse{
+			FromStatus: string(t.FromStatus),
+			ToStatus:   string(t.ToStatus),
+			ActorID:    t.ActorID,
+			CreatedAt:  t.CreatedAt,
+		})
+	}
+	return result
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/internal/metrics"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
@@ -19,8 +20,11 @@ import (
 
 //go:generate ${LOCAL_BIN}/mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
 type receptionService interface {
-	CloseLastReception(ctx context.Context, pvzID uuid.UUID) (*domain.Reception, error)
+	CloseLastReception(ctx context.Context, closeIn dto.ReceptionClose) (*domain.Reception, error)
 	Create(ctx context.Context, createIn dto.ReceptionCreate) (*domain.Reception, error)
+	Cancel(ctx context.Context, in dto.ReceptionTransition) (*domain.Reception, error)
+	Reopen(ctx context.Context, in dto.ReceptionTransition) (*domain.Reception, error)
+	ListTransitions(ctx context.Context, receptionID uuid.UUID) ([]*domain.ReceptionTransition, error)
 }
 
 type ReceptionHandlers struct {
@@ -46,6 +50,7 @@ func New(validator *validation.Validator, receptionService receptionService) *Re
 // @Success 201 {object} CreateResponse "Reception successfully created"
 // @Failure 400 {object} response.Error "Invalid request or validation failed"
 // @Failure 404 {object} response.Error "PVZ not found"
+// @Failure 409 {object} response.Error "PVZ is not active"
 // @Failure 500 {object} response.Error "Internal server error"
 // @Router /receptions [post]
 func (h *ReceptionHandlers) Create(w http.ResponseWriter, r *http.Request) {
@@ -66,7 +71,9 @@ func (h *ReceptionHandlers) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	receptionRes, err := h.receptionService.Create(ctx, ToCreateIn(req))
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	receptionRes, err := h.receptionService.Create(ctx, ToCreateIn(req, claims.UserID))
 	if err != nil {
 		mess, code := mapErrorToHTTP(err)
 
@@ -109,7 +116,9 @@ func (h *ReceptionHandlers) CloseLastReception(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	pvzRes, err := h.receptionService.CloseLastReception(ctx, pvzID)
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	pvzRes, err := h.receptionService.CloseLastReception(ctx, ToCloseIn(pvzID, claims.UserID))
 	if err != nil {
 		mess, code := mapErrorToHTTP(err)
 
@@ -123,6 +132,100 @@ func (h *ReceptionHandlers) CloseLastReception(w http.ResponseWriter, r *http.Re
 	response.WriteJSON(w, ctx, http.StatusOK, res)
 }
 
+// @Summary Cancel Reception
+// @Description Cancel an open reception created by mistake. Cancelled reception can not be closed or reopened. Requires JWT-Token with Moderator role.
+// @ID CancelReception
+// @Tags Reception
+// @Security ApiKeyAuth
+// @Produce json
+// @Param receptionID path string true "Reception ID"
+// @Success 200 {object} ReceptionResponse "Reception cancelled"
+// @Failure 400 {object} response.Error "Invalid reception ID"
+// @Failure 404 {object} response.Error "Reception not found"
+// @Failure 409 {object} response.Error "Transition is not allowed"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /receptions/{receptionID}/cancel [post]
+func (h *ReceptionHandlers) Cancel(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, h.receptionService.Cancel)
+}
+
+// @Summary Reopen Reception
+// @Description Reopen a closed reception to fix a scan. PVZ must have no other open reception. Requires JWT-Token with Moderator role.
+// @ID ReopenReception
+// @Tags Reception
+// @Security ApiKeyAuth
+// @Produce json
+// @Param receptionID path string true "Reception ID"
+// @Success 200 {object} ReceptionResponse "Reception reopened"
+// @Failure 400 {object} response.Error "Invalid reception ID"
+// @Failure 404 {object} response.Error "Reception not found"
+// @Failure 409 {object} response.Error "Transition is not allowed, PVZ already has an open reception or is not active, or a product barcode is already in another open reception"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /receptions/{receptionID}/reopen [post]
+func (h *ReceptionHandlers) Reopen(w http.ResponseWriter, r *http.Request) {
+	h.transition(w, r, h.receptionService.Reopen)
+}
+
+// @Summary List Reception Transitions
+// @Description Get status history of a reception in chronological order. Requires JWT-Token with Moderator role.
+// @ID ListReceptionTransitions
+// @Tags Reception
+// @Security ApiKeyAuth
+// @Produce json
+// @Param receptionID path string true "Reception ID"
+// @Success 200 {array} TransitionResponse "Status history"
+// @Failure 400 {object} response.Error "Invalid reception ID"
+// @Failure 404 {object} response.Error "Reception not found"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /receptions/{receptionID}/transitions [get]
+func (h *ReceptionHandlers) ListTransitions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	receptionID, err := uuid.Parse(chi.URLParam(r, "receptionID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid receptionID format", nil)
+		return
+	}
+
+	transitions, err := h.receptionService.ListTransitions(ctx, receptionID)
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToTransitionListResponse(transitions))
+}
+
+func (h *ReceptionHandlers) transition(
+	w http.ResponseWriter,
+	r *http.Request,
+	fn func(ctx context.Context, in dto.ReceptionTransition) (*domain.Reception, error),
+) {
+	ctx := r.Context()
+
+	receptionID, err := uuid.Parse(chi.URLParam(r, "receptionID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid receptionID format", nil)
+		return
+	}
+
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	receptionRes, err := fn(ctx, ToTransitionIn(receptionID, claims.UserID))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToReceptionResponse(*receptionRes))
+}
+
 func mapErrorToHTTP(err error) (msg string, statusCode int) {
 	switch {
 	case errors.Is(err, domain.ErrNoReceptionIsCurrentlyInProgress):
@@ -133,6 +236,14 @@ func mapErrorToHTTP(err error) (msg string, statusCode int) {
 		msg = err.Error()
 		statusCode = http.StatusNotFound
 
+	case errors.Is(err, domain.ErrInvalidReceptionTransition), errors.Is(err, domain.ErrPVZHasOpenReception):
+		msg = err.Error()
+		statusCode = http.StatusConflict
+
+	case errors.Is(err, domain.ErrPVZNotActive), errors.Is(err, domain.ErrReceptionBarcodeConflict):
+		msg = err.Error()
+		statusCode = http.StatusConflict
+
 	default:
 		statusCode = http.StatusInternalServerError
 		msg = "internal server error"
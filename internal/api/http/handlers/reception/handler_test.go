@@ -15,7 +15,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/reception/mocks"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
 	"github.com/valeragav/avito-pvz-service/pkg/testutils"
 	"github.com/valeragav/avito-pvz-service/pkg/validation"
 	"go.uber.org/mock/gomock"
@@ -30,6 +32,7 @@ func TestReceptionsHandlers_Create(t *testing.T) {
 	validPvzID := uuid.New()
 	validReceptionID := uuid.New()
 	validTime := time.Now().UTC()
+	userID := uuid.New()
 
 	tests := []struct {
 		name                  string
@@ -46,7 +49,7 @@ func TestReceptionsHandlers_Create(t *testing.T) {
 			},
 			receptionsServiceMock: func(s *mocks.MockreceptionService) {
 				s.EXPECT().
-					Create(gomock.Any(), gomock.Any()).
+					Create(gomock.Any(), dto.ReceptionCreate{PvzID: validPvzID, CreatedBy: userID}).
 					Return(&domain.Reception{
 						ID:       validReceptionID,
 						DateTime: validTime,
@@ -108,6 +111,7 @@ func TestReceptionsHandlers_Create(t *testing.T) {
 			require.NoError(t, err)
 
 			req := httptest.NewRequest(http.MethodPost, "/receptions", bodyReader)
+			req = req.WithContext(context.WithValue(req.Context(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.EmployeeRole}))
 
 			w := httptest.NewRecorder()
 			handler.Create(w, req)
@@ -248,3 +252,193 @@ func TestReceptionsHandlers_CloseLastReception(t *testing.T) {
 		})
 	}
 }
+
+func TestReceptionsHandlers_Cancel(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validator := validation.New()
+	validTime := time.Now().UTC()
+	receptionID := uuid.New()
+	pvzID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name                  string
+		receptionID           string
+		receptionsServiceMock func(*mocks.MockreceptionService)
+		expectedCode          int
+		expected              *ReceptionResponse
+		expectedError         *response.Error
+	}{
+		{
+			name:        "success",
+			receptionID: receptionID.String(),
+			receptionsServiceMock: func(s *mocks.MockreceptionService) {
+				s.EXPECT().
+					Cancel(gomock.Any(), dto.ReceptionTransition{ReceptionID: receptionID, ActorID: userID}).
+					Return(&domain.Reception{
+						ID:              receptionID,
+						DateTime:        validTime,
+						PvzID:           pvzID,
+						ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusCancelled},
+					}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expected: &ReceptionResponse{
+				ID:       receptionID,
+				DateTime: validTime,
+				PvzID:    pvzID,
+				Status:   "cancelled",
+			},
+		},
+		{
+			name:         "invalid uuid",
+			receptionID:  "invalid",
+			expectedCode: http.StatusBadRequest,
+			expectedError: &response.Error{
+				Message: "invalid receptionID format",
+			},
+		},
+		{
+			name:        "transition not allowed",
+			receptionID: receptionID.String(),
+			receptionsServiceMock: func(s *mocks.MockreceptionService) {
+				s.EXPECT().
+					Cancel(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrInvalidReceptionTransition)
+			},
+			expectedCode: http.StatusConflict,
+			expectedError: &response.Error{
+				Message: "reception status transition is not allowed",
+				Details: "reception status transition is not allowed",
+			},
+		},
+		{
+			name:        "reception not found",
+			receptionID: receptionID.String(),
+			receptionsServiceMock: func(s *mocks.MockreceptionService) {
+				s.EXPECT().
+					Cancel(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrReceptionNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedError: &response.Error{
+				Message: "reception not found",
+				Details: "reception not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receptionServiceMock := mocks.NewMockreceptionService(ctrl)
+			handler := New(validator, receptionServiceMock)
+
+			if tt.receptionsServiceMock != nil {
+				tt.receptionsServiceMock(receptionServiceMock)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/receptions/"+tt.receptionID+"/cancel", http.NoBody)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("receptionID", tt.receptionID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.ModeratorRole}))
+
+			w := httptest.NewRecorder()
+
+			handler.Cancel(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res ReceptionResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expected, &res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestReceptionsHandlers_Reopen(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validator := validation.New()
+	receptionID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name                  string
+		receptionsServiceMock func(*mocks.MockreceptionService)
+		expectedCode          int
+	}{
+		{
+			name: "success",
+			receptionsServiceMock: func(s *mocks.MockreceptionService) {
+				s.EXPECT().
+					Reopen(gomock.Any(), dto.ReceptionTransition{ReceptionID: receptionID, ActorID: userID}).
+					Return(&domain.Reception{
+						ID:              receptionID,
+						ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusReopened},
+					}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "pvz has open reception",
+			receptionsServiceMock: func(s *mocks.MockreceptionService) {
+				s.EXPECT().
+					Reopen(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrPVZHasOpenReception)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "barcode rescanned in another open reception",
+			receptionsServiceMock: func(s *mocks.MockreceptionService) {
+				s.EXPECT().
+					Reopen(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrReceptionBarcodeConflict)
+			},
+			expectedCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receptionServiceMock := mocks.NewMockreceptionService(ctrl)
+			handler := New(validator, receptionServiceMock)
+
+			tt.receptionsServiceMock(receptionServiceMock)
+
+			req := httptest.NewRequest(http.MethodPost, "/receptions/"+receptionID.String()+"/reopen", http.NoBody)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("receptionID", receptionID.String())
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.ModeratorRole}))
+
+			w := httptest.NewRecorder()
+
+			handler.Reopen(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
@@ -43,19 +43,34 @@ func (m *MockreceptionService) EXPECT() *MockreceptionServiceMockRecorder {
 	return m.recorder
 }
 
+// Cancel mocks base method.
+func (m *MockreceptionService) Cancel(ctx context.Context, in dto.ReceptionTransition) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, in)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockreceptionServiceMockRecorder) Cancel(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockreceptionService)(nil).Cancel), ctx, in)
+}
+
 // CloseLastReception mocks base method.
-func (m *MockreceptionService) CloseLastReception(ctx context.Context, pvzID uuid.UUID) (*domain.Reception, error) {
+func (m *MockreceptionService) CloseLastReception(ctx context.Context, closeIn dto.ReceptionClose) (*domain.Reception, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseLastReception", ctx, pvzID)
+	ret := m.ctrl.Call(m, "CloseLastReception", ctx, closeIn)
 	ret0, _ := ret[0].(*domain.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CloseLastReception indicates an expected call of CloseLastReception.
-func (mr *MockreceptionServiceMockRecorder) CloseLastReception(ctx, pvzID any) *gomock.Call {
+func (mr *MockreceptionServiceMockRecorder) CloseLastReception(ctx, closeIn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockreceptionService)(nil).CloseLastReception), ctx, pvzID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockreceptionService)(nil).CloseLastReception), ctx, closeIn)
 }
 
 // Create mocks base method.
@@ -72,3 +87,33 @@ func (mr *MockreceptionServiceMockRecorder) Create(ctx, createIn any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockreceptionService)(nil).Create), ctx, createIn)
 }
+
+// ListTransitions mocks base method.
+func (m *MockreceptionService) ListTransitions(ctx context.Context, receptionID uuid.UUID) ([]*domain.ReceptionTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransitions", ctx, receptionID)
+	ret0, _ := ret[0].([]*domain.ReceptionTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransitions indicates an expected call of ListTransitions.
+func (mr *MockreceptionServiceMockRecorder) ListTransitions(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransitions", reflect.TypeOf((*MockreceptionService)(nil).ListTransitions), ctx, receptionID)
+}
+
+// Reopen mocks base method.
+func (m *MockreceptionService) Reopen(ctx context.Context, in dto.ReceptionTransition) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, in)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockreceptionServiceMockRecorder) Reopen(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockreceptionService)(nil).Reopen), ctx, in)
+}
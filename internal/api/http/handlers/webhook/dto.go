@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+)
+
+type CreateRequest struct {
+	URL        string   `json:"url" validate:"required,url,max=2048"`
+	Secret     string   `json:"secret" validate:"omitempty,min=16,max=255"`
+	EventTypes []string `json:"eventTypes" validate:"required,min=1,dive,required"`
+}
+
+type SubscriptionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"eventTypes"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// CreateResponse единственный ответ, в котором возвращается секрет подписки.
+type CreateResponse struct {
+	SubscriptionResponse
+	Secret string `json:"secret"`
+}
+
+type DeliveryResponse struct {
+	ID             uuid.UUID  `json:"id"`
+	EventID        uuid.UUID  `json:"eventId"`
+	EventType      string     `json:"eventType"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt"`
+	LastStatusCode int        `json:"lastStatusCode,omitempty"`
+	LastError      string     `json:"lastError,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+}
+
+func ToCreateIn(req CreateRequest, createdBy uuid.UUID) dto.WebhookSubscriptionCreate {
+	eventTypes := make([]domain.EventType, 0, len(req.EventTypes))
+	for _, t := range req.EventTypes {
+		eventTypes = append(eventTypes, domain.EventType(t))
+	}
+
+	return dto.WebhookSubscriptionCreate{
+		URL:        req.URL,
+		Secret:     req.Secret,
+		EventTypes: eventTypes,
+		CreatedBy:  createdBy,
+	}
+}
+
+func ToDeliveryListParams(subscriptionID uuid.UUID, pagination listparams.Pagination) dto.WebhookDeliveryListParams {
+	return dto.WebhookDeliveryListParams{
+		SubscriptionID: subscriptionID,
+		Pagination:     &pagination,
+	}
+}
+
+func ToSubscriptionResponse(s domain.WebhookSubscription) SubscriptionResponse {
+	eventTypes := make([]string, 0, len(s.EventTypes))
+	for _, t := range s.EventTypes {
+		eventTypes = append(eventTypes, string(t))
+	}
+
+	return SubscriptionResponse{
+		ID:         s.ID,
+		URL:        s.URL,
+		EventTypes: eventTypes,
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+func ToCreateResponse(s domain.WebhookSubscription) CreateResponse {
+	return CreateResponse{
+		SubscriptionResponse: ToSubscriptionResponse(s),
+		Secret:               s.Secret,
+	}
+}
+
+func ToListResponse(subscriptions []*domain.WebhookSubscription) []SubscriptionResponse {
+	result := make([]SubscriptionResponse, 0, len(subscriptions))
+	for _, s := range subscriptions {
+		result = append(result, ToSubscriptionResponse(*s))
+	}
+	return result
+}
+
+func ToDeliveryListResponse(deliveries []*domain.WebhookDelivery) []DeliveryResponse {
+	result := make([]DeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		result = append(result, DeliveryResponse{
+			ID:             d.ID,
+			EventID:        d.Event.ID,
+			EventType:      string(d.Event.Type),
+			Status:         string(d.Status),
+			Attempts:       d.Attempts,
+			NextAttemptAt:  d.NextAttemptAt,
+			LastStatusCode: d.LastStatusCode,
+			LastError:      d.LastError,
+			CreatedAt:      d.CreatedAt,
+			DeliveredAt:    d.DeliveredAt,
+		})
+	}
+	return result
+}
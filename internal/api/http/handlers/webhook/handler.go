@@ -0,0 +1,207 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+	"github.com/valeragav/avito-pvz-service/pkg/validation"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=handler.go -destination=./mocks/service_mock.go -package=mocks
+type webhookService interface {
+	CreateSubscription(ctx context.Context, createIn dto.WebhookSubscriptionCreate) (*domain.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, pagination *listparams.Pagination) ([]*domain.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, subscriptionID uuid.UUID) error
+	ListDeliveries(ctx context.Context, params dto.WebhookDeliveryListParams) ([]*domain.WebhookDelivery, error)
+}
+
+type WebhookHandlers struct {
+	validator      *validation.Validator
+	webhookService webhookService
+}
+
+func New(validator *validation.Validator, webhookService webhookService) *WebhookHandlers {
+	return &WebhookHandlers{
+		validator,
+		webhookService,
+	}
+}
+
+// @Summary Create webhook subscription
+// @Description Register URL to receive signed event callbacks. If secret is omitted it is generated and returned only in this response. Requires JWT-Token with Moderator role.
+// @ID CreateWebhook
+// @Tags Webhooks
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param input body CreateRequest true "Subscription data"
+// @Success 201 {object} CreateResponse "Subscription created"
+// @Failure 400 {object} response.Error "Invalid request or validation failed"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /webhooks [post]
+func (h *WebhookHandlers) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req CreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		if errors.Is(err, io.EOF) {
+			response.WriteError(w, ctx, http.StatusBadRequest, "request body is empty", nil)
+			return
+		}
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid request body", err)
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	claims, _ := middleware.ClaimsFromContext(ctx)
+
+	subscription, err := h.webhookService.CreateSubscription(ctx, ToCreateIn(req, claims.UserID))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusCreated, ToCreateResponse(*subscription))
+}
+
+// @Summary List webhook subscriptions
+// @Description Get registered webhook subscriptions, newest first. Requires JWT-Token with Moderator role.
+// @ID ListWebhooks
+// @Tags Webhooks
+// @Security ApiKeyAuth
+// @Produce json
+// @Param limit query int false "Limit number of results"
+// @Param page query int false "Page for pagination"
+// @Success 200 {array} SubscriptionResponse "Subscriptions"
+// @Failure 400 {object} response.Error "Bad request"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /webhooks [get]
+func (h *WebhookHandlers) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := listparams.ParsePagination(r.URL.Query(), listparams.Pagination{})
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	subscriptions, err := h.webhookService.ListSubscriptions(ctx, &pagination)
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToListResponse(subscriptions))
+}
+
+// @Summary Delete webhook subscription
+// @Description Delete subscription together with its delivery log. Requires JWT-Token with Moderator role.
+// @ID DeleteWebhook
+// @Tags Webhooks
+// @Security ApiKeyAuth
+// @Param subscriptionID path string true "Subscription ID"
+// @Success 204 "Subscription deleted"
+// @Failure 400 {object} response.Error "Invalid subscription ID"
+// @Failure 404 {object} response.Error "Subscription not found"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /webhooks/{subscriptionID} [delete]
+func (h *WebhookHandlers) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	subscriptionID, err := uuid.Parse(chi.URLParam(r, "subscriptionID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid subscriptionID format", nil)
+		return
+	}
+
+	err = h.webhookService.DeleteSubscription(ctx, subscriptionID)
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary List webhook deliveries
+// @Description Get delivery log of a subscription, newest first. Requires JWT-Token with Moderator role.
+// @ID ListWebhookDeliveries
+// @Tags Webhooks
+// @Security ApiKeyAuth
+// @Produce json
+// @Param subscriptionID path string true "Subscription ID"
+// @Param limit query int false "Limit number of results"
+// @Param page query int false "Page for pagination"
+// @Success 200 {array} DeliveryResponse "Deliveries"
+// @Failure 400 {object} response.Error "Bad request"
+// @Failure 404 {object} response.Error "Subscription not found"
+// @Failure 500 {object} response.Error "Internal server error"
+// @Router /webhooks/{subscriptionID}/deliveries [get]
+func (h *WebhookHandlers) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	subscriptionID, err := uuid.Parse(chi.URLParam(r, "subscriptionID"))
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, "invalid subscriptionID format", nil)
+		return
+	}
+
+	pagination, err := listparams.ParsePagination(r.URL.Query(), listparams.Pagination{})
+	if err != nil {
+		response.WriteError(w, ctx, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(ctx, ToDeliveryListParams(subscriptionID, pagination))
+	if err != nil {
+		mess, code := mapErrorToHTTP(err)
+
+		logger.ErrorCtx(ctx, mess, "error", err)
+		response.WriteError(w, ctx, code, mess, err)
+		return
+	}
+
+	response.WriteJSON(w, ctx, http.StatusOK, ToDeliveryListResponse(deliveries))
+}
+
+func mapErrorToHTTP(err error) (msg string, statusCode int) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidEventType):
+		msg = err.Error()
+		statusCode = http.StatusBadRequest
+
+	case errors.Is(err, domain.ErrWebhookSubscriptionNotFound):
+		msg = err.Error()
+		statusCode = http.StatusNotFound
+
+	default:
+		statusCode = http.StatusInternalServerError
+		msg = "internal server error"
+	}
+
+	return msg, statusCode
+}
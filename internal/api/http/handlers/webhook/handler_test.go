@@ -0,0 +1,311 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/webhook/mocks"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"github.com/valeragav/avito-pvz-service/pkg/validation"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWebhookHandlers_Create(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	userID := uuid.New()
+
+	subscription := &domain.WebhookSubscription{
+		ID:         uuid.New(),
+		URL:        "https://partner.example/hook",
+		Secret:     "generated-secret-value",
+		EventTypes: []domain.EventType{domain.EventReceptionClosed},
+		CreatedBy:  userID,
+		CreatedAt:  time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC),
+	}
+
+	testcases := []struct {
+		name          string
+		body          string
+		serviceMock   func(*mocks.MockwebhookService)
+		expectedCode  int
+		expected      *CreateResponse
+		expectedError *response.Error
+	}{
+		{
+			name:         "successful create",
+			body:         `{"url":"https://partner.example/hook","eventTypes":["ReceptionClosed"]}`,
+			expectedCode: http.StatusCreated,
+			serviceMock: func(service *mocks.MockwebhookService) {
+				service.
+					EXPECT().
+					CreateSubscription(gomock.Any(), dto.WebhookSubscriptionCreate{
+						URL:        "https://partner.example/hook",
+						EventTypes: []domain.EventType{domain.EventReceptionClosed},
+						CreatedBy:  userID,
+					}).
+					Return(subscription, nil)
+			},
+			expected: &CreateResponse{
+				SubscriptionResponse: ToSubscriptionResponse(*subscription),
+				Secret:               subscription.Secret,
+			},
+		},
+		{
+			name:         "empty event types",
+			body:         `{"url":"https://partner.example/hook","eventTypes":[]}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "short secret",
+			body:         `{"url":"https://partner.example/hook","secret":"short","eventTypes":["ReceptionClosed"]}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid event type",
+			body:         `{"url":"https://partner.example/hook","eventTypes":["Unknown"]}`,
+			expectedCode: http.StatusBadRequest,
+			serviceMock: func(service *mocks.MockwebhookService) {
+				service.
+					EXPECT().
+					CreateSubscription(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: %s", domain.ErrInvalidEventType, "Unknown"))
+			},
+			expectedError: &response.Error{
+				Message: "invalid event type: Unknown",
+				Details: "invalid event type: Unknown",
+			},
+		},
+		{
+			name:         "service error",
+			body:         `{"url":"https://partner.example/hook","eventTypes":["ReceptionClosed"]}`,
+			expectedCode: http.StatusInternalServerError,
+			serviceMock: func(service *mocks.MockwebhookService) {
+				service.
+					EXPECT().
+					CreateSubscription(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("storage error"))
+			},
+			expectedError: &response.Error{
+				Message: "internal server error",
+				Details: "storage error",
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			webhookServiceMock := mocks.NewMockwebhookService(ctrl)
+			handler := New(valid, webhookServiceMock)
+
+			if tt.serviceMock != nil {
+				tt.serviceMock(webhookServiceMock)
+			}
+
+			req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.ContextClaims{}, domain.UserClaims{UserID: userID, Role: domain.ModeratorRole}))
+
+			w := httptest.NewRecorder()
+			handler.Create(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res CreateResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+
+				assert.Equal(t, *tt.expected, res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
+
+func TestWebhookHandlers_Delete(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	subscriptionID := uuid.New()
+
+	testcases := []struct {
+		name           string
+		subscriptionID string
+		serviceMock    func(*mocks.MockwebhookService)
+		expectedCode   int
+	}{
+		{
+			name:           "successful delete",
+			subscriptionID: subscriptionID.String(),
+			expectedCode:   http.StatusNoContent,
+			serviceMock: func(service *mocks.MockwebhookService) {
+				service.EXPECT().DeleteSubscription(gomock.Any(), subscriptionID).Return(nil)
+			},
+		},
+		{
+			name:           "invalid id",
+			subscriptionID: "not-a-uuid",
+			expectedCode:   http.StatusBadRequest,
+		},
+		{
+			name:           "not found",
+			subscriptionID: subscriptionID.String(),
+			expectedCode:   http.StatusNotFound,
+			serviceMock: func(service *mocks.MockwebhookService) {
+				service.EXPECT().DeleteSubscription(gomock.Any(), subscriptionID).Return(domain.ErrWebhookSubscriptionNotFound)
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			webhookServiceMock := mocks.NewMockwebhookService(ctrl)
+			handler := New(valid, webhookServiceMock)
+
+			if tt.serviceMock != nil {
+				tt.serviceMock(webhookServiceMock)
+			}
+
+			req := httptest.NewRequest("DELETE", "/webhooks/"+tt.subscriptionID, http.NoBody)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("subscriptionID", tt.subscriptionID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.Delete(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func TestWebhookHandlers_ListDeliveries(t *testing.T) {
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	valid := validation.New()
+	subscriptionID := uuid.New()
+
+	deliveries := []*domain.WebhookDelivery{
+		{
+			ID:             uuid.New(),
+			SubscriptionID: subscriptionID,
+			Event:          domain.OutboxEvent{ID: uuid.New(), Type: domain.EventReceptionClosed},
+			Status:         domain.WebhookDeliveryPending,
+			Attempts:       2,
+			NextAttemptAt:  time.Date(2026, time.February, 11, 10, 31, 0, 0, time.UTC),
+			LastStatusCode: 503,
+			LastError:      "webhook responded with status 503",
+			CreatedAt:      time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC),
+		},
+	}
+
+	testcases := []struct {
+		name          string
+		requestQuery  string
+		serviceMock   func(*mocks.MockwebhookService)
+		expectedCode  int
+		expected      []DeliveryResponse
+		expectedError *response.Error
+	}{
+		{
+			name:         "successful list",
+			requestQuery: "?page=2&limit=5",
+			expectedCode: http.StatusOK,
+			serviceMock: func(service *mocks.MockwebhookService) {
+				service.
+					EXPECT().
+					ListDeliveries(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, params dto.WebhookDeliveryListParams) ([]*domain.WebhookDelivery, error) {
+						require.Equal(t, subscriptionID, params.SubscriptionID)
+						require.Equal(t, uint(2), params.Pagination.Page)
+						require.Equal(t, uint(5), params.Pagination.Limit)
+						return deliveries, nil
+					})
+			},
+			expected: ToDeliveryListResponse(deliveries),
+		},
+		{
+			name:         "subscription not found",
+			expectedCode: http.StatusNotFound,
+			serviceMock: func(service *mocks.MockwebhookService) {
+				service.
+					EXPECT().
+					ListDeliveries(gomock.Any(), gomock.Any()).
+					Return(nil, domain.ErrWebhookSubscriptionNotFound)
+			},
+			expectedError: &response.Error{
+				Message: "webhook subscription not found",
+				Details: "webhook subscription not found",
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			webhookServiceMock := mocks.NewMockwebhookService(ctrl)
+			handler := New(valid, webhookServiceMock)
+
+			if tt.serviceMock != nil {
+				tt.serviceMock(webhookServiceMock)
+			}
+
+			req := httptest.NewRequest("GET", "/webhooks/"+subscriptionID.String()+"/deliveries"+tt.requestQuery, http.NoBody)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("subscriptionID", subscriptionID.String())
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.ListDeliveries(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+
+			if tt.expected != nil {
+				var res []DeliveryResponse
+				err := json.NewDecoder(w.Body).Decode(&res)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expected, res)
+			}
+
+			if tt.expectedError != nil {
+				var errorRes response.Error
+				err := json.NewDecoder(w.Body).Decode(&errorRes)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedError, &errorRes)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/issuance"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type IssuanceRoute struct {
+	authMiddleware   *middleware.AuthMiddleware
+	issuanceHandlers *issuance.IssuanceHandlers
+}
+
+func NewIssuanceRoute(authMiddleware *middleware.AuthMiddleware, issuanceHandlers *issuance.IssuanceHandlers) *IssuanceRoute {
+	return &IssuanceRoute{
+		authMiddleware,
+		issuanceHandlers,
+	}
+}
+
+func (router IssuanceRoute) Init(r chi.Router) {
+	r.Route("/issuances", func(b chi.Router) {
+		b.Use(router.authMiddleware.Init())
+
+		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole, domain.ModeratorRole)).Get("/", router.issuanceHandlers.List)
+		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole)).Post("/", router.issuanceHandlers.Issue)
+		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole)).Post("/returns", router.issuanceHandlers.Return)
+	})
+}
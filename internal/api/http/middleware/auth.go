@@ -2,22 +2,25 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"slices"
 	"strings"
 
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
 )
 
 const prefixAuth = "Bearer "
 
-type ContextRole struct{}
+// ContextClaims ключ контекста, под которым лежат domain.UserClaims авторизованного пользователя
+type ContextClaims struct{}
 
 type Handler func(w http.ResponseWriter, r *http.Request)
 
 type JwtService interface {
-	ValidateJwt(string) (*domain.UserClaims, error)
+	ValidateJwt(ctx context.Context, token string) (*domain.UserClaims, error)
 }
 
 type AuthMiddleware struct {
@@ -47,13 +50,19 @@ func (a AuthMiddleware) Init() func(next http.Handler) http.Handler {
 				return
 			}
 
-			claims, err := a.jwtService.ValidateJwt(jvtToken)
+			claims, err := a.jwtService.ValidateJwt(ctx, jvtToken)
 			if err != nil {
-				response.WriteError(w, ctx, http.StatusUnauthorized, err.Error(), nil)
+				// причину отказа клиенту не раскрываем, ошибки denylist внутренние
+				if errors.Is(err, domain.ErrInvalidToken) {
+					response.WriteError(w, ctx, http.StatusUnauthorized, "invalid token", nil)
+					return
+				}
+				logger.ErrorCtx(ctx, "failed to validate token", "error", err)
+				response.WriteError(w, ctx, http.StatusInternalServerError, "internal server error", nil)
 				return
 			}
 
-			ctx = context.WithValue(ctx, ContextRole{}, claims.Role)
+			ctx = context.WithValue(ctx, ContextClaims{}, *claims)
 			r = r.WithContext(ctx)
 
 			next.ServeHTTP(w, r)
@@ -66,14 +75,14 @@ func (a AuthMiddleware) RequireRoles(roles ...domain.Role) func(next http.Handle
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
 
-			userRole, ok := ctx.Value(ContextRole{}).(domain.Role)
+			claims, ok := ClaimsFromContext(ctx)
 
 			if !ok {
 				response.WriteError(w, ctx, http.StatusUnauthorized, "unauthorized", nil)
 				return
 			}
 
-			if slices.Contains(roles, userRole) {
+			if slices.Contains(roles, claims.Role) {
 				next.ServeHTTP(w, r)
 				return
 			}
@@ -82,3 +91,8 @@ func (a AuthMiddleware) RequireRoles(roles ...domain.Role) func(next http.Handle
 		})
 	}
 }
+
+func ClaimsFromContext(ctx context.Context) (domain.UserClaims, bool) {
+	claims, ok := ctx.Value(ContextClaims{}).(domain.UserClaims)
+	return claims, ok
+}
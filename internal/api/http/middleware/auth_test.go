@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/response"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type stubJwtService struct {
+	claims *domain.UserClaims
+	err    error
+}
+
+func (s stubJwtService) ValidateJwt(ctx context.Context, token string) (*domain.UserClaims, error) {
+	return s.claims, s.err
+}
+
+func TestAuthMiddleware_Init(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		header     string
+		jwtService stubJwtService
+		wantStatus int
+		wantMsg    string
+	}{
+		{
+			name:       "valid token",
+			header:     "Bearer token",
+			jwtService: stubJwtService{claims: &domain.UserClaims{Role: domain.EmployeeRole}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "no header",
+			wantStatus: http.StatusUnauthorized,
+			wantMsg:    "authorization required",
+		},
+		{
+			name:       "invalid token hides reason",
+			header:     "Bearer token",
+			jwtService: stubJwtService{err: fmt.Errorf("%w: token is expired by 1h", domain.ErrInvalidToken)},
+			wantStatus: http.StatusUnauthorized,
+			wantMsg:    "invalid token",
+		},
+		{
+			name:       "denylist error is internal",
+			header:     "Bearer token",
+			jwtService: stubJwtService{err: errors.New("failed to check token denylist: connection refused")},
+			wantStatus: http.StatusInternalServerError,
+			wantMsg:    "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, ok := ClaimsFromContext(r.Context())
+				assert.True(t, ok)
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/pvz", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+
+			NewAuthMiddleware(tt.jwtService).Init()(next).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantMsg == "" {
+				return
+			}
+
+			var body response.Error
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+			assert.Equal(t, tt.wantMsg, body.Message)
+			assert.Empty(t, body.Details)
+		})
+	}
+}
@@ -25,5 +25,8 @@ func (router ProductsRoute) Init(r chi.Router) {
 		b.Use(router.authMiddleware.Init())
 
 		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole)).Post("/", router.productsHandlers.Create)
+		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole)).Post("/batch", router.productsHandlers.CreateBatch)
+		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole, domain.ModeratorRole)).Get("/by-barcode/{code}", router.productsHandlers.GetByBarcode)
+		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole)).Delete("/{productID}", router.productsHandlers.DeleteProduct)
 	})
 }
@@ -38,6 +38,11 @@ func (router PVZRoute) Init(r chi.Router) {
 
 		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole, domain.ModeratorRole)).Get("/", router.pvzHandlers.List)
 		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Post("/", router.pvzHandlers.Create)
+		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole, domain.ModeratorRole)).Get("/nearby", router.pvzHandlers.Nearby)
+		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole, domain.ModeratorRole)).Get("/{pvzID}", router.pvzHandlers.Get)
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Patch("/{pvzID}", router.pvzHandlers.Update)
+		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole, domain.ModeratorRole)).Get("/{pvzID}/inventory", router.pvzHandlers.Inventory)
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Post("/{pvzID}/status", router.pvzHandlers.ChangeStatus)
 
 		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole)).Post("/{pvzID}/close_last_reception", router.receptionsHandlers.CloseLastReception)
 		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole)).Post("/{pvzID}/delete_last_product", router.productsHandlers.DeleteLastProduct)
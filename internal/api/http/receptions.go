@@ -25,5 +25,8 @@ func (router ReceptionsRoute) Init(r chi.Router) {
 		b.Use(router.authMiddleware.Init())
 
 		b.With(router.authMiddleware.RequireRoles(domain.EmployeeRole)).Post("/", router.receptionsHandlers.Create)
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Post("/{receptionID}/cancel", router.receptionsHandlers.Cancel)
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Post("/{receptionID}/reopen", router.receptionsHandlers.Reopen)
+		b.With(router.authMiddleware.RequireRoles(domain.ModeratorRole)).Get("/{receptionID}/transitions", router.receptionsHandlers.ListTransitions)
 	})
 }
@@ -7,10 +7,15 @@ import (
 	httpSwagger "github.com/swaggo/http-swagger"
 	_ "github.com/valeragav/avito-pvz-service/api/v1/swagger"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/audit"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/auth"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/city"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/export"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/issuance"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/product"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/pvz"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/reception"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/webhook"
 	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
 	"github.com/valeragav/avito-pvz-service/internal/app"
 	"github.com/valeragav/avito-pvz-service/internal/config"
@@ -37,9 +42,14 @@ func NewRouter(appService *app.App, cfg *config.Config) *chi.Mux {
 	router.HandleFunc("/ping", handlers.PingHandler)
 
 	authHandlers := auth.New(appService.Validator, appService.AuthUseCase)
-	pvzHandlers := pvz.New(appService.Validator, appService.PVZUseCase)
+	pvzHandlers := pvz.New(appService.Validator, appService.PVZUseCase, appService.CursorCodec)
 	receptionsHandlers := reception.New(appService.Validator, appService.ReceptionUseCase)
 	productsHandlers := product.New(appService.Validator, appService.ProductUseCase)
+	auditHandlers := audit.New(appService.AuditUseCase)
+	webhookHandlers := webhook.New(appService.Validator, appService.WebhookUseCase)
+	issuanceHandlers := issuance.New(appService.Validator, appService.IssuanceUseCase)
+	exportHandlers := export.New(appService.ReceptionUseCase)
+	cityHandlers := city.New(appService.Validator, appService.CityUseCase)
 
 	authRoute := NewAuthRoute(authHandlers)
 	authRoute.Init(router)
@@ -53,6 +63,21 @@ func NewRouter(appService *app.App, cfg *config.Config) *chi.Mux {
 	receptionsRoute := NewReceptionsRoute(authMiddleware, receptionsHandlers)
 	receptionsRoute.Init(router)
 
+	auditRoute := NewAuditRoute(authMiddleware, auditHandlers)
+	auditRoute.Init(router)
+
+	webhookRoute := NewWebhookRoute(authMiddleware, webhookHandlers)
+	webhookRoute.Init(router)
+
+	issuanceRoute := NewIssuanceRoute(authMiddleware, issuanceHandlers)
+	issuanceRoute.Init(router)
+
+	exportRoute := NewExportRoute(authMiddleware, exportHandlers)
+	exportRoute.Init(router)
+
+	cityRoute := NewCityRoute(authMiddleware, cityHandlers)
+	cityRoute.Init(router)
+
 	return router
 }
 
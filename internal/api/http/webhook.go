@@ -0,0 +1,32 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/handlers/webhook"
+	"github.com/valeragav/avito-pvz-service/internal/api/http/middleware"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type WebhookRoute struct {
+	authMiddleware  *middleware.AuthMiddleware
+	webhookHandlers *webhook.WebhookHandlers
+}
+
+func NewWebhookRoute(authMiddleware *middleware.AuthMiddleware, webhookHandlers *webhook.WebhookHandlers) *WebhookRoute {
+	return &WebhookRoute{
+		authMiddleware,
+		webhookHandlers,
+	}
+}
+
+func (router WebhookRoute) Init(r chi.Router) {
+	r.Route("/webhooks", func(b chi.Router) {
+		b.Use(router.authMiddleware.Init())
+		b.Use(router.authMiddleware.RequireRoles(domain.ModeratorRole))
+
+		b.Post("/", router.webhookHandlers.Create)
+		b.Get("/", router.webhookHandlers.List)
+		b.Delete("/{subscriptionID}", router.webhookHandlers.Delete)
+		b.Get("/{subscriptionID}/deliveries", router.webhookHandlers.ListDeliveries)
+	})
+}
@@ -1,26 +1,49 @@
 package app
 
 import (
+	"crypto/rand"
+	"fmt"
+
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/valeragav/avito-pvz-service/internal/config"
 	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+	"github.com/valeragav/avito-pvz-service/internal/infra/publisher"
 	"github.com/valeragav/avito-pvz-service/internal/security"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/audit"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/auth"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/city"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/issuance"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/outbox"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/product"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/pvz"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/reception"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/watch"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/webhook"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 	"github.com/valeragav/avito-pvz-service/pkg/logger"
 	"github.com/valeragav/avito-pvz-service/pkg/validation"
 )
 
 type App struct {
+	AuditUseCase     *audit.AuditUseCase
 	AuthUseCase      *auth.AuthUseCase
 	PVZUseCase       *pvz.PVZUseCase
 	ReceptionUseCase *reception.ReceptionUseCase
 	ProductUseCase   *product.ProductUseCase
+	IssuanceUseCase  *issuance.IssuanceUseCase
+	WebhookUseCase   *webhook.WebhookUseCase
+	CityUseCase      *city.CityUseCase
+
+	Validator   *validation.Validator
+	JwtService  *security.JwtService
+	OutboxRelay *outbox.Relay
+	TokenPurger *auth.TokenPurger
+	CursorCodec *listparams.CursorCodec
+	WatchBus    *watch.Bus
 
-	Validator  *validation.Validator
-	JwtService *security.JwtService
+	EventListener *postgres.EventListener
+
+	WebhookDispatcher *webhook.Dispatcher
 }
 
 func New(cfg *config.Config, lg *logger.Logger, db *pgxpool.Pool) (*App, error) {
@@ -32,6 +55,16 @@ func New(cfg *config.Config, lg *logger.Logger, db *pgxpool.Pool) (*App, error)
 	statusRepo := postgres.NewReceptionStatusRepository(db)
 	productRepo := postgres.NewProductRepository(db)
 	productTypeRepo := postgres.NewProductTypeRepository(db)
+	refreshTokenRepo := postgres.NewRefreshTokenRepository(db)
+	revokedTokenRepo := postgres.NewRevokedTokenRepository(db)
+	auditEventRepo := postgres.NewAuditEventRepository(db)
+	receptionTransitionRepo := postgres.NewReceptionTransitionRepository(db)
+	outboxEventRepo := postgres.NewOutboxEventRepository(db)
+	webhookSubscriptionRepo := postgres.NewWebhookSubscriptionRepository(db)
+	webhookDeliveryRepo := postgres.NewWebhookDeliveryRepository(db)
+	issuanceRepo := postgres.NewIssuanceRepository(db)
+
+	txManager := postgres.NewTxManager(db)
 
 	// services
 	jwtService, err := security.New(
@@ -39,26 +72,119 @@ func New(cfg *config.Config, lg *logger.Logger, db *pgxpool.Pool) (*App, error)
 		cfg.Jwt.RSAPublicFile,
 		cfg.Jwt.Iss,
 		cfg.Jwt.AccessLifeTime,
+		revokedTokenRepo,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	refreshTokenService, err := security.NewRefreshTokenService(cfg.Jwt.RefreshLifeTime)
+	if err != nil {
+		return nil, err
+	}
+
 	validator := validation.New()
 
+	cursorCodec, err := newCursorCodec(cfg.Pagination, lg)
+	if err != nil {
+		return nil, err
+	}
+
+	eventPublisher, err := newEventPublisher(cfg.Outbox)
+	if err != nil {
+		return nil, err
+	}
+
 	// usecases
-	authUC := auth.New(jwtService, userRepo)
-	pvzUC := pvz.New(pvzRepo, cityRepo, receptionRepo, productRepo)
-	receptionUC := reception.New(receptionRepo, statusRepo, pvzRepo)
-	productUC := product.New(productRepo, receptionRepo, productTypeRepo, pvzRepo)
+	auditUC := audit.New(auditEventRepo)
+	// NOTIFY отправляется в транзакции use case и доходит до подписчиков WatchPVZ
+	// на всех экземплярах, включая этот, сразу после коммита
+	outboxUC := outbox.New(outboxEventRepo, postgres.NewEventNotifier(db))
+	webhookUC := webhook.New(webhookSubscriptionRepo, webhookDeliveryRepo)
+	watchBus := watch.NewBus(cfg.Watch.BufferSize)
+
+	// подписчики webhook получают события всегда, вдобавок к настроенному publisher
+	outboxRelay := outbox.NewRelay(
+		outboxEventRepo,
+		publisher.NewFanout(webhookUC, eventPublisher),
+		outbox.RelayConfig{
+			Interval:     cfg.Outbox.PollInterval,
+			BatchSize:    uint64(cfg.Outbox.BatchSize),
+			MaxAttempts:  cfg.Outbox.MaxAttempts,
+			BackoffBase:  cfg.Outbox.BackoffBase,
+			BackoffMax:   cfg.Outbox.BackoffMax,
+			LeaseTimeout: cfg.Outbox.LeaseTimeout,
+		},
+	)
+	webhookDispatcher := webhook.NewDispatcher(
+		webhookDeliveryRepo,
+		publisher.NewSignedWebhookSender(cfg.Webhook.Timeout),
+		webhook.DispatcherConfig{
+			Interval:     cfg.Webhook.DispatchInterval,
+			BatchSize:    uint64(cfg.Webhook.BatchSize),
+			MaxAttempts:  cfg.Webhook.MaxAttempts,
+			BackoffBase:  cfg.Webhook.BackoffBase,
+			BackoffMax:   cfg.Webhook.BackoffMax,
+			LeaseTimeout: cfg.Webhook.LeaseTimeout,
+		},
+	)
+	authUC := auth.New(jwtService, refreshTokenService, userRepo, refreshTokenRepo, revokedTokenRepo, txManager)
+	tokenPurger := auth.NewTokenPurger(refreshTokenRepo, revokedTokenRepo, cfg.Jwt.PurgeInterval)
+	pvzUC := pvz.New(pvzRepo, cityRepo, receptionRepo, productRepo, productTypeRepo, txManager, auditUC, outboxUC)
+	receptionUC := reception.New(receptionRepo, statusRepo, pvzRepo, productRepo, receptionTransitionRepo, txManager, auditUC, outboxUC)
+	productUC := product.New(productRepo, receptionRepo, productTypeRepo, pvzRepo, txManager, auditUC, outboxUC)
+	issuanceUC := issuance.New(issuanceRepo, productRepo, receptionRepo, pvzRepo, txManager, auditUC, outboxUC)
+	cityUC := city.New(cityRepo, txManager)
+
+	eventListener := postgres.NewEventListener(db, watchBus.Publish, cfg.Watch.ListenRetryInterval)
 
 	return &App{
+		AuditUseCase:     auditUC,
 		AuthUseCase:      authUC,
 		PVZUseCase:       pvzUC,
 		ReceptionUseCase: receptionUC,
 		ProductUseCase:   productUC,
+		IssuanceUseCase:  issuanceUC,
+		WebhookUseCase:   webhookUC,
+		CityUseCase:      cityUC,
 
-		Validator:  validator,
-		JwtService: jwtService,
+		Validator:   validator,
+		JwtService:  jwtService,
+		OutboxRelay: outboxRelay,
+		TokenPurger: tokenPurger,
+		CursorCodec: cursorCodec,
+		WatchBus:    watchBus,
+
+		EventListener: eventListener,
+
+		WebhookDispatcher: webhookDispatcher,
 	}, nil
 }
+
+func newCursorCodec(cfg config.Pagination, lg *logger.Logger) (*listparams.CursorCodec, error) {
+	if cfg.CursorSecret != "" {
+		return listparams.NewCursorCodec([]byte(cfg.CursorSecret)), nil
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("pagination: failed to generate cursor secret: %w", err)
+	}
+	lg.Warn("pagination cursor secret is not set, cursors will not survive restart")
+
+	return listparams.NewCursorCodec(secret), nil
+}
+
+func newEventPublisher(cfg config.Outbox) (publisher.Publisher, error) {
+	switch cfg.Publisher {
+	case "log":
+		return publisher.NewLogPublisher(), nil
+	case "webhook":
+		if cfg.WebhookURL == "" {
+			return nil, fmt.Errorf("outbox: webhook url is not set")
+		}
+		return publisher.NewWebhookPublisher(cfg.WebhookURL, cfg.WebhookTimeout), nil
+	default:
+		return nil, fmt.Errorf("outbox: unknown publisher %q", cfg.Publisher)
+	}
+}
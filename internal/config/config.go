@@ -19,6 +19,10 @@ type Config struct {
 	GRPC          GRPC          `yaml:"grpc"`
 	MetricsServer MetricsServer `yaml:"metric_server"`
 	SwaggerServer SwaggerServer `yaml:"swagger_server"`
+	Outbox        Outbox        `yaml:"outbox"`
+	Webhook       Webhook       `yaml:"webhook"`
+	Watch         Watch         `yaml:"watch"`
+	Pagination    Pagination    `yaml:"pagination"`
 }
 
 type GRPC struct {
@@ -52,6 +56,42 @@ type SwaggerServer struct {
 	IdleTimeout  time.Duration `yaml:"idle_timeout"`
 }
 
+type Outbox struct {
+	// Publisher куда relay отправляет события: log или webhook
+	Publisher      string        `yaml:"publisher"`
+	WebhookURL     string        `yaml:"webhook_url"`
+	WebhookTimeout time.Duration `yaml:"webhook_timeout"`
+	PollInterval   time.Duration `yaml:"poll_interval"`
+	BatchSize      int           `yaml:"batch_size"`
+	MaxAttempts    int           `yaml:"max_attempts"`
+	BackoffBase    time.Duration `yaml:"backoff_base"`
+	BackoffMax     time.Duration `yaml:"backoff_max"`
+	LeaseTimeout   time.Duration `yaml:"lease_timeout"`
+}
+
+type Webhook struct {
+	Timeout          time.Duration `yaml:"timeout"`
+	DispatchInterval time.Duration `yaml:"dispatch_interval"`
+	BatchSize        int           `yaml:"batch_size"`
+	MaxAttempts      int           `yaml:"max_attempts"`
+	BackoffBase      time.Duration `yaml:"backoff_base"`
+	BackoffMax       time.Duration `yaml:"backoff_max"`
+	LeaseTimeout     time.Duration `yaml:"lease_timeout"`
+}
+
+type Watch struct {
+	// BufferSize сколько событий копится для подписчика WatchPVZ,
+	// после переполнения подписчик отключается
+	BufferSize          int           `yaml:"buffer_size"`
+	ListenRetryInterval time.Duration `yaml:"listen_retry_interval"`
+}
+
+type Pagination struct {
+	// CursorSecret ключ подписи курсоров keyset пагинации. Если не задан,
+	// генерируется при старте, и курсоры перестают работать после перезапуска
+	CursorSecret string `yaml:"cursor_secret"`
+}
+
 type Db struct {
 	Option   string `yaml:"option"`
 	Driver   string `yaml:"driver"`
@@ -68,10 +108,13 @@ type Db struct {
 }
 
 type Jwt struct {
-	AccessLifeTime time.Duration `yaml:"accessLifeTime"`
-	Iss            string        `yaml:"iss"`
-	RSAPublicFile  string        `yaml:"RSAPublicFile"`
-	RSAPrivateFile string        `yaml:"RSAPrivateFile"`
+	AccessLifeTime  time.Duration `yaml:"accessLifeTime"`
+	RefreshLifeTime time.Duration `yaml:"refreshLifeTime"`
+	Iss             string        `yaml:"iss"`
+	RSAPublicFile   string        `yaml:"RSAPublicFile"`
+	RSAPrivateFile  string        `yaml:"RSAPrivateFile"`
+	// PurgeInterval как часто удаляются истёкшие refresh токены и записи denylist
+	PurgeInterval time.Duration `yaml:"purgeInterval"`
 }
 
 func LoadConfig(configPath string) *Config {
@@ -137,11 +180,44 @@ func LoadConfig(configPath string) *Config {
 			MaxConnIdleTime: MustGetDef("DB_MAX_CONN_IDLE_TIME", 5*time.Minute),
 		},
 
+		Outbox: Outbox{
+			Publisher:      MustGetDef("OUTBOX_PUBLISHER", "log"),
+			WebhookURL:     MustGetDef("OUTBOX_WEBHOOK_URL", ""),
+			WebhookTimeout: MustGetDef("OUTBOX_WEBHOOK_TIMEOUT", 5*time.Second),
+			PollInterval:   MustGetDef("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:      MustGetDef("OUTBOX_BATCH_SIZE", 100),
+			MaxAttempts:    MustGetDef("OUTBOX_MAX_ATTEMPTS", 10),
+			BackoffBase:    MustGetDef("OUTBOX_BACKOFF_BASE", time.Second),
+			BackoffMax:     MustGetDef("OUTBOX_BACKOFF_MAX", 10*time.Minute),
+			LeaseTimeout:   MustGetDef("OUTBOX_LEASE_TIMEOUT", 10*time.Minute),
+		},
+
+		Webhook: Webhook{
+			Timeout:          MustGetDef("WEBHOOK_TIMEOUT", 5*time.Second),
+			DispatchInterval: MustGetDef("WEBHOOK_DISPATCH_INTERVAL", time.Second),
+			BatchSize:        MustGetDef("WEBHOOK_BATCH_SIZE", 50),
+			MaxAttempts:      MustGetDef("WEBHOOK_MAX_ATTEMPTS", 8),
+			BackoffBase:      MustGetDef("WEBHOOK_BACKOFF_BASE", 5*time.Second),
+			BackoffMax:       MustGetDef("WEBHOOK_BACKOFF_MAX", time.Hour),
+			LeaseTimeout:     MustGetDef("WEBHOOK_LEASE_TIMEOUT", 10*time.Minute),
+		},
+
+		Watch: Watch{
+			BufferSize:          MustGetDef("WATCH_BUFFER_SIZE", 64),
+			ListenRetryInterval: MustGetDef("WATCH_LISTEN_RETRY_INTERVAL", time.Second),
+		},
+
+		Pagination: Pagination{
+			CursorSecret: MustGetDef("PAGINATION_CURSOR_SECRET", ""),
+		},
+
 		Jwt: Jwt{
-			AccessLifeTime: MustGetDef("JWT_ACCESS_LIFE_TIME", 2*time.Hour),
-			Iss:            MustGetDef("JWT_ISSUER", "avito-pvz-service"),
-			RSAPublicFile:  MustGetDef("JWT_RSA_PUBLIC_PEM_FILE", "secrets/public.pem"),
-			RSAPrivateFile: MustGetDef("JWT_RSA_PRIVATE_PEM_FILE", "secrets/private.pem"),
+			AccessLifeTime:  MustGetDef("JWT_ACCESS_LIFE_TIME", 2*time.Hour),
+			RefreshLifeTime: MustGetDef("JWT_REFRESH_LIFE_TIME", 30*24*time.Hour),
+			Iss:             MustGetDef("JWT_ISSUER", "avito-pvz-service"),
+			RSAPublicFile:   MustGetDef("JWT_RSA_PUBLIC_PEM_FILE", "secrets/public.pem"),
+			RSAPrivateFile:  MustGetDef("JWT_RSA_PRIVATE_PEM_FILE", "secrets/private.pem"),
+			PurgeInterval:   MustGetDef("JWT_PURGE_INTERVAL", time.Hour),
 		},
 	}
 }
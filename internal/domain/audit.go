@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditAction string
+
+const (
+	AuditActionPVZCreated         AuditAction = "pvz.created"
+	AuditActionPVZStatusChanged   AuditAction = "pvz.status_changed"
+	AuditActionPVZUpdated         AuditAction = "pvz.updated"
+	AuditActionReceptionOpened    AuditAction = "reception.opened"
+	AuditActionReceptionClosed    AuditAction = "reception.closed"
+	AuditActionReceptionCancelled AuditAction = "reception.cancelled"
+	AuditActionReceptionReopened  AuditAction = "reception.reopened"
+	AuditActionProductAdded       AuditAction = "product.added"
+	AuditActionProductRemoved     AuditAction = "product.removed"
+	AuditActionProductIssued      AuditAction = "product.issued"
+	AuditActionProductReturned    AuditAction = "product.returned"
+)
+
+// AuditEvent запись журнала изменений. Before и After сериализуются в JSON как есть,
+// для созданной сущности Before пустой, для удалённой пустой After.
+type AuditEvent struct {
+	ID        uuid.UUID
+	ActorID   uuid.UUID
+	Action    AuditAction
+	EntityID  uuid.UUID
+	PvzID     uuid.UUID
+	RequestID string
+	Before    any
+	After     any
+	CreatedAt time.Time
+}
+
+type AuditFilter struct {
+	PvzID     *uuid.UUID
+	ActorID   *uuid.UUID
+	Action    *AuditAction
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+func (a AuditAction) IsValid() bool {
+	switch a {
+	case AuditActionPVZCreated, AuditActionPVZStatusChanged, AuditActionPVZUpdated, AuditActionReceptionOpened,
+		AuditActionReceptionClosed, AuditActionReceptionCancelled, AuditActionReceptionReopened, AuditActionProductAdded,
+		AuditActionProductRemoved, AuditActionProductIssued, AuditActionProductReturned:
+		return true
+	default:
+		return false
+	}
+}
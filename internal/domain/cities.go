@@ -7,8 +7,10 @@ import (
 )
 
 type City struct {
-	ID   uuid.UUID
-	Name string
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
 }
 
 var ErrCityNotFound = errors.New("not found city")
+var ErrCityDuplicate = errors.New("city already exists")
+var ErrCityHasPVZ = errors.New("city has pvz")
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	EventPVZCreated         EventType = "PVZCreated"
+	EventPVZStatusChanged   EventType = "PVZStatusChanged"
+	EventPVZUpdated         EventType = "PVZUpdated"
+	EventReceptionOpened    EventType = "ReceptionOpened"
+	EventReceptionClosed    EventType = "ReceptionClosed"
+	EventReceptionCancelled EventType = "ReceptionCancelled"
+	EventReceptionReopened  EventType = "ReceptionReopened"
+	EventProductAdded       EventType = "ProductAdded"
+	EventProductRemoved     EventType = "ProductRemoved"
+	EventProductIssued      EventType = "ProductIssued"
+	EventProductReturned    EventType = "ProductReturned"
+)
+
+// OutboxEvent доменное событие, сохранённое в outbox в одной транзакции с изменением.
+// Payload сериализуется в JSON как есть, после чтения из БД это json.RawMessage.
+type OutboxEvent struct {
+	ID          uuid.UUID
+	Type        EventType
+	AggregateID uuid.UUID
+	PvzID       uuid.UUID
+	Payload     any
+	CreatedAt   time.Time
+	PublishedAt *time.Time
+	Attempts    int
+	LastError   string
+
+	// NextAttemptAt время следующей попытки публикации после неудачи,
+	// FailedAt выставляется, когда попытки исчерпаны и событие больше не публикуется
+	NextAttemptAt time.Time
+	FailedAt      *time.Time
+}
+
+var ErrSlowConsumer = errors.New("event consumer is too slow")
+
+// PVZEvent опубликованное событие outbox с городом PVZ, которое получают подписчики WatchPVZ.
+type PVZEvent struct {
+	ID          uuid.UUID
+	Type        EventType
+	AggregateID uuid.UUID
+	PvzID       uuid.UUID
+	City        string
+	OccurredAt  time.Time
+	Payload     json.RawMessage
+}
+
+// PVZEventFilter пустые поля не ограничивают выборку.
+type PVZEventFilter struct {
+	PvzIDs []uuid.UUID
+	City   string
+}
+
+func (f PVZEventFilter) Match(event PVZEvent) bool {
+	if len(f.PvzIDs) > 0 && !slices.Contains(f.PvzIDs, event.PvzID) {
+		return false
+	}
+	if f.City != "" && f.City != event.City {
+		return false
+	}
+	return true
+}
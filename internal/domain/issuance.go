@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type IssuanceKind string
+
+const (
+	// IssuanceKindIssue товар выдан клиенту
+	IssuanceKindIssue IssuanceKind = "issue"
+	// IssuanceKindReturn клиент вернул выданный товар, он снова на складе PVZ
+	IssuanceKindReturn IssuanceKind = "return"
+)
+
+// Issuance запись о выдаче товара клиенту или возврате.
+// У возврата IssueID указывает на выдачу, которую он отменяет.
+type Issuance struct {
+	ID        uuid.UUID    `json:"id"`
+	ProductID uuid.UUID    `json:"productId"`
+	PvzID     uuid.UUID    `json:"pvzId"`
+	Kind      IssuanceKind `json:"kind"`
+	IssueID   uuid.UUID    `json:"issueId,omitempty"`
+	Customer  string       `json:"customer,omitempty"`
+	Reason    string       `json:"reason,omitempty"`
+	ActorID   uuid.UUID    `json:"actorId"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+var ErrProductAlreadyIssued = errors.New("product is already issued")
+var ErrProductNotIssued = errors.New("product is not issued")
+var ErrProductNotReceived = errors.New("product reception is not closed yet")
+var ErrProductHasIssuances = errors.New("product has issuance history")
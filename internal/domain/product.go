@@ -8,17 +8,29 @@ import (
 )
 
 type Product struct {
-	ID          uuid.UUID
-	DateTime    time.Time
-	TypeID      uuid.UUID
-	ReceptionID uuid.UUID
+	ID          uuid.UUID `json:"id"`
+	DateTime    time.Time `json:"dateTime"`
+	TypeID      uuid.UUID `json:"typeId"`
+	ReceptionID uuid.UUID `json:"receptionId"`
+	CreatedBy   uuid.UUID `json:"createdBy"`
+	Barcode     string    `json:"barcode,omitempty"`
+	// Issued товар выдан клиенту и не числится на складе PVZ
+	Issued bool `json:"issued"`
 
-	ProductType *ProductType
+	ProductType *ProductType `json:"type,omitempty"`
 }
 
 type ProductType struct {
-	ID   uuid.UUID
-	Name string
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
 }
 
+// MaxProductBatchSize ограничивает количество товаров в одном пакетном запросе.
+const MaxProductBatchSize = 100
+
 var ErrProductToDelete = errors.New("no products to delete")
+var ErrProductNotFound = errors.New("product not found")
+var ErrProductReceptionClosed = errors.New("product belongs to a reception that is not open")
+var ErrProductTypeNotFound = errors.New("product type not found")
+var ErrProductBarcodeDuplicate = errors.New("product with this barcode is already in an open reception")
+var ErrProductBatchSize = errors.New("product batch size is out of range")
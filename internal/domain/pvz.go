@@ -2,19 +2,115 @@ package domain
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type PVZStatus string
+
+const (
+	PVZStatusActive    PVZStatus = "active"
+	PVZStatusSuspended PVZStatus = "suspended"
+	PVZStatusClosed    PVZStatus = "closed"
+)
+
+// pvzStatusTransitions допустимые смены статуса PVZ.
+// closed конечный статус: PVZ закрыт навсегда.
+var pvzStatusTransitions = map[PVZStatus][]PVZStatus{
+	PVZStatusActive:    {PVZStatusSuspended, PVZStatusClosed},
+	PVZStatusSuspended: {PVZStatusActive, PVZStatusClosed},
+}
+
+func (s PVZStatus) IsValid() bool {
+	switch s {
+	case PVZStatusActive, PVZStatusSuspended, PVZStatusClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s PVZStatus) CanTransitionTo(to PVZStatus) bool {
+	return slices.Contains(pvzStatusTransitions[s], to)
+}
+
+// json теги нужны для снимков сущностей в журнале аудита
 type PVZ struct {
-	ID               uuid.UUID
-	RegistrationDate time.Time
-	CityID           uuid.UUID
+	ID               uuid.UUID `json:"id"`
+	RegistrationDate time.Time `json:"registrationDate"`
+	CityID           uuid.UUID `json:"cityId"`
+
+	// StatusReason и StatusEffectiveAt относятся к последней смене статуса, у нового PVZ пустые
+	Status            PVZStatus  `json:"status"`
+	StatusReason      string     `json:"statusReason,omitempty"`
+	StatusEffectiveAt *time.Time `json:"statusEffectiveAt,omitempty"`
+
+	// Профиль PVZ для курьеров и покупателей, Capacity nil означает вместимость без ограничения
+	Address      string       `json:"address,omitempty"`
+	Location     *GeoPoint    `json:"location,omitempty"`
+	WorkingHours WorkingHours `json:"workingHours,omitempty"`
+	Capacity     *int         `json:"capacity,omitempty"`
+
+	// TypeCapacities ограничивает остаток по названию типа товара, типы без записи не ограничены
+	TypeCapacities map[string]int `json:"typeCapacities,omitempty"`
+
+	// StockOnHand количество принятых и не выданных товаров, заполняется только в списке PVZ
+	StockOnHand int `json:"stockOnHand,omitempty"`
 
-	Receptions []*Reception
-	City       *City
+	// DistanceKm расстояние до точки поиска, заполняется только в поиске ближайших PVZ
+	DistanceKm float64 `json:"distanceKm,omitempty"`
+
+	Receptions []*Reception `json:"receptions,omitempty"`
+	City       *City        `json:"city,omitempty"`
+}
+
+// IsActive в PVZ можно открывать приёмки и принимать товары только в статусе active.
+func (p *PVZ) IsActive() bool {
+	return p.Status == PVZStatusActive
+}
+
+// InventoryItem остаток товаров одного типа на складе PVZ.
+type InventoryItem struct {
+	TypeName string
+	Count    int
+}
+
+// PVZInventory товары на складе PVZ на момент AsOf: принятые, не удалённые и не выданные.
+type PVZInventory struct {
+	PvzID uuid.UUID
+	AsOf  time.Time
+	Total int
+	Items []InventoryItem
 }
 
+// PVZListFilter условия отбора списка PVZ. Даты, статус приёмки и тип товара
+// проверяются для одной и той же приёмки PVZ, пустые условия не применяются.
+type PVZListFilter struct {
+	Cities            []string
+	StartDate         *time.Time
+	EndDate           *time.Time
+	ReceptionStatuses []ReceptionStatusCode
+	ProductTypes      []string
+}
+
+// Поля, по которым можно сортировать список PVZ.
+const (
+	PVZSortRegistrationDate = "registrationDate"
+	PVZSortCity             = "city"
+	PVZSortLastReceptionAt  = "lastReceptionAt"
+)
+
+var PVZSortFields = []string{PVZSortRegistrationDate, PVZSortCity, PVZSortLastReceptionAt}
+
+// PVZStatusEffectiveDateTolerance допустимое расхождение effectiveDate с текущим временем
+// на случай рассинхронизации часов клиента и сервера.
+const PVZStatusEffectiveDateTolerance = time.Minute
+
 var ErrPVZNotFound = errors.New("not found pvz")
 var ErrDuplicatePvzID = errors.New("duplicate pvz id")
+var ErrPVZCursorSort = errors.New("cursor can only be used with default sort")
+var ErrPVZNotActive = errors.New("pvz is not active")
+var ErrInvalidPVZStatusTransition = errors.New("pvz status transition is not allowed")
+var ErrPVZStatusEffectiveDate = errors.New("effectiveDate must be the current time, scheduled and backdated status changes are not supported")
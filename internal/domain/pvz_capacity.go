@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrPVZCapacityExceeded = errors.New("pvz capacity exceeded")
+
+// HasCapacityLimits для PVZ без лимитов остаток при приёмке не считается.
+func (p *PVZ) HasCapacityLimits() bool {
+	return p.Capacity != nil || len(p.TypeCapacities) > 0
+}
+
+// CheckCapacity проверяет, поместится ли ещё один товар типа typeName
+// при остатке stock по названиям типов.
+func (p *PVZ) CheckCapacity(stock map[string]int, typeName string) error {
+	if p.Capacity != nil {
+		total := StockTotal(stock)
+		if total >= *p.Capacity {
+			return fmt.Errorf("%w: %d of %d products on hand", ErrPVZCapacityExceeded, total, *p.Capacity)
+		}
+	}
+
+	if capacity, ok := p.TypeCapacities[typeName]; ok && stock[typeName] >= capacity {
+		return fmt.Errorf("%w: %d of %d products of type %s on hand", ErrPVZCapacityExceeded, stock[typeName], capacity, typeName)
+	}
+
+	return nil
+}
+
+func StockTotal(stock map[string]int) int {
+	var total int
+	for _, count := range stock {
+		total += count
+	}
+	return total
+}
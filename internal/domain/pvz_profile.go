@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// GeoPoint координаты в градусах WGS 84.
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+func (p GeoPoint) IsValid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lon >= -180 && p.Lon <= 180
+}
+
+type Weekday string
+
+const (
+	Monday    Weekday = "mon"
+	Tuesday   Weekday = "tue"
+	Wednesday Weekday = "wed"
+	Thursday  Weekday = "thu"
+	Friday    Weekday = "fri"
+	Saturday  Weekday = "sat"
+	Sunday    Weekday = "sun"
+)
+
+var Weekdays = []Weekday{Monday, Tuesday, Wednesday, Thursday, Friday, Saturday, Sunday}
+
+func (d Weekday) IsValid() bool {
+	return slices.Contains(Weekdays, d)
+}
+
+// WorkingInterval время работы в один день недели, Open и Close в формате HH:MM.
+// Close 24:00 означает работу до конца дня, работа через полночь задаётся двумя интервалами.
+type WorkingInterval struct {
+	Day   Weekday `json:"day"`
+	Open  string  `json:"open"`
+	Close string  `json:"close"`
+}
+
+// WorkingHours недельное расписание PVZ. В один день может быть несколько
+// непересекающихся интервалов, например с перерывом на обед.
+type WorkingHours []WorkingInterval
+
+func (h WorkingHours) Validate() error {
+	type span struct{ open, close int }
+	byDay := make(map[Weekday][]span, len(Weekdays))
+
+	for _, interval := range h {
+		if !interval.Day.IsValid() {
+			return fmt.Errorf("%w: unknown day %q", ErrInvalidWorkingHours, interval.Day)
+		}
+
+		open, ok := parseClock(interval.Open, false)
+		if !ok {
+			return fmt.Errorf("%w: invalid open time %q", ErrInvalidWorkingHours, interval.Open)
+		}
+		closeAt, ok := parseClock(interval.Close, true)
+		if !ok {
+			return fmt.Errorf("%w: invalid close time %q", ErrInvalidWorkingHours, interval.Close)
+		}
+		if open >= closeAt {
+			return fmt.Errorf("%w: %s %s-%s closes before it opens", ErrInvalidWorkingHours, interval.Day, interval.Open, interval.Close)
+		}
+
+		for _, s := range byDay[interval.Day] {
+			if open < s.close && s.open < closeAt {
+				return fmt.Errorf("%w: overlapping intervals on %s", ErrInvalidWorkingHours, interval.Day)
+			}
+		}
+		byDay[interval.Day] = append(byDay[interval.Day], span{open, closeAt})
+	}
+
+	return nil
+}
+
+// parseClock возвращает минуты от начала дня. 24:00 допустимо только как время закрытия.
+func parseClock(s string, endOfDay bool) (int, bool) {
+	if endOfDay && s == "24:00" {
+		return 24 * 60, true
+	}
+
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, false
+	}
+
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// Радиус поиска ближайших PVZ в километрах.
+const (
+	DefaultNearbyRadiusKm = 5.0
+	MaxNearbyRadiusKm     = 100.0
+)
+
+// ValidateProfile проверяет координаты, расписание и вместимость PVZ.
+func (p *PVZ) ValidateProfile() error {
+	if p.Location != nil && !p.Location.IsValid() {
+		return ErrInvalidPVZLocation
+	}
+	if err := p.WorkingHours.Validate(); err != nil {
+		return err
+	}
+	if p.Capacity != nil && *p.Capacity <= 0 {
+		return ErrInvalidPVZCapacity
+	}
+	for typeName, capacity := range p.TypeCapacities {
+		if capacity <= 0 {
+			return fmt.Errorf("%w: %s", ErrInvalidPVZCapacity, typeName)
+		}
+	}
+	return nil
+}
+
+var ErrInvalidPVZLocation = errors.New("latitude must be in [-90, 90] and longitude in [-180, 180]")
+var ErrInvalidWorkingHours = errors.New("invalid working hours")
+var ErrInvalidPVZCapacity = errors.New("capacity must be positive")
+var ErrInvalidNearbyRadius = errors.New("radius must be greater than 0 and at most 100 km")
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,22 +13,88 @@ type ReceptionStatusCode string
 const (
 	ReceptionStatusClose      ReceptionStatusCode = "close"
 	ReceptionStatusInProgress ReceptionStatusCode = "in_progress"
+	ReceptionStatusCancelled  ReceptionStatusCode = "cancelled"
+	ReceptionStatusReopened   ReceptionStatusCode = "reopened"
 )
 
+// OpenReceptionStatuses статусы открытой приёмки: в неё можно добавлять и удалять товары,
+// и в PVZ может быть только одна такая приёмка.
+var OpenReceptionStatuses = []ReceptionStatusCode{ReceptionStatusInProgress, ReceptionStatusReopened}
+
+// receptionTransitions допустимые переходы между статусами приёмки.
+// cancelled конечный статус, переоткрытую приёмку можно только снова закрыть.
+var receptionTransitions = map[ReceptionStatusCode][]ReceptionStatusCode{
+	ReceptionStatusInProgress: {ReceptionStatusClose, ReceptionStatusCancelled},
+	ReceptionStatusReopened:   {ReceptionStatusClose},
+	ReceptionStatusClose:      {ReceptionStatusReopened},
+}
+
+func (s ReceptionStatusCode) IsValid() bool {
+	switch s {
+	case ReceptionStatusClose, ReceptionStatusInProgress, ReceptionStatusCancelled, ReceptionStatusReopened:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s ReceptionStatusCode) IsOpen() bool {
+	return slices.Contains(OpenReceptionStatuses, s)
+}
+
+func (s ReceptionStatusCode) CanTransitionTo(to ReceptionStatusCode) bool {
+	return slices.Contains(receptionTransitions[s], to)
+}
+
 type ReceptionStatus struct {
-	ID   uuid.UUID
-	Name ReceptionStatusCode
+	ID   uuid.UUID           `json:"id"`
+	Name ReceptionStatusCode `json:"name"`
 }
 
 type Reception struct {
-	ID       uuid.UUID
-	PvzID    uuid.UUID
-	DateTime time.Time
-	StatusID uuid.UUID
+	ID       uuid.UUID `json:"id"`
+	PvzID    uuid.UUID `json:"pvzId"`
+	DateTime time.Time `json:"dateTime"`
+	StatusID uuid.UUID `json:"statusId"`
+
+	// кто открыл и кто закрыл приёмку, uuid.Nil если неизвестно
+	CreatedBy uuid.UUID `json:"createdBy"`
+	ClosedBy  uuid.UUID `json:"closedBy"`
+
+	Products        []*Product       `json:"products,omitempty"`
+	ReceptionStatus *ReceptionStatus `json:"status,omitempty"`
+}
+
+// ReceptionTransition запись истории смены статуса приёмки.
+// FromStatus пустой для перехода, которым приёмка была создана.
+type ReceptionTransition struct {
+	ID          uuid.UUID
+	ReceptionID uuid.UUID
+	FromStatus  ReceptionStatusCode
+	ToStatus    ReceptionStatusCode
+	ActorID     uuid.UUID
+	CreatedAt   time.Time
+}
+
+// ReceptionExportRow строка выгрузки приёмок: одна строка на товар.
+// Приёмка без товаров выгружается одной строкой с пустыми полями товара.
+type ReceptionExportRow struct {
+	ReceptionID       uuid.UUID
+	PvzID             uuid.UUID
+	City              string
+	ReceptionDateTime time.Time
+	Status            ReceptionStatusCode
 
-	Products        []*Product
-	ReceptionStatus *ReceptionStatus
+	ProductID       uuid.UUID
+	ProductDateTime time.Time
+	ProductType     string
+	Barcode         string
+	Issued          bool
 }
 
 var ErrNoReceptionIsCurrentlyInProgress = errors.New("no reception is currently in progress")
 var ErrReceptionNotFound = errors.New("reception not found")
+var ErrInvalidReceptionTransition = errors.New("reception status transition is not allowed")
+var ErrPVZHasOpenReception = errors.New("pvz already has an open reception")
+var ErrReceptionBarcodeConflict = errors.New("reception has products with barcodes already scanned in another open reception")
+var ErrInvalidExportPeriod = errors.New("startDate must not be after endDate")
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TokenPair struct {
+	AccessToken  Token
+	RefreshToken Token
+}
+
+// RefreshToken хранится в БД только в виде хеша
+type RefreshToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+func (t RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+func (t RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+var ErrInvalidRefreshToken = errors.New("invalid refresh token")
+
+// ErrInvalidToken access токен отклонён: подпись, срок, издатель или отзыв.
+// Остальные ошибки проверки токена внутренние и клиенту не показываются.
+var ErrInvalidToken = errors.New("invalid token")
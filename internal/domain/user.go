@@ -3,6 +3,7 @@ package domain
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
@@ -25,7 +26,12 @@ type User struct {
 type Token string
 
 type UserClaims struct {
-	Role Role
+	UserID uuid.UUID
+	Role   Role
+
+	// jti и срок жизни access токена, нужны для отзыва
+	TokenID   uuid.UUID
+	ExpiresAt time.Time
 }
 
 func NewUser(email, password string, role Role) (*User, error) {
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookSubscription struct {
+	ID         uuid.UUID
+	URL        string
+	Secret     string
+	EventTypes []EventType
+	CreatedBy  uuid.UUID
+	CreatedAt  time.Time
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery попытка доставки одного события одной подписке.
+// Строка живёт до успешной доставки или исчерпания попыток и служит журналом доставок.
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	Event          OutboxEvent
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+
+	Subscription *WebhookSubscription
+}
+
+// ReceptionClosedPayload полезная нагрузка события ReceptionClosed:
+// закрытая приёмка и количество товаров по типам.
+type ReceptionClosedPayload struct {
+	Reception     *Reception     `json:"reception"`
+	ProductCounts map[string]int `json:"productCounts"`
+}
+
+func (e EventType) IsValid() bool {
+	switch e {
+	case EventPVZCreated, EventPVZStatusChanged, EventPVZUpdated, EventReceptionOpened,
+		EventReceptionClosed, EventReceptionCancelled, EventReceptionReopened, EventProductAdded,
+		EventProductRemoved, EventProductIssued, EventProductReturned:
+		return true
+	default:
+		return false
+	}
+}
+
+var ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+var ErrInvalidEventType = errors.New("invalid event type")
@@ -3,6 +3,31 @@ package infra
 import "errors"
 
 var (
-	ErrNotFound  = errors.New("not found")
-	ErrDuplicate = errors.New("duplicate")
+	ErrNotFound   = errors.New("not found")
+	ErrDuplicate  = errors.New("duplicate")
+	ErrReferenced = errors.New("referenced")
 )
+
+// ConstraintError нарушение ограничения БД с именем ограничения или индекса.
+// errors.Is сопоставляет его с Err, поэтому проверки на ErrDuplicate продолжают работать.
+type ConstraintError struct {
+	Err        error
+	Constraint string
+}
+
+func (e *ConstraintError) Error() string {
+	return e.Err.Error() + ": " + e.Constraint
+}
+
+func (e *ConstraintError) Unwrap() error {
+	return e.Err
+}
+
+// ConstraintName возвращает имя нарушенного ограничения или пустую строку.
+func ConstraintName(err error) string {
+	var constraintErr *ConstraintError
+	if errors.As(err, &constraintErr) {
+		return constraintErr.Constraint
+	}
+	return ""
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres/schema"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+)
+
+type AuditEventRepository struct {
+	db  DBTX
+	sqb sq.StatementBuilderType
+}
+
+func NewAuditEventRepository(db DBTX) *AuditEventRepository {
+	return &AuditEventRepository{
+		db:  db,
+		sqb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *AuditEventRepository) Create(ctx context.Context, event domain.AuditEvent) (*domain.AuditEvent, error) {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	record, err := schema.NewAuditEvent(&event)
+	if err != nil {
+		return nil, fmt.Errorf("%w: marshal audit state: %w", ErrBuildQuery, err)
+	}
+
+	qb := r.sqb.
+		Insert(record.TableName()).
+		Columns(record.InsertColumns()...).
+		Values(record.Values()...).
+		Suffix("RETURNING " + strings.Join(record.Columns(), ", "))
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.AuditEvent])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainAuditEvent(result), nil
+}
+
+func (r *AuditEventRepository) List(ctx context.Context, filter domain.AuditFilter, pagination *listparams.Pagination) ([]*domain.AuditEvent, error) {
+	where := sq.And{}
+
+	if filter.PvzID != nil {
+		where = append(where, sq.Eq{schema.AuditEventCols.PvzID: *filter.PvzID})
+	}
+	if filter.ActorID != nil {
+		where = append(where, sq.Eq{schema.AuditEventCols.ActorID: *filter.ActorID})
+	}
+	if filter.Action != nil {
+		where = append(where, sq.Eq{schema.AuditEventCols.Action: string(*filter.Action)})
+	}
+	if filter.StartDate != nil {
+		where = append(where, sq.GtOrEq{schema.AuditEventCols.CreatedAt: *filter.StartDate})
+	}
+	if filter.EndDate != nil {
+		where = append(where, sq.LtOrEq{schema.AuditEventCols.CreatedAt: *filter.EndDate})
+	}
+
+	qb := r.sqb.
+		Select(schema.AuditEvent{}.Columns()...).
+		From(schema.AuditEvent{}.TableName()).
+		Where(where).
+		OrderBy(schema.AuditEventCols.CreatedAt+" DESC", schema.AuditEventCols.ID)
+
+	if pagination != nil {
+		qb = qb.Limit(uint64(pagination.Limit)).
+			Offset(uint64(pagination.Offset()))
+	}
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.AuditEvent])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainAuditEventList(results), nil
+}
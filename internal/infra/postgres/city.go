@@ -9,7 +9,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
 	"github.com/valeragav/avito-pvz-service/internal/infra/postgres/schema"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 )
 
 type CityRepository struct {
@@ -69,6 +71,89 @@ func (r *CityRepository) Get(ctx context.Context, filter domain.City) (*domain.C
 	return schema.NewDomainCities(&result), nil
 }
 
+func (r *CityRepository) List(ctx context.Context, pagination *listparams.Pagination) ([]*domain.City, error) {
+	qb := r.sqb.
+		Select(schema.City{}.Columns()...).
+		From(schema.City{}.TableName()).
+		OrderBy(schema.CityCols.Name, schema.CityCols.ID)
+
+	if pagination != nil {
+		qb = qb.Limit(uint64(pagination.Limit)).
+			Offset(uint64(pagination.Offset()))
+	}
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.City])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainCitiesList(results), nil
+}
+
+func (r *CityRepository) Update(ctx context.Context, city domain.City) (*domain.City, error) {
+	record := schema.NewCity(&city)
+
+	qb := r.sqb.
+		Update(record.TableName()).
+		Set(schema.CityCols.Name, record.Name).
+		Where(sq.Eq{schema.CityCols.ID: city.ID}).
+		Suffix("RETURNING " + strings.Join(record.Columns(), ", "))
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.City])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainCities(&result), nil
+}
+
+// Delete возвращает infra.ErrReferenced, если на город ссылаются PVZ.
+func (r *CityRepository) Delete(ctx context.Context, cityID uuid.UUID) error {
+	qb := r.sqb.
+		Delete(schema.City{}.TableName()).
+		Where(sq.Eq{schema.CityCols.ID: cityID})
+
+	sql, args, err := qb.ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrBuildQuery, err)
+	}
+
+	tag, err := executor(ctx, r.db).Exec(ctx, sql, args...)
+	if err != nil {
+		if IsForeignKeyViolationError(err) {
+			return infra.ErrReferenced
+		}
+		return fmt.Errorf("%w: %w", ErrExecuteQuery, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return infra.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *CityRepository) HasPVZ(ctx context.Context, cityID uuid.UUID) (bool, error) {
+	qb := r.sqb.
+		Select("1").
+		Prefix("SELECT EXISTS (").
+		From(schema.PVZ{}.TableName()).
+		Where(sq.Eq{schema.PVZCols.CityID: cityID}).
+		Suffix(")")
+
+	sql, args, err := qb.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrBuildQuery, err)
+	}
+
+	var exists bool
+	if err := executor(ctx, r.db).QueryRow(ctx, sql, args...).Scan(&exists); err != nil {
+		return false, fmt.Errorf("%w: %w", ErrExecuteQuery, err)
+	}
+
+	return exists, nil
+}
+
 func (r CityRepository) CreateBatchPgx(ctx context.Context, cities []domain.City) error {
 	batch := &pgx.Batch{}
 
@@ -87,7 +172,7 @@ func (r CityRepository) CreateBatchPgx(ctx context.Context, cities []domain.City
 		batch.Queue(sql, city.ID, city.Name)
 	}
 
-	br := r.db.SendBatch(ctx, batch)
+	br := executor(ctx, r.db).SendBatch(ctx, batch)
 	defer br.Close()
 
 	for range cities {
@@ -22,7 +22,7 @@ func Exec(ctx context.Context, db DBTX, builder builder) error {
 		logger.DebugCtx(ctx, "err builder", "sql", sql, "args", args, "err", err)
 		return fmt.Errorf("%w: %w", ErrBuildQuery, err)
 	}
-	_, err = db.Exec(ctx, sql, args...)
+	_, err = executor(ctx, db).Exec(ctx, sql, args...)
 	return err
 }
 
@@ -34,7 +34,7 @@ func CollectRows[T any](ctx context.Context, db DBTX, builder builder, rowMapper
 		return nil, fmt.Errorf("%w: %w", ErrBuildQuery, err)
 	}
 
-	rows, err := db.Query(ctx, sql, args...)
+	rows, err := executor(ctx, db).Query(ctx, sql, args...)
 	if err != nil {
 		logger.DebugCtx(ctx, "err execute query", "sql", sql, "args", args, "err", err)
 		return nil, fmt.Errorf("%w: %w", ErrExecuteQuery, err)
@@ -44,7 +44,7 @@ func CollectRows[T any](ctx context.Context, db DBTX, builder builder, rowMapper
 	results, err := pgx.CollectRows(rows, rowMapper)
 	if err != nil {
 		if IsDuplicateKeyError(err) {
-			return nil, infra.ErrDuplicate
+			return nil, duplicateError(err)
 		}
 		logger.DebugCtx(ctx, "err scan", "sql", sql, "args", args, "err", err)
 		return nil, fmt.Errorf("%w: %w", ErrScanResult, err)
@@ -53,6 +53,42 @@ func CollectRows[T any](ctx context.Context, db DBTX, builder builder, rowMapper
 	return results, nil
 }
 
+// ForEachRow executes a sql query built by sqb and calls fn for every row as it is read from the connection,
+// without collecting the whole result in memory. An error returned by fn stops the iteration and is returned as is.
+func ForEachRow[T any](ctx context.Context, db DBTX, builder builder, rowMapper func(pgx.CollectableRow) (T, error), fn func(T) error) error {
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		logger.DebugCtx(ctx, "err builder", "sql", sql, "args", args, "err", err)
+		return fmt.Errorf("%w: %w", ErrBuildQuery, err)
+	}
+
+	rows, err := executor(ctx, db).Query(ctx, sql, args...)
+	if err != nil {
+		logger.DebugCtx(ctx, "err execute query", "sql", sql, "args", args, "err", err)
+		return fmt.Errorf("%w: %w", ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		row, err := rowMapper(rows)
+		if err != nil {
+			logger.DebugCtx(ctx, "err scan", "sql", sql, "args", args, "err", err)
+			return fmt.Errorf("%w: %w", ErrScanResult, err)
+		}
+
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.DebugCtx(ctx, "err read rows", "sql", sql, "args", args, "err", err)
+		return fmt.Errorf("%w: %w", ErrExecuteQuery, err)
+	}
+
+	return nil
+}
+
 // CollectOneRow executes a sql query built by sqb, collects a single row into dst using RowMapper.
 func CollectOneRow[T any](ctx context.Context, db DBTX, builder builder, rowMapper func(pgx.CollectableRow) (T, error)) (T, error) {
 	var zero T
@@ -63,7 +99,7 @@ func CollectOneRow[T any](ctx context.Context, db DBTX, builder builder, rowMapp
 		return zero, fmt.Errorf("%w: %w", ErrBuildQuery, err)
 	}
 
-	rows, err := db.Query(ctx, sql, args...)
+	rows, err := executor(ctx, db).Query(ctx, sql, args...)
 	if err != nil {
 		logger.DebugCtx(ctx, "err execute query", "sql", sql, "args", args, "err", err)
 		return zero, fmt.Errorf("%w: %w", ErrExecuteQuery, err)
@@ -76,7 +112,7 @@ func CollectOneRow[T any](ctx context.Context, db DBTX, builder builder, rowMapp
 			return zero, infra.ErrNotFound
 		}
 		if IsDuplicateKeyError(err) {
-			return zero, infra.ErrDuplicate
+			return zero, duplicateError(err)
 		}
 		logger.DebugCtx(ctx, "err scan", "sql", sql, "args", args, "err", err)
 		return zero, fmt.Errorf("%w: %w", ErrScanResult, err)
@@ -89,6 +125,20 @@ func IsDuplicateKeyError(err error) bool {
 	return IsPgErrorWithCode(err, pgerrcode.UniqueViolation)
 }
 
+// duplicateError возвращает infra.ErrDuplicate с именем нарушенного уникального индекса,
+// чтобы use case мог различить, какое ограничение сработало.
+func duplicateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName != "" {
+		return &infra.ConstraintError{Err: infra.ErrDuplicate, Constraint: pgErr.ConstraintName}
+	}
+	return infra.ErrDuplicate
+}
+
+func IsForeignKeyViolationError(err error) bool {
+	return IsPgErrorWithCode(err, pgerrcode.ForeignKeyViolation)
+}
+
 func IsPgErrorWithCode(err error, code string) bool {
 	if err == nil {
 		return false
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres/schema"
+)
+
+type IssuanceRepository struct {
+	db  DBTX
+	sqb sq.StatementBuilderType
+}
+
+func NewIssuanceRepository(db DBTX) *IssuanceRepository {
+	return &IssuanceRepository{
+		db:  db,
+		sqb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *IssuanceRepository) Create(ctx context.Context, issuance domain.Issuance) (*domain.Issuance, error) {
+	if issuance.ID == uuid.Nil {
+		issuance.ID = uuid.New()
+	}
+	if issuance.CreatedAt.IsZero() {
+		issuance.CreatedAt = time.Now()
+	}
+
+	record := schema.NewIssuance(&issuance)
+
+	qb := r.sqb.
+		Insert(record.TableName()).
+		Columns(record.InsertColumns()...).
+		Values(record.Values()...).
+		Suffix("RETURNING " + strings.Join(record.Columns(), ", "))
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.Issuance])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainIssuance(result), nil
+}
+
+// GetLastByProduct возвращает последнюю запись указанного вида по товару.
+func (r *IssuanceRepository) GetLastByProduct(ctx context.Context, productID uuid.UUID, kind domain.IssuanceKind) (*domain.Issuance, error) {
+	qb := r.sqb.
+		Select(schema.Issuance{}.Columns()...).
+		From(schema.Issuance{}.TableName()).
+		Where(sq.Eq{
+			schema.IssuanceCols.ProductID: productID,
+			schema.IssuanceCols.Kind:      string(kind),
+		}).
+		OrderBy(schema.IssuanceCols.CreatedAt+" DESC", schema.IssuanceCols.ID+" DESC").
+		Limit(1)
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.Issuance])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainIssuance(result), nil
+}
+
+// ListByProduct возвращает историю выдач и возвратов товара в хронологическом порядке.
+func (r *IssuanceRepository) ListByProduct(ctx context.Context, productID uuid.UUID) ([]*domain.Issuance, error) {
+	qb := r.sqb.
+		Select(schema.Issuance{}.Columns()...).
+		From(schema.Issuance{}.TableName()).
+		Where(sq.Eq{schema.IssuanceCols.ProductID: productID}).
+		OrderBy(schema.IssuanceCols.CreatedAt, schema.IssuanceCols.ID)
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.Issuance])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainIssuanceList(results), nil
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+)
+
+// PVZEventsChannel канал LISTEN/NOTIFY, через который события outbox расходятся по всем экземплярам сервиса.
+const PVZEventsChannel = "pvz_events"
+
+// maxNotifyBody запас до лимита NOTIFY в 8000 байт: jsonb при выводе добавляет пробелы и город.
+const maxNotifyBody = 7000
+
+type pvzEventNotification struct {
+	ID          uuid.UUID        `json:"id"`
+	Type        domain.EventType `json:"type"`
+	AggregateID uuid.UUID        `json:"aggregateId"`
+	PvzID       uuid.UUID        `json:"pvzId"`
+	City        string           `json:"city"`
+	OccurredAt  time.Time        `json:"occurredAt"`
+	Payload     json.RawMessage  `json:"payload,omitempty"`
+}
+
+// EventNotifier публикует события outbox в PVZEventsChannel.
+// Outbox вызывает его внутри транзакции use case, поэтому уведомление уходит только после её коммита.
+type EventNotifier struct {
+	db  DBTX
+	sqb sq.StatementBuilderType
+}
+
+func NewEventNotifier(db DBTX) *EventNotifier {
+	return &EventNotifier{
+		db:  db,
+		sqb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (n *EventNotifier) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	if event.PvzID == uuid.Nil {
+		return nil
+	}
+
+	msg := pvzEventNotification{
+		ID:          event.ID,
+		Type:        event.Type,
+		AggregateID: event.AggregateID,
+		PvzID:       event.PvzID,
+		OccurredAt:  event.CreatedAt,
+	}
+
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: marshal event payload: %w", ErrBuildQuery, err)
+	}
+	msg.Payload = payload
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("%w: marshal notification: %w", ErrBuildQuery, err)
+	}
+	if len(body) > maxNotifyBody {
+		// подписчик получит событие без payload и при необходимости дочитает его через API
+		msg.Payload = nil
+		if body, err = json.Marshal(msg); err != nil {
+			return fmt.Errorf("%w: marshal notification: %w", ErrBuildQuery, err)
+		}
+	}
+
+	qb := n.sqb.Select().Column(sq.Expr(
+		"pg_notify(?, (?::jsonb || jsonb_build_object('city', (SELECT cities.name FROM pvz JOIN cities ON cities.id = pvz.city_id WHERE pvz.id = ?)))::text)",
+		PVZEventsChannel, string(body), event.PvzID,
+	))
+
+	return Exec(ctx, n.db, qb)
+}
+
+// EventListener слушает PVZEventsChannel на выделенном соединении и передаёт события в handler.
+// После обрыва соединения переподключается через retryInterval, уведомления за это время теряются.
+type EventListener struct {
+	pool          *pgxpool.Pool
+	handler       func(domain.PVZEvent)
+	retryInterval time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewEventListener(pool *pgxpool.Pool, handler func(domain.PVZEvent), retryInterval time.Duration) *EventListener {
+	return &EventListener{
+		pool:          pool,
+		handler:       handler,
+		retryInterval: retryInterval,
+	}
+}
+
+// Start запускает фоновую горутину. Остановка через Stop или отмену ctx.
+func (l *EventListener) Start(ctx context.Context) {
+	ctx, l.cancel = context.WithCancel(ctx)
+	l.done = make(chan struct{})
+
+	go l.run(ctx)
+}
+
+// Stop закрывает соединение и ждёт завершения горутины.
+func (l *EventListener) Stop(ctx context.Context) error {
+	if l.cancel == nil {
+		return nil
+	}
+	l.cancel()
+
+	select {
+	case <-l.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *EventListener) run(ctx context.Context) {
+	defer close(l.done)
+
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.WarnCtx(ctx, "event listener: connection lost", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(l.retryInterval):
+		}
+	}
+}
+
+func (l *EventListener) listen(ctx context.Context) error {
+	poolConn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire conn: %w", err)
+	}
+	// соединение в режиме LISTEN не должно вернуться в пул
+	conn := poolConn.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+PVZEventsChannel); err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+
+		var msg pvzEventNotification
+		if err := json.Unmarshal([]byte(notification.Payload), &msg); err != nil {
+			logger.WarnCtx(ctx, "event listener: invalid notification", "error", err)
+			continue
+		}
+
+		l.handler(domain.PVZEvent{
+			ID:          msg.ID,
+			Type:        msg.Type,
+			AggregateID: msg.AggregateID,
+			PvzID:       msg.PvzID,
+			City:        msg.City,
+			OccurredAt:  msg.OccurredAt,
+			Payload:     msg.Payload,
+		})
+	}
+}
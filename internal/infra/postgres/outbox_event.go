@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres/schema"
+)
+
+type OutboxEventRepository struct {
+	db  DBTX
+	sqb sq.StatementBuilderType
+}
+
+func NewOutboxEventRepository(db DBTX) *OutboxEventRepository {
+	return &OutboxEventRepository{
+		db:  db,
+		sqb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *OutboxEventRepository) Create(ctx context.Context, event domain.OutboxEvent) (*domain.OutboxEvent, error) {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	record, err := schema.NewOutboxEvent(&event)
+	if err != nil {
+		return nil, fmt.Errorf("%w: marshal event payload: %w", ErrBuildQuery, err)
+	}
+
+	qb := r.sqb.
+		Insert(record.TableName()).
+		Columns(record.InsertColumns()...).
+		Values(record.Values()...).
+		Suffix("RETURNING " + strings.Join(record.Columns(), ", "))
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.OutboxEvent])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainOutboxEvent(result), nil
+}
+
+// ClaimDue выбирает пачку событий, время публикации которых наступило, и сдаёт их в аренду
+// до now+lease. Публикация идёт уже без транзакции, а аренда не даёт другим экземплярам relay
+// взять те же события; после падения relay события вернутся в очередь по истечении аренды.
+// Событие не выбирается, пока более раннее событие того же PVZ ждёт повтора или арендовано,
+// поэтому порядок внутри PVZ сохраняется, а сбойный PVZ не занимает пачку целиком.
+func (r *OutboxEventRepository) ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]*domain.OutboxEvent, error) {
+	now := time.Now()
+
+	due := sq.Select("candidate.id").
+		From("outbox_events AS candidate").
+		Where(sq.Eq{
+			"candidate.published_at": nil,
+			"candidate.failed_at":    nil,
+		}).
+		Where(sq.LtOrEq{"candidate.next_attempt_at": now}).
+		Where(sq.Or{
+			sq.Eq{"candidate.locked_until": nil},
+			sq.Lt{"candidate.locked_until": now},
+		}).
+		Where(sq.Expr(
+			`NOT EXISTS (SELECT 1 FROM outbox_events AS prev
+			WHERE prev.pvz_id = candidate.pvz_id AND prev.published_at IS NULL AND prev.failed_at IS NULL
+			AND (prev.created_at, prev.id) < (candidate.created_at, candidate.id)
+			AND (prev.next_attempt_at > ? OR prev.locked_until >= ?))`,
+			now, now,
+		)).
+		OrderBy("candidate.created_at", "candidate.id").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	qb := r.sqb.
+		Update(schema.OutboxEvent{}.TableName()).
+		Set(schema.OutboxEventCols.LockedUntil, now.Add(lease)).
+		Where(sq.Expr(schema.OutboxEventCols.ID+" IN (?)", due)).
+		Suffix("RETURNING " + strings.Join(schema.OutboxEvent{}.Columns(), ", "))
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.OutboxEvent])
+	if err != nil {
+		return nil, err
+	}
+
+	events := schema.NewDomainOutboxEventList(results)
+	// UPDATE ... RETURNING не сохраняет порядок подзапроса
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].CreatedAt.Equal(events[j].CreatedAt) {
+			return events[i].ID.String() < events[j].ID.String()
+		}
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+
+	return events, nil
+}
+
+func (r *OutboxEventRepository) MarkPublished(ctx context.Context, eventID uuid.UUID) error {
+	qb := r.sqb.
+		Update(schema.OutboxEvent{}.TableName()).
+		Set(schema.OutboxEventCols.PublishedAt, sq.Expr("now()")).
+		Set(schema.OutboxEventCols.Attempts, sq.Expr(schema.OutboxEventCols.Attempts+" + 1")).
+		Set(schema.OutboxEventCols.LastError, nil).
+		Set(schema.OutboxEventCols.LockedUntil, nil).
+		Where(sq.Eq{schema.OutboxEventCols.ID: eventID})
+
+	return Exec(ctx, r.db, qb)
+}
+
+// MarkFailed сохраняет неудачную попытку и откладывает следующую до nextAttemptAt.
+func (r *OutboxEventRepository) MarkFailed(ctx context.Context, eventID uuid.UUID, reason string, nextAttemptAt time.Time) error {
+	qb := r.sqb.
+		Update(schema.OutboxEvent{}.TableName()).
+		Set(schema.OutboxEventCols.Attempts, sq.Expr(schema.OutboxEventCols.Attempts+" + 1")).
+		Set(schema.OutboxEventCols.LastError, reason).
+		Set(schema.OutboxEventCols.NextAttemptAt, nextAttemptAt).
+		Set(schema.OutboxEventCols.LockedUntil, nil).
+		Where(sq.Eq{schema.OutboxEventCols.ID: eventID})
+
+	return Exec(ctx, r.db, qb)
+}
+
+// MarkDead сохраняет последнюю неудачную попытку и снимает событие с публикации.
+func (r *OutboxEventRepository) MarkDead(ctx context.Context, eventID uuid.UUID, reason string) error {
+	qb := r.sqb.
+		Update(schema.OutboxEvent{}.TableName()).
+		Set(schema.OutboxEventCols.Attempts, sq.Expr(schema.OutboxEventCols.Attempts+" + 1")).
+		Set(schema.OutboxEventCols.LastError, reason).
+		Set(schema.OutboxEventCols.FailedAt, sq.Expr("now()")).
+		Set(schema.OutboxEventCols.LockedUntil, nil).
+		Where(sq.Eq{schema.OutboxEventCols.ID: eventID})
+
+	return Exec(ctx, r.db, qb)
+}
+
+// Release снимает аренду с событий, которые relay взял, но не стал публиковать.
+func (r *OutboxEventRepository) Release(ctx context.Context, eventIDs []uuid.UUID) error {
+	qb := r.sqb.
+		Update(schema.OutboxEvent{}.TableName()).
+		Set(schema.OutboxEventCols.LockedUntil, nil).
+		Where(sq.Eq{schema.OutboxEventCols.ID: eventIDs})
+
+	return Exec(ctx, r.db, qb)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
@@ -46,6 +47,54 @@ func (r ProductRepository) Create(ctx context.Context, product domain.Product) (
 	return schema.NewDomainProduct(&productCreate), nil
 }
 
+// CreateBatch вставляет товары одним pgx.Batch, результат в порядке входного слайса.
+func (r ProductRepository) CreateBatch(ctx context.Context, products []domain.Product) ([]*domain.Product, error) {
+	batch := &pgx.Batch{}
+
+	for _, product := range products {
+		if product.ID == uuid.Nil {
+			product.ID = uuid.New()
+		}
+
+		record := schema.NewProduct(&product)
+
+		sql, args, err := r.sqb.
+			Insert(record.TableName()).
+			Columns(record.InsertColumns()...).
+			Values(record.Values()...).
+			Suffix("RETURNING " + strings.Join(record.Columns(), ", ")).
+			ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrBuildQuery, err)
+		}
+
+		batch.Queue(sql, args...)
+	}
+
+	br := executor(ctx, r.db).SendBatch(ctx, batch)
+	defer br.Close()
+
+	res := make([]*domain.Product, 0, len(products))
+	for range products {
+		rows, err := br.Query()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrExecuteQuery, err)
+		}
+
+		created, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[schema.Product])
+		if err != nil {
+			if IsDuplicateKeyError(err) {
+				return nil, duplicateError(err)
+			}
+			return nil, fmt.Errorf("%w: %w", ErrScanResult, err)
+		}
+
+		res = append(res, schema.NewDomainProduct(&created))
+	}
+
+	return res, nil
+}
+
 func (r ProductRepository) Get(ctx context.Context, filter domain.Product) (*domain.Product, error) {
 	where := sq.Eq{}
 	if filter.ID != uuid.Nil {
@@ -77,6 +126,35 @@ func (r ProductRepository) Get(ctx context.Context, filter domain.Product) (*dom
 	return schema.NewDomainProductWithTypeName(results), nil
 }
 
+// GetLastByBarcode возвращает последний принятый товар с таким штрихкодом.
+func (r *ProductRepository) GetLastByBarcode(ctx context.Context, barcode string) (*domain.Product, error) {
+	qb := r.sqb.
+		Select(schema.ProductWithTypeName{}.Columns()...).
+		From(schema.Product{}.TableName()).
+		Join("product_types ON product_types.id = products.type_id").
+		Where(sq.Eq{"products.barcode": barcode}).
+		OrderBy("products.date_time DESC").
+		Limit(1)
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.ProductWithTypeName])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainProductWithTypeName(result), nil
+}
+
+// ListOpenBarcodes возвращает штрихкоды из списка, которые уже есть в открытых приёмках.
+func (r *ProductRepository) ListOpenBarcodes(ctx context.Context, barcodes []string) ([]string, error) {
+	qb := r.sqb.
+		Select("products.barcode").
+		From(schema.Product{}.TableName()).
+		Where(sq.Eq{"products.barcode": barcodes}).
+		Where("products.in_open_reception")
+
+	return CollectRows(ctx, r.db, qb, pgx.RowTo[string])
+}
+
 func (r *ProductRepository) GetLastProductInReception(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error) {
 	qb := r.sqb.
 		Select(schema.ProductWithTypeName{}.Columns()...).
@@ -94,6 +172,7 @@ func (r *ProductRepository) GetLastProductInReception(ctx context.Context, recep
 	return schema.NewDomainProductWithTypeName(result), nil
 }
 
+// DeleteProduct возвращает infra.ErrReferenced, если у товара есть история выдач.
 func (r *ProductRepository) DeleteProduct(ctx context.Context, productID uuid.UUID) error {
 	qb := r.sqb.
 		Delete(schema.Product{}.TableName()).
@@ -104,8 +183,11 @@ func (r *ProductRepository) DeleteProduct(ctx context.Context, productID uuid.UU
 		return fmt.Errorf("%w: %w", ErrBuildQuery, err)
 	}
 
-	tag, err := r.db.Exec(ctx, sql, args...)
+	tag, err := executor(ctx, r.db).Exec(ctx, sql, args...)
 	if err != nil {
+		if IsForeignKeyViolationError(err) {
+			return infra.ErrReferenced
+		}
 		return fmt.Errorf("%w: %w", ErrExecuteQuery, err)
 	}
 
@@ -130,3 +212,119 @@ func (r *ProductRepository) ListByReceptionIDsWithTypeName(ctx context.Context,
 
 	return schema.NewDomainProductWithTypeNameList(results), nil
 }
+
+// SetIssued меняет признак выдачи товара. Обновление условное, поэтому
+// повторная выдача или возврат невыданного товара возвращают infra.ErrNotFound.
+func (r *ProductRepository) SetIssued(ctx context.Context, productID uuid.UUID, issued bool) (*domain.Product, error) {
+	qb := r.sqb.
+		Update(schema.Product{}.TableName()).
+		Set(schema.ProductCols.Issued, issued).
+		Where(sq.Eq{
+			schema.ProductCols.ID:     productID,
+			schema.ProductCols.Issued: !issued,
+		}).
+		Suffix("RETURNING " + strings.Join(schema.Product{}.Columns(), ", "))
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.Product])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainProduct(&result), nil
+}
+
+// CountOnHandByPVZ возвращает количество невыданных товаров на складе каждого PVZ.
+// Товары отменённых приёмок не учитываются.
+func (r *ProductRepository) CountOnHandByPVZ(ctx context.Context, pvzIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	qb := r.sqb.
+		Select("receptions.pvz_id AS pvz_id", "count(*) AS count").
+		From(schema.Product{}.TableName()).
+		Join("receptions ON receptions.id = products.reception_id").
+		Join("reception_statuses ON reception_statuses.id = receptions.status_id").
+		Where(sq.Eq{"receptions.pvz_id": pvzIDs}).
+		Where(sq.Eq{"products.issued": false}).
+		Where(sq.NotEq{"reception_statuses.name": string(domain.ReceptionStatusCancelled)}).
+		GroupBy("receptions.pvz_id")
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.PVZStockCount])
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int, len(results))
+	for _, row := range results {
+		counts[row.PvzID] = row.Count
+	}
+
+	return counts, nil
+}
+
+// InventoryByType возвращает остаток товаров на складе PVZ по названию типа.
+// Без asOf используется денормализованный признак выдачи. С asOf выдача и отмена приёмки
+// восстанавливаются по журналам issuances и reception_transitions на указанный момент.
+// Удалённые товары в истории не учитываются, так как удаляются физически.
+func (r *ProductRepository) InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error) {
+	qb := r.sqb.
+		Select("product_types.name AS type_name", "count(*) AS count").
+		From(schema.Product{}.TableName()).
+		Join("product_types ON product_types.id = products.type_id").
+		Join("receptions ON receptions.id = products.reception_id").
+		Where(sq.Eq{"receptions.pvz_id": pvzID}).
+		GroupBy("product_types.name")
+
+	if asOf == nil {
+		qb = qb.
+			Join("reception_statuses ON reception_statuses.id = receptions.status_id").
+			Where(sq.Eq{"products.issued": false}).
+			Where(sq.NotEq{"reception_statuses.name": string(domain.ReceptionStatusCancelled)})
+	} else {
+		qb = qb.
+			Where(sq.LtOrEq{"products.date_time": *asOf}).
+			Where(sq.Expr(
+				"COALESCE((SELECT reception_transitions.to_status FROM reception_transitions WHERE reception_transitions.reception_id = receptions.id AND reception_transitions.created_at <= ? ORDER BY reception_transitions.created_at DESC LIMIT 1), ?) <> ?",
+				*asOf,
+				string(domain.ReceptionStatusInProgress),
+				string(domain.ReceptionStatusCancelled),
+			)).
+			Where(sq.Expr(
+				"COALESCE((SELECT issuances.kind FROM issuances WHERE issuances.product_id = products.id AND issuances.created_at <= ? ORDER BY issuances.created_at DESC, issuances.id DESC LIMIT 1), ?) <> ?",
+				*asOf,
+				string(domain.IssuanceKindReturn),
+				string(domain.IssuanceKindIssue),
+			))
+	}
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.ProductTypeCount])
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(results))
+	for _, row := range results {
+		counts[row.TypeName] = row.Count
+	}
+
+	return counts, nil
+}
+
+// CountByTypeInReception возвращает количество товаров в приёмке по названию типа.
+func (r *ProductRepository) CountByTypeInReception(ctx context.Context, receptionID uuid.UUID) (map[string]int, error) {
+	qb := r.sqb.
+		Select("product_types.name AS type_name", "count(*) AS count").
+		From(schema.Product{}.TableName()).
+		Join("product_types ON product_types.id = products.type_id").
+		Where(sq.Eq{"products.reception_id": receptionID}).
+		GroupBy("product_types.name")
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.ProductTypeCount])
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(results))
+	for _, row := range results {
+		counts[row.TypeName] = row.Count
+	}
+
+	return counts, nil
+}
@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -73,6 +75,68 @@ func (r *PVZRepository) Get(ctx context.Context, filter domain.PVZ) (*domain.PVZ
 	return schema.NewDomainPVZ(result), nil
 }
 
+// GetForUpdate блокирует строку PVZ до конца транзакции,
+// чтобы параллельные операции с приёмками одного PVZ выполнялись последовательно.
+func (r *PVZRepository) GetForUpdate(ctx context.Context, pvzID uuid.UUID) (*domain.PVZ, error) {
+	qb := r.sqb.
+		Select(schema.PVZ{}.Columns()...).
+		From(schema.PVZ{}.TableName()).
+		Where(sq.Eq{schema.PVZCols.ID: pvzID}).
+		Suffix("FOR UPDATE")
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.PVZ])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainPVZ(result), nil
+}
+
+// UpdateStatus записывает новый статус PVZ вместе с причиной и датой вступления в силу.
+func (r *PVZRepository) UpdateStatus(ctx context.Context, pvzID uuid.UUID, status domain.PVZStatus, reason string, effectiveAt time.Time) (*domain.PVZ, error) {
+	qb := r.sqb.
+		Update(schema.PVZ{}.TableName()).
+		SetMap(map[string]any{
+			schema.PVZCols.Status:            string(status),
+			schema.PVZCols.StatusReason:      reason,
+			schema.PVZCols.StatusEffectiveAt: effectiveAt,
+		}).
+		Where(sq.Eq{schema.PVZCols.ID: pvzID}).
+		Suffix("RETURNING " + strings.Join(schema.PVZ{}.Columns(), ", "))
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.PVZ])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainPVZ(result), nil
+}
+
+// UpdateProfile перезаписывает адрес, координаты, расписание и лимиты вместимости PVZ.
+func (r *PVZRepository) UpdateProfile(ctx context.Context, pvz domain.PVZ) (*domain.PVZ, error) {
+	record := schema.NewPVZ(&pvz)
+
+	qb := r.sqb.
+		Update(record.TableName()).
+		SetMap(map[string]any{
+			schema.PVZCols.Address:        record.Address,
+			schema.PVZCols.Latitude:       record.Latitude,
+			schema.PVZCols.Longitude:      record.Longitude,
+			schema.PVZCols.WorkingHours:   record.WorkingHours,
+			schema.PVZCols.Capacity:       record.Capacity,
+			schema.PVZCols.TypeCapacities: record.TypeCapacities,
+		}).
+		Where(sq.Eq{schema.PVZCols.ID: pvz.ID}).
+		Suffix("RETURNING " + strings.Join(record.Columns(), ", "))
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.PVZ])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainPVZ(result), nil
+}
+
 // ListPvzByAcceptanceDateAndCitySlow выполняет JOIN с таблицей receptions,
 // что приводит к дублированию строк PVZ (по одной на каждую приёмку),
 // вынуждает использовать GROUP BY на всём результате до применения LIMIT
@@ -116,28 +180,89 @@ func (r *PVZRepository) ListPvzByAcceptanceDateAndCitySlow(ctx context.Context,
 	return schema.NewDomainPVZWithCityNameList(results), nil
 }
 
-func (r *PVZRepository) ListPvzByAcceptanceDateAndCity(ctx context.Context, pagination *listparams.Pagination, startDate, endDate *time.Time) ([]*domain.PVZ, error) {
+const (
+	earthRadiusKm = 6371.0
+	// kmPerDegree длина градуса широты, по ней строится прямоугольник для отбора по индексу
+	kmPerDegree = 111.045
+)
+
+// haversineKm расстояние от точки до PVZ по формуле гаверсинусов, параметры: широта, широта, долгота.
+// least защищает asin от погрешности округления чуть больше 1.
+var haversineKm = fmt.Sprintf(
+	"%.1f * 2 * asin(least(1, sqrt(power(sin(radians(pvz.latitude - ?) / 2), 2)"+
+		" + cos(radians(?)) * cos(radians(pvz.latitude)) * power(sin(radians(pvz.longitude - ?) / 2), 2))))",
+	earthRadiusKm,
+)
+
+// ListNearby возвращает активные PVZ в радиусе radiusKm от точки, ближайшие первыми.
+// Сначала строки отбираются по прямоугольнику координат, затем точно по расстоянию.
+func (r *PVZRepository) ListNearby(ctx context.Context, point domain.GeoPoint, radiusKm float64, limit uint) ([]*domain.PVZ, error) {
+	distance := sq.Expr(haversineKm, point.Lat, point.Lat, point.Lon)
+
 	qb := r.sqb.
 		Select(schema.PVZWithCityName{}.Columns()...).
+		Column(sq.Alias(distance, "distance")).
 		From("pvz").
 		Join("cities ON cities.id = pvz.city_id").
-		OrderBy("pvz.registration_date DESC")
+		// статус литералом, чтобы планировщик мог использовать частичный индекс idx_pvz_active_location
+		Where("pvz.status = 'active'").
+		Where(sq.Expr("pvz.latitude BETWEEN ? AND ?", point.Lat-radiusKm/kmPerDegree, point.Lat+radiusKm/kmPerDegree)).
+		Where(sq.Expr(haversineKm+" <= ?", point.Lat, point.Lat, point.Lon, radiusKm)).
+		OrderBy("distance", "pvz.id").
+		Limit(uint64(limit))
 
-	if pagination != nil {
-		qb = qb.Limit(uint64(pagination.Limit)).
-			Offset(uint64(pagination.Offset()))
+	// у полюсов и через антимеридиан прямоугольник по долготе не строится
+	if cosLat := math.Cos(point.Lat * math.Pi / 180); cosLat > 0.01 {
+		lonDelta := radiusKm / (kmPerDegree * cosLat)
+		if point.Lon-lonDelta >= -180 && point.Lon+lonDelta <= 180 {
+			qb = qb.Where(sq.Expr("pvz.longitude BETWEEN ? AND ?", point.Lon-lonDelta, point.Lon+lonDelta))
+		}
 	}
 
-	if startDate != nil && endDate != nil {
-		qb = qb.Where(
-			sq.Expr(
-				"EXISTS (SELECT 1 FROM receptions WHERE receptions.pvz_id = pvz.id AND receptions.date_time >= ? AND receptions.date_time <= ?)",
-				startDate,
-				endDate,
-			),
-		)
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.PVZWithDistance])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainPVZWithDistanceList(results), nil
+}
+
+// pvzSortColumns выражения для разрешённых полей сортировки списка PVZ.
+var pvzSortColumns = map[string]string{
+	domain.PVZSortRegistrationDate: "pvz.registration_date",
+	domain.PVZSortCity:             "cities.name",
+	domain.PVZSortLastReceptionAt:  "(SELECT MAX(receptions.date_time) FROM receptions WHERE receptions.pvz_id = pvz.id)",
+}
+
+// ListPvzByAcceptanceDateAndCity без sorts сортирует по (registration_date DESC, id DESC),
+// иначе по переданным полям с id DESC в конце для стабильного порядка.
+// С after выполняется keyset пагинация по сортировке по умолчанию: отдаются строки строго после курсора,
+// номер страницы не учитывается.
+func (r *PVZRepository) ListPvzByAcceptanceDateAndCity(ctx context.Context, filter domain.PVZListFilter, sorts []listparams.Sort, pagination *listparams.Pagination, after *listparams.Cursor) ([]*domain.PVZ, error) {
+	orderBy, err := pvzOrderBy(sorts)
+	if err != nil {
+		return nil, err
 	}
 
+	qb := r.sqb.
+		Select(schema.PVZWithCityName{}.Columns()...).
+		From("pvz").
+		Join("cities ON cities.id = pvz.city_id").
+		OrderBy(orderBy...)
+
+	if after != nil {
+		qb = qb.Where(sq.Expr("(pvz.registration_date, pvz.id) < (?, ?)", after.Time, after.ID))
+	}
+
+	if pagination != nil {
+		qb = qb.Limit(uint64(pagination.Limit))
+		if after == nil {
+			qb = qb.Offset(uint64(pagination.Offset()))
+		}
+	}
+
+	qb = applyPvzListFilter(qb, filter)
+
 	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.PVZWithCityName])
 	if err != nil {
 		return nil, err
@@ -146,6 +271,96 @@ func (r *PVZRepository) ListPvzByAcceptanceDateAndCity(ctx context.Context, pagi
 	return schema.NewDomainPVZWithCityNameList(results), nil
 }
 
+// CountPvzByAcceptanceDateAndCity считает PVZ под тем же фильтром, что и ListPvzByAcceptanceDateAndCity.
+// Сортировка не нужна, а cities присоединяется только при фильтре по городу.
+func (r *PVZRepository) CountPvzByAcceptanceDateAndCity(ctx context.Context, filter domain.PVZListFilter) (int, error) {
+	qb := r.sqb.
+		Select("COUNT(*)").
+		From("pvz")
+
+	if len(filter.Cities) > 0 {
+		qb = qb.Join("cities ON cities.id = pvz.city_id")
+	}
+
+	qb = applyPvzListFilter(qb, filter)
+
+	return CollectOneRow(ctx, r.db, qb, pgx.RowTo[int])
+}
+
+// applyPvzListFilter ожидает, что при фильтре по городу таблица cities уже присоединена.
+func applyPvzListFilter(qb sq.SelectBuilder, filter domain.PVZListFilter) sq.SelectBuilder {
+	if len(filter.Cities) > 0 {
+		qb = qb.Where(sq.Eq{"cities.name": filter.Cities})
+	}
+
+	if receptionExists, ok := pvzReceptionExists(filter); ok {
+		qb = qb.Where(receptionExists)
+	}
+
+	return qb
+}
+
+func pvzOrderBy(sorts []listparams.Sort) ([]string, error) {
+	if len(sorts) == 0 {
+		return []string{"pvz.registration_date DESC", "pvz.id DESC"}, nil
+	}
+
+	orderBy := make([]string, 0, len(sorts)+1)
+	for _, s := range sorts {
+		column, ok := pvzSortColumns[s.Field]
+		if !ok {
+			return nil, fmt.Errorf("unknown pvz sort field %q", s.Field)
+		}
+
+		order := "ASC"
+		if s.Order == listparams.SortDesc {
+			order = "DESC"
+		}
+		// PVZ без приёмок всегда в конце списка
+		orderBy = append(orderBy, column+" "+order+" NULLS LAST")
+	}
+
+	return append(orderBy, "pvz.id DESC"), nil
+}
+
+// pvzReceptionExists строит условие на наличие у PVZ приёмки, подходящей под фильтр.
+// Все условия относятся к одной приёмке: например, приёмка за период с товаром нужного типа.
+func pvzReceptionExists(filter domain.PVZListFilter) (sq.Sqlizer, bool) {
+	sub := sq.Select("1").
+		From("receptions").
+		Where("receptions.pvz_id = pvz.id")
+	ok := false
+
+	if filter.StartDate != nil && filter.EndDate != nil {
+		sub = sub.Where(sq.And{
+			sq.GtOrEq{"receptions.date_time": filter.StartDate},
+			sq.LtOrEq{"receptions.date_time": filter.EndDate},
+		})
+		ok = true
+	}
+
+	if len(filter.ReceptionStatuses) > 0 {
+		sub = sub.
+			Join("reception_statuses ON reception_statuses.id = receptions.status_id").
+			Where(sq.Eq{"reception_statuses.name": filter.ReceptionStatuses})
+		ok = true
+	}
+
+	if len(filter.ProductTypes) > 0 {
+		sub = sub.Where(sq.Expr(
+			"EXISTS (SELECT 1 FROM products JOIN product_types ON product_types.id = products.type_id WHERE products.reception_id = receptions.id AND product_types.name = ANY(?))",
+			filter.ProductTypes,
+		))
+		ok = true
+	}
+
+	if !ok {
+		return nil, false
+	}
+
+	return sq.Expr("EXISTS (?)", sub), true
+}
+
 func (r *PVZRepository) GetList(ctx context.Context, pagination *listparams.Pagination) ([]*domain.PVZ, error) {
 	qb := r.sqb.
 		Select(schema.PVZWithCityName{}.Columns()...).
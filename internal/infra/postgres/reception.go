@@ -3,12 +3,14 @@ package postgres
 import (
 	"context"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/internal/infra/postgres/schema"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 )
 
 type ReceptionRepository struct {
@@ -95,10 +97,91 @@ func (r *ReceptionRepository) ListByIDsWithStatus(ctx context.Context, pvzIDs []
 	return schema.NewDomainReceptionWithStatusList(results), nil
 }
 
+// ListByPVZWithStatus возвращает приёмки одного PVZ со статусами, новые первыми.
+// Границы периода startDate и endDate применяются независимо.
+func (r *ReceptionRepository) ListByPVZWithStatus(ctx context.Context, pvzID uuid.UUID, pagination *listparams.Pagination, startDate, endDate *time.Time) ([]*domain.Reception, error) {
+	qb := r.sqb.
+		Select(schema.ReceptionWithStatus{}.Columns()...).
+		From(schema.Reception{}.TableName()).
+		Join("reception_statuses ON reception_statuses.id = receptions.status_id").
+		Where(sq.Eq{"receptions.pvz_id": pvzID}).
+		OrderBy("receptions.date_time DESC", "receptions.id DESC")
+
+	if startDate != nil {
+		qb = qb.Where(sq.GtOrEq{"receptions.date_time": *startDate})
+	}
+	if endDate != nil {
+		qb = qb.Where(sq.LtOrEq{"receptions.date_time": *endDate})
+	}
+
+	if pagination != nil {
+		qb = qb.Limit(uint64(pagination.Limit)).
+			Offset(uint64(pagination.Offset()))
+	}
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.ReceptionWithStatus])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainReceptionWithStatusList(results), nil
+}
+
+// Export отдаёт приёмки за период вместе с товарами в fn по мере чтения из базы,
+// не загружая результат в память. Границы периода применяются независимо, nil означает без ограничения.
+func (r *ReceptionRepository) Export(ctx context.Context, startDate, endDate *time.Time, fn func(domain.ReceptionExportRow) error) error {
+	qb := r.sqb.
+		Select(schema.ReceptionExportRow{}.Columns()...).
+		From(schema.Reception{}.TableName()).
+		Join("pvz ON pvz.id = receptions.pvz_id").
+		Join("cities ON cities.id = pvz.city_id").
+		Join("reception_statuses ON reception_statuses.id = receptions.status_id").
+		LeftJoin("products ON products.reception_id = receptions.id").
+		LeftJoin("product_types ON product_types.id = products.type_id").
+		OrderBy("receptions.date_time", "receptions.id", "products.date_time", "products.id")
+
+	if startDate != nil {
+		qb = qb.Where(sq.GtOrEq{"receptions.date_time": *startDate})
+	}
+	if endDate != nil {
+		qb = qb.Where(sq.LtOrEq{"receptions.date_time": *endDate})
+	}
+
+	return ForEachRow(ctx, r.db, qb, pgx.RowToStructByName[schema.ReceptionExportRow], func(row schema.ReceptionExportRow) error {
+		return fn(schema.NewDomainReceptionExportRow(row))
+	})
+}
+
 func (r *ReceptionRepository) FindByStatus(ctx context.Context, statusName domain.ReceptionStatusCode, filter domain.Reception) (*domain.Reception, error) {
+	return r.findLastWithStatus(ctx, statusName, filter)
+}
+
+// FindOpen возвращает открытую приёмку: в работе или переоткрытую.
+func (r *ReceptionRepository) FindOpen(ctx context.Context, filter domain.Reception) (*domain.Reception, error) {
+	return r.findLastWithStatus(ctx, domain.OpenReceptionStatuses, filter)
+}
+
+// GetWithStatus возвращает приёмку вместе с её статусом.
+func (r *ReceptionRepository) GetWithStatus(ctx context.Context, receptionID uuid.UUID) (*domain.Reception, error) {
+	qb := r.sqb.
+		Select(schema.ReceptionWithStatus{}.Columns()...).
+		From(schema.Reception{}.TableName()).
+		Join("reception_statuses ON reception_statuses.id = receptions.status_id").
+		Where(sq.Eq{"receptions.id": receptionID})
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.ReceptionWithStatus])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainReceptionWithStatus(result), nil
+}
+
+// findLastWithStatus statuses может быть одним статусом или срезом статусов.
+func (r *ReceptionRepository) findLastWithStatus(ctx context.Context, statuses any, filter domain.Reception) (*domain.Reception, error) {
 	where := ToWhereMap(filter)
 
-	where["reception_statuses.name"] = statusName
+	where["reception_statuses.name"] = statuses
 
 	qb := r.sqb.
 		Select(schema.ReceptionWithStatus{}.Columns()...).
@@ -136,6 +219,9 @@ func (r *ReceptionRepository) Update(ctx context.Context, receptionID uuid.UUID,
 	if update.StatusID != uuid.Nil {
 		clauses[schema.ReceptionCols.StatusID] = update.StatusID
 	}
+	if update.ClosedBy != uuid.Nil {
+		clauses[schema.ReceptionCols.ClosedBy] = update.ClosedBy
+	}
 
 	qb = qb.SetMap(clauses)
 
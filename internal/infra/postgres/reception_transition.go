@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres/schema"
+)
+
+type ReceptionTransitionRepository struct {
+	db  DBTX
+	sqb sq.StatementBuilderType
+}
+
+func NewReceptionTransitionRepository(db DBTX) *ReceptionTransitionRepository {
+	return &ReceptionTransitionRepository{
+		db:  db,
+		sqb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *ReceptionTransitionRepository) Create(ctx context.Context, transition domain.ReceptionTransition) (*domain.ReceptionTransition, error) {
+	if transition.ID == uuid.Nil {
+		transition.ID = uuid.New()
+	}
+	if transition.CreatedAt.IsZero() {
+		transition.CreatedAt = time.Now()
+	}
+
+	record := schema.NewReceptionTransition(&transition)
+
+	qb := r.sqb.
+		Insert(record.TableName()).
+		Columns(record.InsertColumns()...).
+		Values(record.Values()...).
+		Suffix("RETURNING " + strings.Join(record.Columns(), ", "))
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.ReceptionTransition])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainReceptionTransition(result), nil
+}
+
+// ListByReception возвращает историю статусов приёмки в хронологическом порядке.
+func (r *ReceptionTransitionRepository) ListByReception(ctx context.Context, receptionID uuid.UUID) ([]*domain.ReceptionTransition, error) {
+	qb := r.sqb.
+		Select(schema.ReceptionTransition{}.Columns()...).
+		From(schema.ReceptionTransition{}.TableName()).
+		Where(sq.Eq{schema.ReceptionTransitionCols.ReceptionID: receptionID}).
+		OrderBy(schema.ReceptionTransitionCols.CreatedAt, schema.ReceptionTransitionCols.ID)
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.ReceptionTransition])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainReceptionTransitionList(results), nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres/schema"
+)
+
+type RefreshTokenRepository struct {
+	db  DBTX
+	sqb sq.StatementBuilderType
+}
+
+func NewRefreshTokenRepository(db DBTX) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		db:  db,
+		sqb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, token domain.RefreshToken) (*domain.RefreshToken, error) {
+	if token.ID == uuid.Nil {
+		token.ID = uuid.New()
+	}
+
+	record := schema.NewRefreshToken(&token)
+
+	qb := r.sqb.
+		Insert(record.TableName()).
+		Columns(record.InsertColumns()...).
+		Values(record.Values()...).
+		Suffix("RETURNING " + strings.Join(record.Columns(), ", "))
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.RefreshToken])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainRefreshToken(result), nil
+}
+
+// GetByHashForUpdate блокирует токен, чтобы один refresh токен нельзя было обменять дважды параллельно
+func (r *RefreshTokenRepository) GetByHashForUpdate(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	qb := r.sqb.
+		Select(schema.RefreshToken{}.Columns()...).
+		From(schema.RefreshToken{}.TableName()).
+		Where(sq.Eq{schema.RefreshTokenCols.TokenHash: tokenHash}).
+		Suffix("FOR UPDATE")
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.RefreshToken])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainRefreshToken(result), nil
+}
+
+func (r *RefreshTokenRepository) Revoke(ctx context.Context, tokenID uuid.UUID) error {
+	qb := r.sqb.
+		Update(schema.RefreshToken{}.TableName()).
+		Set(schema.RefreshTokenCols.RevokedAt, sq.Expr("now()")).
+		Where(sq.Eq{
+			schema.RefreshTokenCols.ID:        tokenID,
+			schema.RefreshTokenCols.RevokedAt: nil,
+		})
+
+	return Exec(ctx, r.db, qb)
+}
+
+func (r *RefreshTokenRepository) RevokeAllByUser(ctx context.Context, userID uuid.UUID) error {
+	qb := r.sqb.
+		Update(schema.RefreshToken{}.TableName()).
+		Set(schema.RefreshTokenCols.RevokedAt, sq.Expr("now()")).
+		Where(sq.Eq{
+			schema.RefreshTokenCols.UserID:    userID,
+			schema.RefreshTokenCols.RevokedAt: nil,
+		})
+
+	return Exec(ctx, r.db, qb)
+}
+
+// DeleteExpired удаляет истёкшие refresh токены, в том числе отозванные: обменять их уже нельзя.
+func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	qb := r.sqb.
+		Delete(schema.RefreshToken{}.TableName()).
+		Where(schema.RefreshTokenCols.ExpiresAt + " < now()")
+
+	sql, args, err := qb.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrBuildQuery, err)
+	}
+
+	tag, err := executor(ctx, r.db).Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrExecuteQuery, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
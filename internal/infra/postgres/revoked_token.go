@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres/schema"
+)
+
+// RevokedTokenRepository - denylist jti отозванных access токенов
+type RevokedTokenRepository struct {
+	db  DBTX
+	sqb sq.StatementBuilderType
+}
+
+func NewRevokedTokenRepository(db DBTX) *RevokedTokenRepository {
+	return &RevokedTokenRepository{
+		db:  db,
+		sqb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *RevokedTokenRepository) Create(ctx context.Context, jti uuid.UUID, expiresAt time.Time) error {
+	record := schema.RevokedToken{JTI: jti, ExpiresAt: expiresAt}
+
+	qb := r.sqb.
+		Insert(record.TableName()).
+		Columns(record.InsertColumns()...).
+		Values(record.Values()...).
+		Suffix("ON CONFLICT (jti) DO NOTHING")
+
+	return Exec(ctx, r.db, qb)
+}
+
+func (r *RevokedTokenRepository) Exists(ctx context.Context, jti uuid.UUID) (bool, error) {
+	qb := r.sqb.
+		Select("1").
+		Prefix("SELECT EXISTS (").
+		From(schema.RevokedToken{}.TableName()).
+		Where(sq.Eq{schema.RevokedTokenCols.JTI: jti}).
+		Suffix(")")
+
+	sql, args, err := qb.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrBuildQuery, err)
+	}
+
+	var exists bool
+	if err := executor(ctx, r.db).QueryRow(ctx, sql, args...).Scan(&exists); err != nil {
+		return false, fmt.Errorf("%w: %w", ErrExecuteQuery, err)
+	}
+
+	return exists, nil
+}
+
+// DeleteExpired удаляет записи истёкших токенов: такие токены отклоняются и без denylist.
+func (r *RevokedTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	qb := r.sqb.
+		Delete(schema.RevokedToken{}.TableName()).
+		Where(schema.RevokedTokenCols.ExpiresAt + " < now()")
+
+	sql, args, err := qb.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrBuildQuery, err)
+	}
+
+	tag, err := executor(ctx, r.db).Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrExecuteQuery, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,134 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type AuditEvent struct {
+	ID          uuid.UUID       `db:"audit_events.id"`
+	ActorID     uuid.NullUUID   `db:"audit_events.actor_id"`
+	Action      string          `db:"audit_events.action"`
+	EntityID    uuid.UUID       `db:"audit_events.entity_id"`
+	PvzID       uuid.NullUUID   `db:"audit_events.pvz_id"`
+	RequestID   *string         `db:"audit_events.request_id"`
+	BeforeState json.RawMessage `db:"audit_events.before_state"`
+	AfterState  json.RawMessage `db:"audit_events.after_state"`
+	CreatedAt   time.Time       `db:"audit_events.created_at"`
+}
+
+func NewAuditEvent(d *domain.AuditEvent) (*AuditEvent, error) {
+	before, err := marshalState(d.Before)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := marshalState(d.After)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestID *string
+	if d.RequestID != "" {
+		requestID = &d.RequestID
+	}
+
+	return &AuditEvent{
+		ID:          d.ID,
+		ActorID:     NewNullUUID(d.ActorID),
+		Action:      string(d.Action),
+		EntityID:    d.EntityID,
+		PvzID:       NewNullUUID(d.PvzID),
+		RequestID:   requestID,
+		BeforeState: before,
+		AfterState:  after,
+		CreatedAt:   d.CreatedAt,
+	}, nil
+}
+
+func NewDomainAuditEvent(d AuditEvent) *domain.AuditEvent {
+	var requestID string
+	if d.RequestID != nil {
+		requestID = *d.RequestID
+	}
+
+	res := &domain.AuditEvent{
+		ID:        d.ID,
+		ActorID:   d.ActorID.UUID,
+		Action:    domain.AuditAction(d.Action),
+		EntityID:  d.EntityID,
+		PvzID:     d.PvzID.UUID,
+		RequestID: requestID,
+		CreatedAt: d.CreatedAt,
+	}
+
+	// nil интерфейс вместо пустого RawMessage, чтобы в ответе был null
+	if d.BeforeState != nil {
+		res.Before = d.BeforeState
+	}
+	if d.AfterState != nil {
+		res.After = d.AfterState
+	}
+
+	return res
+}
+
+func NewDomainAuditEventList(d []AuditEvent) []*domain.AuditEvent {
+	var res = make([]*domain.AuditEvent, 0, len(d))
+	for _, record := range d {
+		res = append(res, NewDomainAuditEvent(record))
+	}
+	return res
+}
+
+func marshalState(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+func (AuditEvent) TableName() string {
+	return "audit_events"
+}
+
+func (p AuditEvent) InsertColumns() []string {
+	return []string{"id", "actor_id", "action", "entity_id", "pvz_id", "request_id", "before_state", "after_state", "created_at"}
+}
+
+func (p AuditEvent) Columns() []string {
+	return []string{
+		"audit_events.id as \"audit_events.id\"",
+		"audit_events.actor_id as \"audit_events.actor_id\"",
+		"audit_events.action as \"audit_events.action\"",
+		"audit_events.entity_id as \"audit_events.entity_id\"",
+		"audit_events.pvz_id as \"audit_events.pvz_id\"",
+		"audit_events.request_id as \"audit_events.request_id\"",
+		"audit_events.before_state as \"audit_events.before_state\"",
+		"audit_events.after_state as \"audit_events.after_state\"",
+		"audit_events.created_at as \"audit_events.created_at\"",
+	}
+}
+
+func (p AuditEvent) Values() []any {
+	return []any{p.ID, p.ActorID, p.Action, p.EntityID, p.PvzID, p.RequestID, p.BeforeState, p.AfterState, p.CreatedAt}
+}
+
+var AuditEventCols = struct {
+	ID        string
+	ActorID   string
+	Action    string
+	EntityID  string
+	PvzID     string
+	CreatedAt string
+}{
+	"audit_events.id",
+	"audit_events.actor_id",
+	"audit_events.action",
+	"audit_events.entity_id",
+	"audit_events.pvz_id",
+	"audit_events.created_at",
+}
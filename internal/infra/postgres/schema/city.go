@@ -24,6 +24,14 @@ func NewDomainCities(d *City) *domain.City {
 	}
 }
 
+func NewDomainCitiesList(d []City) []*domain.City {
+	var res = make([]*domain.City, 0, len(d))
+	for _, record := range d {
+		res = append(res, NewDomainCities(&record))
+	}
+	return res
+}
+
 func (City) TableName() string {
 	return "cities"
 }
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type Issuance struct {
+	ID        uuid.UUID     `db:"issuances.id"`
+	ProductID uuid.UUID     `db:"issuances.product_id"`
+	PvzID     uuid.UUID     `db:"issuances.pvz_id"`
+	Kind      string        `db:"issuances.kind"`
+	IssueID   uuid.NullUUID `db:"issuances.issue_id"`
+	Customer  *string       `db:"issuances.customer"`
+	Reason    *string       `db:"issuances.reason"`
+	ActorID   uuid.NullUUID `db:"issuances.actor_id"`
+	CreatedAt time.Time     `db:"issuances.created_at"`
+}
+
+func NewIssuance(d *domain.Issuance) *Issuance {
+	return &Issuance{
+		ID:        d.ID,
+		ProductID: d.ProductID,
+		PvzID:     d.PvzID,
+		Kind:      string(d.Kind),
+		IssueID:   NewNullUUID(d.IssueID),
+		Customer:  NewNullString(d.Customer),
+		Reason:    NewNullString(d.Reason),
+		ActorID:   NewNullUUID(d.ActorID),
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+func NewDomainIssuance(d Issuance) *domain.Issuance {
+	return &domain.Issuance{
+		ID:        d.ID,
+		ProductID: d.ProductID,
+		PvzID:     d.PvzID,
+		Kind:      domain.IssuanceKind(d.Kind),
+		IssueID:   d.IssueID.UUID,
+		Customer:  StringFromNull(d.Customer),
+		Reason:    StringFromNull(d.Reason),
+		ActorID:   d.ActorID.UUID,
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+func NewDomainIssuanceList(d []Issuance) []*domain.Issuance {
+	var res = make([]*domain.Issuance, 0, len(d))
+	for _, record := range d {
+		res = append(res, NewDomainIssuance(record))
+	}
+	return res
+}
+
+func (Issuance) TableName() string {
+	return "issuances"
+}
+
+func (p Issuance) InsertColumns() []string {
+	return []string{"id", "product_id", "pvz_id", "kind", "issue_id", "customer", "reason", "actor_id", "created_at"}
+}
+
+func (p Issuance) Columns() []string {
+	return []string{
+		"issuances.id as \"issuances.id\"",
+		"issuances.product_id as \"issuances.product_id\"",
+		"issuances.pvz_id as \"issuances.pvz_id\"",
+		"issuances.kind as \"issuances.kind\"",
+		"issuances.issue_id as \"issuances.issue_id\"",
+		"issuances.customer as \"issuances.customer\"",
+		"issuances.reason as \"issuances.reason\"",
+		"issuances.actor_id as \"issuances.actor_id\"",
+		"issuances.created_at as \"issuances.created_at\"",
+	}
+}
+
+func (p Issuance) Values() []any {
+	return []any{p.ID, p.ProductID, p.PvzID, p.Kind, p.IssueID, p.Customer, p.Reason, p.ActorID, p.CreatedAt}
+}
+
+var IssuanceCols = struct {
+	ID        string
+	ProductID string
+	Kind      string
+	CreatedAt string
+}{
+	"issuances.id",
+	"issuances.product_id",
+	"issuances.kind",
+	"issuances.created_at",
+}
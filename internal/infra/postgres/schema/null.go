@@ -0,0 +1,23 @@
+package schema
+
+import "github.com/google/uuid"
+
+// NewNullUUID превращает uuid.Nil в NULL для nullable колонок
+func NewNullUUID(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
+
+// NewNullString превращает пустую строку в NULL для nullable колонок
+func NewNullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func StringFromNull(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type OutboxEvent struct {
+	ID          uuid.UUID       `db:"outbox_events.id"`
+	EventType   string          `db:"outbox_events.event_type"`
+	AggregateID uuid.UUID       `db:"outbox_events.aggregate_id"`
+	PvzID       uuid.NullUUID   `db:"outbox_events.pvz_id"`
+	Payload     json.RawMessage `db:"outbox_events.payload"`
+	CreatedAt   time.Time       `db:"outbox_events.created_at"`
+	PublishedAt *time.Time      `db:"outbox_events.published_at"`
+	Attempts    int             `db:"outbox_events.attempts"`
+	LastError   *string         `db:"outbox_events.last_error"`
+
+	NextAttemptAt time.Time  `db:"outbox_events.next_attempt_at"`
+	FailedAt      *time.Time `db:"outbox_events.failed_at"`
+}
+
+func NewOutboxEvent(d *domain.OutboxEvent) (*OutboxEvent, error) {
+	payload, err := json.Marshal(d.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxEvent{
+		ID:          d.ID,
+		EventType:   string(d.Type),
+		AggregateID: d.AggregateID,
+		PvzID:       NewNullUUID(d.PvzID),
+		Payload:     payload,
+		CreatedAt:   d.CreatedAt,
+	}, nil
+}
+
+func NewDomainOutboxEvent(d OutboxEvent) *domain.OutboxEvent {
+	var lastError string
+	if d.LastError != nil {
+		lastError = *d.LastError
+	}
+
+	return &domain.OutboxEvent{
+		ID:          d.ID,
+		Type:        domain.EventType(d.EventType),
+		AggregateID: d.AggregateID,
+		PvzID:       d.PvzID.UUID,
+		Payload:     d.Payload,
+		CreatedAt:   d.CreatedAt,
+		PublishedAt: d.PublishedAt,
+		Attempts:    d.Attempts,
+		LastError:   lastError,
+
+		NextAttemptAt: d.NextAttemptAt,
+		FailedAt:      d.FailedAt,
+	}
+}
+
+func NewDomainOutboxEventList(d []OutboxEvent) []*domain.OutboxEvent {
+	var res = make([]*domain.OutboxEvent, 0, len(d))
+	for _, record := range d {
+		res = append(res, NewDomainOutboxEvent(record))
+	}
+	return res
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
+
+func (p OutboxEvent) InsertColumns() []string {
+	return []string{"id", "event_type", "aggregate_id", "pvz_id", "payload", "created_at"}
+}
+
+func (p OutboxEvent) Columns() []string {
+	return []string{
+		"outbox_events.id as \"outbox_events.id\"",
+		"outbox_events.event_type as \"outbox_events.event_type\"",
+		"outbox_events.aggregate_id as \"outbox_events.aggregate_id\"",
+		"outbox_events.pvz_id as \"outbox_events.pvz_id\"",
+		"outbox_events.payload as \"outbox_events.payload\"",
+		"outbox_events.created_at as \"outbox_events.created_at\"",
+		"outbox_events.published_at as \"outbox_events.published_at\"",
+		"outbox_events.attempts as \"outbox_events.attempts\"",
+		"outbox_events.last_error as \"outbox_events.last_error\"",
+		"outbox_events.next_attempt_at as \"outbox_events.next_attempt_at\"",
+		"outbox_events.failed_at as \"outbox_events.failed_at\"",
+	}
+}
+
+func (p OutboxEvent) Values() []any {
+	return []any{p.ID, p.EventType, p.AggregateID, p.PvzID, p.Payload, p.CreatedAt}
+}
+
+var OutboxEventCols = struct {
+	ID            string
+	CreatedAt     string
+	PublishedAt   string
+	Attempts      string
+	LastError     string
+	NextAttemptAt string
+	LockedUntil   string
+	FailedAt      string
+}{
+	"id",
+	"created_at",
+	"published_at",
+	"attempts",
+	"last_error",
+	"next_attempt_at",
+	"locked_until",
+	"failed_at",
+}
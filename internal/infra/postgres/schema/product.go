@@ -8,10 +8,13 @@ import (
 )
 
 type Product struct {
-	ID          uuid.UUID `db:"products.id"`
-	DateTime    time.Time `db:"products.date_time"`
-	TypeID      uuid.UUID `db:"products.type_id"`
-	ReceptionID uuid.UUID `db:"products.reception_id"`
+	ID          uuid.UUID     `db:"products.id"`
+	DateTime    time.Time     `db:"products.date_time"`
+	TypeID      uuid.UUID     `db:"products.type_id"`
+	ReceptionID uuid.UUID     `db:"products.reception_id"`
+	CreatedBy   uuid.NullUUID `db:"products.created_by"`
+	Barcode     *string       `db:"products.barcode"`
+	Issued      bool          `db:"products.issued"`
 }
 
 type ProductWithTypeName struct {
@@ -25,6 +28,8 @@ func NewProduct(d *domain.Product) *Product {
 		DateTime:    d.DateTime,
 		TypeID:      d.TypeID,
 		ReceptionID: d.ReceptionID,
+		CreatedBy:   NewNullUUID(d.CreatedBy),
+		Barcode:     NewNullString(d.Barcode),
 	}
 }
 
@@ -34,6 +39,9 @@ func NewDomainProduct(d *Product) *domain.Product {
 		DateTime:    d.DateTime,
 		TypeID:      d.TypeID,
 		ReceptionID: d.ReceptionID,
+		CreatedBy:   d.CreatedBy.UUID,
+		Barcode:     StringFromNull(d.Barcode),
+		Issued:      d.Issued,
 	}
 }
 
@@ -43,6 +51,9 @@ func NewDomainProductWithTypeName(d ProductWithTypeName) *domain.Product {
 		DateTime:    d.DateTime,
 		TypeID:      d.TypeID,
 		ReceptionID: d.ReceptionID,
+		CreatedBy:   d.CreatedBy.UUID,
+		Barcode:     StringFromNull(d.Barcode),
+		Issued:      d.Issued,
 		ProductType: &domain.ProductType{
 			ID:   d.ProductType.ID,
 			Name: d.Name,
@@ -69,16 +80,29 @@ func (Product) TableName() string {
 }
 
 func (p Product) InsertColumns() []string {
-	return []string{"id", "date_time", "type_id", "reception_id"}
+	return []string{"id", "date_time", "type_id", "reception_id", "created_by", "barcode"}
 }
 
 func (p Product) Columns() []string {
 	return []string{"products.id as \"products.id\"", "products.date_time as \"products.date_time\"", "products.type_id as \"products.type_id\"",
-		"products.reception_id as \"products.reception_id\""}
+		"products.reception_id as \"products.reception_id\"", "products.created_by as \"products.created_by\"",
+		"products.barcode as \"products.barcode\"", "products.issued as \"products.issued\""}
 }
 
 func (p Product) Values() []any {
-	return []any{p.ID, p.DateTime, p.TypeID, p.ReceptionID}
+	return []any{p.ID, p.DateTime, p.TypeID, p.ReceptionID, p.CreatedBy, p.Barcode}
+}
+
+// PVZStockCount количество товаров на складе PVZ.
+type PVZStockCount struct {
+	PvzID uuid.UUID `db:"pvz_id"`
+	Count int       `db:"count"`
+}
+
+// ProductTypeCount количество товаров одного типа.
+type ProductTypeCount struct {
+	TypeName string `db:"type_name"`
+	Count    int    `db:"count"`
 }
 
 var ProductCols = struct {
@@ -86,9 +110,15 @@ var ProductCols = struct {
 	DateTime    string
 	TypeID      string
 	ReceptionID string
+	CreatedBy   string
+	Barcode     string
+	Issued      string
 }{
 	"id",
 	"date_time",
 	"type_id",
 	"reception_id",
+	"created_by",
+	"barcode",
+	"issued",
 }
@@ -1,6 +1,9 @@
 package schema
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,9 +11,70 @@ import (
 )
 
 type PVZ struct {
-	ID               uuid.UUID `db:"pvz.id"`
-	CityID           uuid.UUID `db:"pvz.city_id"`
-	RegistrationDate time.Time `db:"pvz.registration_date"`
+	ID                uuid.UUID      `db:"pvz.id"`
+	CityID            uuid.UUID      `db:"pvz.city_id"`
+	RegistrationDate  time.Time      `db:"pvz.registration_date"`
+	Status            string         `db:"pvz.status"`
+	StatusReason      string         `db:"pvz.status_reason"`
+	StatusEffectiveAt *time.Time     `db:"pvz.status_effective_at"`
+	Address           string         `db:"pvz.address"`
+	Latitude          *float64       `db:"pvz.latitude"`
+	Longitude         *float64       `db:"pvz.longitude"`
+	WorkingHours      WorkingHours   `db:"pvz.working_hours"`
+	Capacity          *int           `db:"pvz.capacity"`
+	TypeCapacities    TypeCapacities `db:"pvz.type_capacities"`
+}
+
+// WorkingHours расписание PVZ в jsonb колонке, пустое расписание хранится как NULL.
+type WorkingHours domain.WorkingHours
+
+func (h WorkingHours) Value() (driver.Value, error) {
+	if len(h) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(h)
+}
+
+func (h *WorkingHours) Scan(src any) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*h = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported working hours type %T", src)
+	}
+	return json.Unmarshal(data, (*domain.WorkingHours)(h))
+}
+
+// TypeCapacities лимиты по типам товара в jsonb колонке, без лимитов хранится NULL.
+type TypeCapacities map[string]int
+
+func (c TypeCapacities) Value() (driver.Value, error) {
+	if len(c) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(map[string]int(c))
+}
+
+func (c *TypeCapacities) Scan(src any) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*c = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type capacities type %T", src)
+	}
+	return json.Unmarshal(data, (*map[string]int)(c))
 }
 
 type PVZWithCityName struct {
@@ -19,18 +83,45 @@ type PVZWithCityName struct {
 }
 
 func NewPVZ(d *domain.PVZ) *PVZ {
-	return &PVZ{
-		ID:               d.ID,
-		RegistrationDate: d.RegistrationDate,
-		CityID:           d.CityID,
+	res := &PVZ{
+		ID:                d.ID,
+		RegistrationDate:  d.RegistrationDate,
+		CityID:            d.CityID,
+		Status:            string(d.Status),
+		StatusReason:      d.StatusReason,
+		StatusEffectiveAt: d.StatusEffectiveAt,
+		Address:           d.Address,
+		WorkingHours:      WorkingHours(d.WorkingHours),
+		Capacity:          d.Capacity,
+		TypeCapacities:    TypeCapacities(d.TypeCapacities),
 	}
+	if d.Location != nil {
+		res.Latitude = &d.Location.Lat
+		res.Longitude = &d.Location.Lon
+	}
+	return res
+}
+
+func newDomainGeoPoint(lat, lon *float64) *domain.GeoPoint {
+	if lat == nil || lon == nil {
+		return nil
+	}
+	return &domain.GeoPoint{Lat: *lat, Lon: *lon}
 }
 
 func NewDomainPVZ(d PVZ) *domain.PVZ {
 	return &domain.PVZ{
-		ID:               d.ID,
-		RegistrationDate: d.RegistrationDate,
-		CityID:           d.CityID,
+		ID:                d.ID,
+		RegistrationDate:  d.RegistrationDate,
+		CityID:            d.CityID,
+		Status:            domain.PVZStatus(d.Status),
+		StatusReason:      d.StatusReason,
+		StatusEffectiveAt: d.StatusEffectiveAt,
+		Address:           d.Address,
+		Location:          newDomainGeoPoint(d.Latitude, d.Longitude),
+		WorkingHours:      domain.WorkingHours(d.WorkingHours),
+		Capacity:          d.Capacity,
+		TypeCapacities:    d.TypeCapacities,
 	}
 }
 
@@ -44,9 +135,17 @@ func NewDomainPVZList(d []PVZ) []*domain.PVZ {
 
 func NewDomainPVZWithCityName(d PVZWithCityName) *domain.PVZ {
 	return &domain.PVZ{
-		ID:               d.PVZ.ID,
-		RegistrationDate: d.RegistrationDate,
-		CityID:           d.CityID,
+		ID:                d.PVZ.ID,
+		RegistrationDate:  d.RegistrationDate,
+		CityID:            d.CityID,
+		Status:            domain.PVZStatus(d.Status),
+		StatusReason:      d.StatusReason,
+		StatusEffectiveAt: d.StatusEffectiveAt,
+		Address:           d.Address,
+		Location:          newDomainGeoPoint(d.Latitude, d.Longitude),
+		WorkingHours:      domain.WorkingHours(d.WorkingHours),
+		Capacity:          d.Capacity,
+		TypeCapacities:    d.TypeCapacities,
 		City: &domain.City{
 			ID:   d.City.ID,
 			Name: d.Name,
@@ -62,6 +161,22 @@ func NewDomainPVZWithCityNameList(d []PVZWithCityName) []*domain.PVZ {
 	return res
 }
 
+// PVZWithDistance PVZ с расстоянием до точки поиска в километрах.
+type PVZWithDistance struct {
+	PVZWithCityName
+	Distance float64 `db:"distance"`
+}
+
+func NewDomainPVZWithDistanceList(d []PVZWithDistance) []*domain.PVZ {
+	var res = make([]*domain.PVZ, 0, len(d))
+	for _, record := range d {
+		pvz := NewDomainPVZWithCityName(record.PVZWithCityName)
+		pvz.DistanceKm = record.Distance
+		res = append(res, pvz)
+	}
+	return res
+}
+
 func (p PVZWithCityName) Columns() []string {
 	res := PVZ{}.Columns()
 	res = append(res, City{}.Columns()...)
@@ -73,23 +188,44 @@ func (PVZ) TableName() string {
 }
 
 func (pvz PVZ) InsertColumns() []string {
-	return []string{"id", "city_id", "registration_date"}
+	return []string{"id", "city_id", "registration_date", "address", "latitude", "longitude", "working_hours", "capacity", "type_capacities"}
 }
 
 func (pvz PVZ) Columns() []string {
-	return []string{"pvz.id as \"pvz.id\"", "pvz.city_id as \"pvz.city_id\"", "pvz.registration_date as \"pvz.registration_date\""}
+	return []string{"pvz.id as \"pvz.id\"", "pvz.city_id as \"pvz.city_id\"", "pvz.registration_date as \"pvz.registration_date\"",
+		"pvz.status as \"pvz.status\"", "pvz.status_reason as \"pvz.status_reason\"", "pvz.status_effective_at as \"pvz.status_effective_at\"",
+		"pvz.address as \"pvz.address\"", "pvz.latitude as \"pvz.latitude\"", "pvz.longitude as \"pvz.longitude\"",
+		"pvz.working_hours as \"pvz.working_hours\"", "pvz.capacity as \"pvz.capacity\"", "pvz.type_capacities as \"pvz.type_capacities\""}
 }
 
 func (pvz PVZ) Values() []any {
-	return []any{pvz.ID, pvz.CityID, pvz.RegistrationDate}
+	return []any{pvz.ID, pvz.CityID, pvz.RegistrationDate, pvz.Address, pvz.Latitude, pvz.Longitude, pvz.WorkingHours, pvz.Capacity, pvz.TypeCapacities}
 }
 
 var PVZCols = struct {
-	ID               string
-	RegistrationDate string
-	CityID           string
+	ID                string
+	RegistrationDate  string
+	CityID            string
+	Status            string
+	StatusReason      string
+	StatusEffectiveAt string
+	Address           string
+	Latitude          string
+	Longitude         string
+	WorkingHours      string
+	Capacity          string
+	TypeCapacities    string
 }{
 	"id",
 	"registration_date",
 	"city_id",
+	"status",
+	"status_reason",
+	"status_effective_at",
+	"address",
+	"latitude",
+	"longitude",
+	"working_hours",
+	"capacity",
+	"type_capacities",
 }
@@ -8,10 +8,12 @@ import (
 )
 
 type Reception struct {
-	ID       uuid.UUID `db:"receptions.id"`
-	DateTime time.Time `db:"receptions.date_time"`
-	PvzID    uuid.UUID `db:"receptions.pvz_id"`
-	StatusID uuid.UUID `db:"receptions.status_id"`
+	ID        uuid.UUID     `db:"receptions.id"`
+	DateTime  time.Time     `db:"receptions.date_time"`
+	PvzID     uuid.UUID     `db:"receptions.pvz_id"`
+	StatusID  uuid.UUID     `db:"receptions.status_id"`
+	CreatedBy uuid.NullUUID `db:"receptions.created_by"`
+	ClosedBy  uuid.NullUUID `db:"receptions.closed_by"`
 }
 
 type ReceptionWithStatus struct {
@@ -21,19 +23,23 @@ type ReceptionWithStatus struct {
 
 func NewReception(d *domain.Reception) *Reception {
 	return &Reception{
-		ID:       d.ID,
-		DateTime: d.DateTime,
-		PvzID:    d.PvzID,
-		StatusID: d.StatusID,
+		ID:        d.ID,
+		DateTime:  d.DateTime,
+		PvzID:     d.PvzID,
+		StatusID:  d.StatusID,
+		CreatedBy: NewNullUUID(d.CreatedBy),
+		ClosedBy:  NewNullUUID(d.ClosedBy),
 	}
 }
 
 func NewDomainReception(d Reception) *domain.Reception {
 	return &domain.Reception{
-		ID:       d.ID,
-		DateTime: d.DateTime,
-		PvzID:    d.PvzID,
-		StatusID: d.StatusID,
+		ID:        d.ID,
+		DateTime:  d.DateTime,
+		PvzID:     d.PvzID,
+		StatusID:  d.StatusID,
+		CreatedBy: d.CreatedBy.UUID,
+		ClosedBy:  d.ClosedBy.UUID,
 	}
 }
 
@@ -47,10 +53,12 @@ func NewDomainReceptionList(d []Reception) []*domain.Reception {
 
 func NewDomainReceptionWithStatus(d ReceptionWithStatus) *domain.Reception {
 	return &domain.Reception{
-		ID:       d.Reception.ID,
-		PvzID:    d.PvzID,
-		DateTime: d.DateTime,
-		StatusID: d.StatusID,
+		ID:        d.Reception.ID,
+		PvzID:     d.PvzID,
+		DateTime:  d.DateTime,
+		StatusID:  d.StatusID,
+		CreatedBy: d.CreatedBy.UUID,
+		ClosedBy:  d.ClosedBy.UUID,
 		ReceptionStatus: &domain.ReceptionStatus{
 			ID:   d.ReceptionStatus.ID,
 			Name: domain.ReceptionStatusCode(d.Name),
@@ -77,26 +85,31 @@ func (Reception) TableName() string {
 }
 
 func (p Reception) InsertColumns() []string {
-	return []string{"id", "pvz_id", "status_id", "date_time"}
+	return []string{"id", "pvz_id", "status_id", "date_time", "created_by", "closed_by"}
 }
 
 func (p Reception) Columns() []string {
 	return []string{"receptions.id as \"receptions.id\"", "receptions.pvz_id as \"receptions.pvz_id\"",
-		"receptions.status_id as \"receptions.status_id\"", "receptions.date_time as \"receptions.date_time\""}
+		"receptions.status_id as \"receptions.status_id\"", "receptions.date_time as \"receptions.date_time\"",
+		"receptions.created_by as \"receptions.created_by\"", "receptions.closed_by as \"receptions.closed_by\""}
 }
 
 func (p Reception) Values() []any {
-	return []any{p.ID, p.PvzID, p.StatusID, p.DateTime}
+	return []any{p.ID, p.PvzID, p.StatusID, p.DateTime, p.CreatedBy, p.ClosedBy}
 }
 
 var ReceptionCols = struct {
-	ID       string
-	DateTime string
-	PvzID    string
-	StatusID string
+	ID        string
+	DateTime  string
+	PvzID     string
+	StatusID  string
+	CreatedBy string
+	ClosedBy  string
 }{
 	"id",
 	"date_time",
 	"pvz_id",
 	"status_id",
+	"created_by",
+	"closed_by",
 }
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+// ReceptionExportRow строка выгрузки: приёмка с PVZ, городом и статусом, LEFT JOIN товара.
+type ReceptionExportRow struct {
+	ReceptionID       uuid.UUID     `db:"receptions.id"`
+	PvzID             uuid.UUID     `db:"receptions.pvz_id"`
+	City              string        `db:"cities.name"`
+	ReceptionDateTime time.Time     `db:"receptions.date_time"`
+	Status            string        `db:"reception_statuses.name"`
+	ProductID         uuid.NullUUID `db:"products.id"`
+	ProductDateTime   *time.Time    `db:"products.date_time"`
+	ProductType       *string       `db:"product_types.name"`
+	Barcode           *string       `db:"products.barcode"`
+	Issued            *bool         `db:"products.issued"`
+}
+
+func (ReceptionExportRow) Columns() []string {
+	return []string{
+		"receptions.id as \"receptions.id\"", "receptions.pvz_id as \"receptions.pvz_id\"",
+		"cities.name as \"cities.name\"", "receptions.date_time as \"receptions.date_time\"",
+		"reception_statuses.name as \"reception_statuses.name\"", "products.id as \"products.id\"",
+		"products.date_time as \"products.date_time\"", "product_types.name as \"product_types.name\"",
+		"products.barcode as \"products.barcode\"", "products.issued as \"products.issued\"",
+	}
+}
+
+func NewDomainReceptionExportRow(d ReceptionExportRow) domain.ReceptionExportRow {
+	row := domain.ReceptionExportRow{
+		ReceptionID:       d.ReceptionID,
+		PvzID:             d.PvzID,
+		City:              d.City,
+		ReceptionDateTime: d.ReceptionDateTime,
+		Status:            domain.ReceptionStatusCode(d.Status),
+		ProductID:         d.ProductID.UUID,
+		ProductType:       StringFromNull(d.ProductType),
+		Barcode:           StringFromNull(d.Barcode),
+	}
+	if d.ProductDateTime != nil {
+		row.ProductDateTime = *d.ProductDateTime
+	}
+	if d.Issued != nil {
+		row.Issued = *d.Issued
+	}
+
+	return row
+}
@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type ReceptionTransition struct {
+	ID          uuid.UUID     `db:"reception_transitions.id"`
+	ReceptionID uuid.UUID     `db:"reception_transitions.reception_id"`
+	FromStatus  *string       `db:"reception_transitions.from_status"`
+	ToStatus    string        `db:"reception_transitions.to_status"`
+	ActorID     uuid.NullUUID `db:"reception_transitions.actor_id"`
+	CreatedAt   time.Time     `db:"reception_transitions.created_at"`
+}
+
+func NewReceptionTransition(d *domain.ReceptionTransition) *ReceptionTransition {
+	var fromStatus *string
+	if d.FromStatus != "" {
+		s := string(d.FromStatus)
+		fromStatus = &s
+	}
+
+	return &ReceptionTransition{
+		ID:          d.ID,
+		ReceptionID: d.ReceptionID,
+		FromStatus:  fromStatus,
+		ToStatus:    string(d.ToStatus),
+		ActorID:     NewNullUUID(d.ActorID),
+		CreatedAt:   d.CreatedAt,
+	}
+}
+
+func NewDomainReceptionTransition(d ReceptionTransition) *domain.ReceptionTransition {
+	var fromStatus domain.ReceptionStatusCode
+	if d.FromStatus != nil {
+		fromStatus = domain.ReceptionStatusCode(*d.FromStatus)
+	}
+
+	return &domain.ReceptionTransition{
+		ID:          d.ID,
+		ReceptionID: d.ReceptionID,
+		FromStatus:  fromStatus,
+		ToStatus:    domain.ReceptionStatusCode(d.ToStatus),
+		ActorID:     d.ActorID.UUID,
+		CreatedAt:   d.CreatedAt,
+	}
+}
+
+func NewDomainReceptionTransitionList(d []ReceptionTransition) []*domain.ReceptionTransition {
+	var res = make([]*domain.ReceptionTransition, 0, len(d))
+	for _, record := range d {
+		res = append(res, NewDomainReceptionTransition(record))
+	}
+	return res
+}
+
+func (ReceptionTransition) TableName() string {
+	return "reception_transitions"
+}
+
+func (p ReceptionTransition) InsertColumns() []string {
+	return []string{"id", "reception_id", "from_status", "to_status", "actor_id", "created_at"}
+}
+
+func (p ReceptionTransition) Columns() []string {
+	return []string{
+		"reception_transitions.id as \"reception_transitions.id\"",
+		"reception_transitions.reception_id as \"reception_transitions.reception_id\"",
+		"reception_transitions.from_status as \"reception_transitions.from_status\"",
+		"reception_transitions.to_status as \"reception_transitions.to_status\"",
+		"reception_transitions.actor_id as \"reception_transitions.actor_id\"",
+		"reception_transitions.created_at as \"reception_transitions.created_at\"",
+	}
+}
+
+func (p ReceptionTransition) Values() []any {
+	return []any{p.ID, p.ReceptionID, p.FromStatus, p.ToStatus, p.ActorID, p.CreatedAt}
+}
+
+var ReceptionTransitionCols = struct {
+	ID          string
+	ReceptionID string
+	CreatedAt   string
+}{
+	"reception_transitions.id",
+	"reception_transitions.reception_id",
+	"reception_transitions.created_at",
+}
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type RefreshToken struct {
+	ID        uuid.UUID  `db:"refresh_tokens.id"`
+	UserID    uuid.UUID  `db:"refresh_tokens.user_id"`
+	TokenHash string     `db:"refresh_tokens.token_hash"`
+	ExpiresAt time.Time  `db:"refresh_tokens.expires_at"`
+	RevokedAt *time.Time `db:"refresh_tokens.revoked_at"`
+	CreatedAt time.Time  `db:"refresh_tokens.created_at"`
+}
+
+func NewRefreshToken(d *domain.RefreshToken) *RefreshToken {
+	return &RefreshToken{
+		ID:        d.ID,
+		UserID:    d.UserID,
+		TokenHash: d.TokenHash,
+		ExpiresAt: d.ExpiresAt,
+		RevokedAt: d.RevokedAt,
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+func NewDomainRefreshToken(d RefreshToken) *domain.RefreshToken {
+	return &domain.RefreshToken{
+		ID:        d.ID,
+		UserID:    d.UserID,
+		TokenHash: d.TokenHash,
+		ExpiresAt: d.ExpiresAt,
+		RevokedAt: d.RevokedAt,
+		CreatedAt: d.CreatedAt,
+	}
+}
+
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+func (t RefreshToken) InsertColumns() []string {
+	return []string{"id", "user_id", "token_hash", "expires_at"}
+}
+
+func (t RefreshToken) Columns() []string {
+	return []string{"refresh_tokens.id as \"refresh_tokens.id\"", "refresh_tokens.user_id as \"refresh_tokens.user_id\"",
+		"refresh_tokens.token_hash as \"refresh_tokens.token_hash\"", "refresh_tokens.expires_at as \"refresh_tokens.expires_at\"",
+		"refresh_tokens.revoked_at as \"refresh_tokens.revoked_at\"", "refresh_tokens.created_at as \"refresh_tokens.created_at\""}
+}
+
+func (t RefreshToken) Values() []any {
+	return []any{t.ID, t.UserID, t.TokenHash, t.ExpiresAt}
+}
+
+var RefreshTokenCols = struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt string
+	RevokedAt string
+	CreatedAt string
+}{
+	"id",
+	"user_id",
+	"token_hash",
+	"expires_at",
+	"revoked_at",
+	"created_at",
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RevokedToken struct {
+	JTI       uuid.UUID `db:"revoked_tokens.jti"`
+	ExpiresAt time.Time `db:"revoked_tokens.expires_at"`
+}
+
+func (RevokedToken) TableName() string {
+	return "revoked_tokens"
+}
+
+func (t RevokedToken) InsertColumns() []string {
+	return []string{"jti", "expires_at"}
+}
+
+func (t RevokedToken) Values() []any {
+	return []any{t.JTI, t.ExpiresAt}
+}
+
+var RevokedTokenCols = struct {
+	JTI       string
+	ExpiresAt string
+}{
+	"jti",
+	"expires_at",
+}
@@ -0,0 +1,237 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type WebhookSubscription struct {
+	ID         uuid.UUID     `db:"webhook_subscriptions.id"`
+	URL        string        `db:"webhook_subscriptions.url"`
+	Secret     string        `db:"webhook_subscriptions.secret"`
+	EventTypes []string      `db:"webhook_subscriptions.event_types"`
+	CreatedBy  uuid.NullUUID `db:"webhook_subscriptions.created_by"`
+	CreatedAt  time.Time     `db:"webhook_subscriptions.created_at"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID       `db:"webhook_deliveries.id"`
+	SubscriptionID uuid.UUID       `db:"webhook_deliveries.subscription_id"`
+	EventID        uuid.UUID       `db:"webhook_deliveries.event_id"`
+	EventType      string          `db:"webhook_deliveries.event_type"`
+	AggregateID    uuid.UUID       `db:"webhook_deliveries.aggregate_id"`
+	PvzID          uuid.NullUUID   `db:"webhook_deliveries.pvz_id"`
+	Payload        json.RawMessage `db:"webhook_deliveries.payload"`
+	OccurredAt     time.Time       `db:"webhook_deliveries.occurred_at"`
+	Status         string          `db:"webhook_deliveries.status"`
+	Attempts       int             `db:"webhook_deliveries.attempts"`
+	NextAttemptAt  time.Time       `db:"webhook_deliveries.next_attempt_at"`
+	LastStatusCode *int            `db:"webhook_deliveries.last_status_code"`
+	LastError      *string         `db:"webhook_deliveries.last_error"`
+	CreatedAt      time.Time       `db:"webhook_deliveries.created_at"`
+	DeliveredAt    *time.Time      `db:"webhook_deliveries.delivered_at"`
+}
+
+type WebhookDeliveryWithSubscription struct {
+	WebhookDelivery
+	WebhookSubscription
+}
+
+func NewWebhookSubscription(d *domain.WebhookSubscription) *WebhookSubscription {
+	eventTypes := make([]string, 0, len(d.EventTypes))
+	for _, t := range d.EventTypes {
+		eventTypes = append(eventTypes, string(t))
+	}
+
+	return &WebhookSubscription{
+		ID:         d.ID,
+		URL:        d.URL,
+		Secret:     d.Secret,
+		EventTypes: eventTypes,
+		CreatedBy:  NewNullUUID(d.CreatedBy),
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func NewDomainWebhookSubscription(d WebhookSubscription) *domain.WebhookSubscription {
+	eventTypes := make([]domain.EventType, 0, len(d.EventTypes))
+	for _, t := range d.EventTypes {
+		eventTypes = append(eventTypes, domain.EventType(t))
+	}
+
+	return &domain.WebhookSubscription{
+		ID:         d.ID,
+		URL:        d.URL,
+		Secret:     d.Secret,
+		EventTypes: eventTypes,
+		CreatedBy:  d.CreatedBy.UUID,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func NewDomainWebhookSubscriptionList(d []WebhookSubscription) []*domain.WebhookSubscription {
+	var res = make([]*domain.WebhookSubscription, 0, len(d))
+	for _, record := range d {
+		res = append(res, NewDomainWebhookSubscription(record))
+	}
+	return res
+}
+
+func NewWebhookDelivery(d *domain.WebhookDelivery) (*WebhookDelivery, error) {
+	payload, err := json.Marshal(d.Event.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.Event.ID,
+		EventType:      string(d.Event.Type),
+		AggregateID:    d.Event.AggregateID,
+		PvzID:          NewNullUUID(d.Event.PvzID),
+		Payload:        payload,
+		OccurredAt:     d.Event.CreatedAt,
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		CreatedAt:      d.CreatedAt,
+	}, nil
+}
+
+func NewDomainWebhookDelivery(d WebhookDelivery) *domain.WebhookDelivery {
+	res := &domain.WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		Event: domain.OutboxEvent{
+			ID:          d.EventID,
+			Type:        domain.EventType(d.EventType),
+			AggregateID: d.AggregateID,
+			PvzID:       d.PvzID.UUID,
+			Payload:     d.Payload,
+			CreatedAt:   d.OccurredAt,
+		},
+		Status:        domain.WebhookDeliveryStatus(d.Status),
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt,
+		CreatedAt:     d.CreatedAt,
+		DeliveredAt:   d.DeliveredAt,
+	}
+	if d.LastStatusCode != nil {
+		res.LastStatusCode = *d.LastStatusCode
+	}
+	if d.LastError != nil {
+		res.LastError = *d.LastError
+	}
+	return res
+}
+
+func NewDomainWebhookDeliveryList(d []WebhookDelivery) []*domain.WebhookDelivery {
+	var res = make([]*domain.WebhookDelivery, 0, len(d))
+	for _, record := range d {
+		res = append(res, NewDomainWebhookDelivery(record))
+	}
+	return res
+}
+
+func NewDomainWebhookDeliveryWithSubscription(d WebhookDeliveryWithSubscription) *domain.WebhookDelivery {
+	res := NewDomainWebhookDelivery(d.WebhookDelivery)
+	res.Subscription = NewDomainWebhookSubscription(d.WebhookSubscription)
+	return res
+}
+
+func NewDomainWebhookDeliveryWithSubscriptionList(d []WebhookDeliveryWithSubscription) []*domain.WebhookDelivery {
+	var res = make([]*domain.WebhookDelivery, 0, len(d))
+	for _, record := range d {
+		res = append(res, NewDomainWebhookDeliveryWithSubscription(record))
+	}
+	return res
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+func (s WebhookSubscription) InsertColumns() []string {
+	return []string{"id", "url", "secret", "event_types", "created_by", "created_at"}
+}
+
+func (s WebhookSubscription) Columns() []string {
+	return []string{
+		"webhook_subscriptions.id as \"webhook_subscriptions.id\"",
+		"webhook_subscriptions.url as \"webhook_subscriptions.url\"",
+		"webhook_subscriptions.secret as \"webhook_subscriptions.secret\"",
+		"webhook_subscriptions.event_types as \"webhook_subscriptions.event_types\"",
+		"webhook_subscriptions.created_by as \"webhook_subscriptions.created_by\"",
+		"webhook_subscriptions.created_at as \"webhook_subscriptions.created_at\"",
+	}
+}
+
+func (s WebhookSubscription) Values() []any {
+	return []any{s.ID, s.URL, s.Secret, s.EventTypes, s.CreatedBy, s.CreatedAt}
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (d WebhookDelivery) InsertColumns() []string {
+	return []string{"id", "subscription_id", "event_id", "event_type", "aggregate_id", "pvz_id", "payload", "occurred_at", "status", "attempts", "next_attempt_at", "created_at"}
+}
+
+func (d WebhookDelivery) Columns() []string {
+	return []string{
+		"webhook_deliveries.id as \"webhook_deliveries.id\"",
+		"webhook_deliveries.subscription_id as \"webhook_deliveries.subscription_id\"",
+		"webhook_deliveries.event_id as \"webhook_deliveries.event_id\"",
+		"webhook_deliveries.event_type as \"webhook_deliveries.event_type\"",
+		"webhook_deliveries.aggregate_id as \"webhook_deliveries.aggregate_id\"",
+		"webhook_deliveries.pvz_id as \"webhook_deliveries.pvz_id\"",
+		"webhook_deliveries.payload as \"webhook_deliveries.payload\"",
+		"webhook_deliveries.occurred_at as \"webhook_deliveries.occurred_at\"",
+		"webhook_deliveries.status as \"webhook_deliveries.status\"",
+		"webhook_deliveries.attempts as \"webhook_deliveries.attempts\"",
+		"webhook_deliveries.next_attempt_at as \"webhook_deliveries.next_attempt_at\"",
+		"webhook_deliveries.last_status_code as \"webhook_deliveries.last_status_code\"",
+		"webhook_deliveries.last_error as \"webhook_deliveries.last_error\"",
+		"webhook_deliveries.created_at as \"webhook_deliveries.created_at\"",
+		"webhook_deliveries.delivered_at as \"webhook_deliveries.delivered_at\"",
+	}
+}
+
+func (d WebhookDelivery) Values() []any {
+	return []any{d.ID, d.SubscriptionID, d.EventID, d.EventType, d.AggregateID, d.PvzID, d.Payload, d.OccurredAt, d.Status, d.Attempts, d.NextAttemptAt, d.CreatedAt}
+}
+
+func (d WebhookDeliveryWithSubscription) Columns() []string {
+	res := WebhookDelivery{}.Columns()
+	res = append(res, WebhookSubscription{}.Columns()...)
+	return res
+}
+
+var WebhookSubscriptionCols = struct {
+	ID         string
+	EventTypes string
+	CreatedAt  string
+}{
+	"webhook_subscriptions.id",
+	"webhook_subscriptions.event_types",
+	"webhook_subscriptions.created_at",
+}
+
+var WebhookDeliveryCols = struct {
+	ID             string
+	SubscriptionID string
+	Status         string
+	NextAttemptAt  string
+	CreatedAt      string
+}{
+	"webhook_deliveries.id",
+	"webhook_deliveries.subscription_id",
+	"webhook_deliveries.status",
+	"webhook_deliveries.next_attempt_at",
+	"webhook_deliveries.created_at",
+}
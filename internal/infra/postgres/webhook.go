@@ -0,0 +1,247 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres/schema"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+)
+
+type WebhookSubscriptionRepository struct {
+	db  DBTX
+	sqb sq.StatementBuilderType
+}
+
+func NewWebhookSubscriptionRepository(db DBTX) *WebhookSubscriptionRepository {
+	return &WebhookSubscriptionRepository{
+		db:  db,
+		sqb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	if subscription.ID == uuid.Nil {
+		subscription.ID = uuid.New()
+	}
+	if subscription.CreatedAt.IsZero() {
+		subscription.CreatedAt = time.Now()
+	}
+
+	record := schema.NewWebhookSubscription(&subscription)
+
+	qb := r.sqb.
+		Insert(record.TableName()).
+		Columns(record.InsertColumns()...).
+		Values(record.Values()...).
+		Suffix("RETURNING " + strings.Join(record.Columns(), ", "))
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.WebhookSubscription])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainWebhookSubscription(result), nil
+}
+
+func (r *WebhookSubscriptionRepository) Get(ctx context.Context, subscriptionID uuid.UUID) (*domain.WebhookSubscription, error) {
+	qb := r.sqb.
+		Select(schema.WebhookSubscription{}.Columns()...).
+		From(schema.WebhookSubscription{}.TableName()).
+		Where(sq.Eq{schema.WebhookSubscriptionCols.ID: subscriptionID})
+
+	result, err := CollectOneRow(ctx, r.db, qb, pgx.RowToStructByName[schema.WebhookSubscription])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainWebhookSubscription(result), nil
+}
+
+func (r *WebhookSubscriptionRepository) List(ctx context.Context, pagination *listparams.Pagination) ([]*domain.WebhookSubscription, error) {
+	qb := r.sqb.
+		Select(schema.WebhookSubscription{}.Columns()...).
+		From(schema.WebhookSubscription{}.TableName()).
+		OrderBy(schema.WebhookSubscriptionCols.CreatedAt+" DESC", schema.WebhookSubscriptionCols.ID)
+
+	if pagination != nil {
+		qb = qb.Limit(uint64(pagination.Limit)).
+			Offset(uint64(pagination.Offset()))
+	}
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.WebhookSubscription])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainWebhookSubscriptionList(results), nil
+}
+
+// ListByEventType возвращает подписки, в которых есть указанный тип события.
+func (r *WebhookSubscriptionRepository) ListByEventType(ctx context.Context, eventType domain.EventType) ([]*domain.WebhookSubscription, error) {
+	qb := r.sqb.
+		Select(schema.WebhookSubscription{}.Columns()...).
+		From(schema.WebhookSubscription{}.TableName()).
+		Where(sq.Expr("? = ANY("+schema.WebhookSubscriptionCols.EventTypes+")", string(eventType)))
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.WebhookSubscription])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainWebhookSubscriptionList(results), nil
+}
+
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, subscriptionID uuid.UUID) error {
+	qb := r.sqb.
+		Delete(schema.WebhookSubscription{}.TableName()).
+		Where(sq.Eq{"id": subscriptionID})
+
+	sql, args, err := qb.ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrBuildQuery, err)
+	}
+
+	tag, err := executor(ctx, r.db).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrExecuteQuery, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return infra.ErrNotFound
+	}
+
+	return nil
+}
+
+type WebhookDeliveryRepository struct {
+	db  DBTX
+	sqb sq.StatementBuilderType
+}
+
+func NewWebhookDeliveryRepository(db DBTX) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		db:  db,
+		sqb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+// Create ставит доставку в очередь. Повторная постановка того же события
+// той же подписке игнорируется, поэтому повторная публикация из outbox безопасна.
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, delivery domain.WebhookDelivery) error {
+	if delivery.ID == uuid.Nil {
+		delivery.ID = uuid.New()
+	}
+	if delivery.CreatedAt.IsZero() {
+		delivery.CreatedAt = time.Now()
+	}
+	if delivery.NextAttemptAt.IsZero() {
+		delivery.NextAttemptAt = delivery.CreatedAt
+	}
+	if delivery.Status == "" {
+		delivery.Status = domain.WebhookDeliveryPending
+	}
+
+	record, err := schema.NewWebhookDelivery(&delivery)
+	if err != nil {
+		return fmt.Errorf("%w: marshal event payload: %w", ErrBuildQuery, err)
+	}
+
+	qb := r.sqb.
+		Insert(record.TableName()).
+		Columns(record.InsertColumns()...).
+		Values(record.Values()...).
+		Suffix("ON CONFLICT (subscription_id, event_id) DO NOTHING")
+
+	return Exec(ctx, r.db, qb)
+}
+
+// ClaimDue выбирает доставки, время попытки которых наступило, вместе с подпиской
+// и сдаёт их в аренду до now+lease. Отправка идёт уже без транзакции, а аренда не даёт
+// другим экземплярам dispatcher взять те же доставки до сохранения результата.
+func (r *WebhookDeliveryRepository) ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	now := time.Now()
+
+	due := sq.Select("due.id").
+		From("webhook_deliveries AS due").
+		Where(sq.Eq{"due.status": string(domain.WebhookDeliveryPending)}).
+		Where(sq.LtOrEq{"due.next_attempt_at": now}).
+		Where(sq.Or{
+			sq.Eq{"due.locked_until": nil},
+			sq.Lt{"due.locked_until": now},
+		}).
+		OrderBy("due.next_attempt_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	qb := r.sqb.
+		Update(schema.WebhookDelivery{}.TableName()).
+		Set("locked_until", now.Add(lease)).
+		From("webhook_subscriptions").
+		Where("webhook_subscriptions.id = webhook_deliveries.subscription_id").
+		Where(sq.Expr(schema.WebhookDeliveryCols.ID+" IN (?)", due)).
+		Suffix("RETURNING " + strings.Join(schema.WebhookDeliveryWithSubscription{}.Columns(), ", "))
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.WebhookDeliveryWithSubscription])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainWebhookDeliveryWithSubscriptionList(results), nil
+}
+
+// UpdateAttempt сохраняет результат попытки доставки и снимает аренду.
+func (r *WebhookDeliveryRepository) UpdateAttempt(ctx context.Context, deliveryID uuid.UUID, update domain.WebhookDelivery) error {
+	var (
+		lastStatusCode *int
+		lastError      *string
+	)
+	if update.LastStatusCode != 0 {
+		lastStatusCode = &update.LastStatusCode
+	}
+	if update.LastError != "" {
+		lastError = &update.LastError
+	}
+
+	qb := r.sqb.
+		Update(schema.WebhookDelivery{}.TableName()).
+		SetMap(map[string]any{
+			"status":           string(update.Status),
+			"attempts":         update.Attempts,
+			"next_attempt_at":  update.NextAttemptAt,
+			"last_status_code": lastStatusCode,
+			"last_error":       lastError,
+			"delivered_at":     update.DeliveredAt,
+			"locked_until":     nil,
+		}).
+		Where(sq.Eq{"id": deliveryID})
+
+	return Exec(ctx, r.db, qb)
+}
+
+func (r *WebhookDeliveryRepository) ListBySubscription(ctx context.Context, subscriptionID uuid.UUID, pagination *listparams.Pagination) ([]*domain.WebhookDelivery, error) {
+	qb := r.sqb.
+		Select(schema.WebhookDelivery{}.Columns()...).
+		From(schema.WebhookDelivery{}.TableName()).
+		Where(sq.Eq{schema.WebhookDeliveryCols.SubscriptionID: subscriptionID}).
+		OrderBy(schema.WebhookDeliveryCols.CreatedAt+" DESC", schema.WebhookDeliveryCols.ID)
+
+	if pagination != nil {
+		qb = qb.Limit(uint64(pagination.Limit)).
+			Offset(uint64(pagination.Offset()))
+	}
+
+	results, err := CollectRows(ctx, r.db, qb, pgx.RowToStructByName[schema.WebhookDelivery])
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.NewDomainWebhookDeliveryList(results), nil
+}
@@ -0,0 +1,34 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+type Publisher interface {
+	Publish(ctx context.Context, event domain.OutboxEvent) error
+}
+
+// Fanout публикует событие во все publisher по очереди.
+// Ошибка любого из них возвращает событие в outbox, поэтому publisher должны быть идемпотентны.
+type Fanout struct {
+	publishers []Publisher
+}
+
+func NewFanout(publishers ...Publisher) *Fanout {
+	return &Fanout{
+		publishers: publishers,
+	}
+}
+
+func (f *Fanout) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	var errs []error
+	for _, p := range f.publishers {
+		if err := p.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
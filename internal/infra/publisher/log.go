@@ -0,0 +1,29 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+)
+
+// LogPublisher пишет события в лог. Подходит для локальной разработки.
+type LogPublisher struct{}
+
+func NewLogPublisher() *LogPublisher {
+	return &LogPublisher{}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	msg := NewMessage(event)
+
+	logger.InfoCtx(ctx, "domain event",
+		"eventId", msg.ID,
+		"type", msg.Type,
+		"aggregateId", msg.AggregateID,
+		"pvzId", msg.PvzID,
+		"occurredAt", msg.OccurredAt,
+	)
+
+	return nil
+}
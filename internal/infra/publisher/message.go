@@ -0,0 +1,32 @@
+package publisher
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+// Message формат события, который получают внешние системы.
+type Message struct {
+	ID          uuid.UUID  `json:"id"`
+	Type        string     `json:"type"`
+	AggregateID uuid.UUID  `json:"aggregateId"`
+	PvzID       *uuid.UUID `json:"pvzId,omitempty"`
+	OccurredAt  time.Time  `json:"occurredAt"`
+	Payload     any        `json:"payload"`
+}
+
+func NewMessage(event domain.OutboxEvent) Message {
+	msg := Message{
+		ID:          event.ID,
+		Type:        string(event.Type),
+		AggregateID: event.AggregateID,
+		OccurredAt:  event.CreatedAt,
+		Payload:     event.Payload,
+	}
+	if event.PvzID != uuid.Nil {
+		msg.PvzID = &event.PvzID
+	}
+	return msg
+}
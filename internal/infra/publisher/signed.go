@@ -0,0 +1,60 @@
+package publisher
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+const (
+	HeaderSignature = "X-Webhook-Signature"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+)
+
+// SignedWebhookSender доставляет событие подписчику и подписывает тело HMAC-SHA256
+// секретом подписки. Получатель проверяет подпись через Sign с тем же секретом.
+type SignedWebhookSender struct {
+	client *http.Client
+}
+
+func NewSignedWebhookSender(timeout time.Duration) *SignedWebhookSender {
+	return &SignedWebhookSender{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *SignedWebhookSender) Send(ctx context.Context, subscription domain.WebhookSubscription, event domain.OutboxEvent) (int, error) {
+	body, err := json.Marshal(NewMessage(event))
+	if err != nil {
+		return 0, fmt.Errorf("marshal event: %w", err)
+	}
+
+	req, err := newEventRequest(ctx, subscription.URL, event, body)
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, body))
+
+	return send(s.client, req)
+}
+
+// Sign считает подпись "sha256=<hex>" от строки "<timestamp>.<body>".
+// Timestamp в подписи не даёт переиспользовать перехваченный запрос позже.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
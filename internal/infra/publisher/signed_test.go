@@ -0,0 +1,67 @@
+package publisher
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+func TestSignedWebhookSender_Send(t *testing.T) {
+	event := domain.OutboxEvent{
+		ID:          uuid.New(),
+		Type:        domain.EventReceptionClosed,
+		AggregateID: uuid.New(),
+		PvzID:       uuid.New(),
+		CreatedAt:   time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC),
+	}
+
+	t.Run("signed request", func(t *testing.T) {
+		const secret = "0123456789abcdef"
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+
+			timestamp, err := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+			assert.NoError(t, err)
+			assert.Equal(t, Sign(secret, timestamp, body), r.Header.Get(HeaderSignature))
+			assert.Equal(t, event.ID.String(), r.Header.Get("X-Event-Id"))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		code, err := NewSignedWebhookSender(time.Second).Send(context.Background(), domain.WebhookSubscription{URL: srv.URL, Secret: secret}, event)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("non 2xx response", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		code, err := NewSignedWebhookSender(time.Second).Send(context.Background(), domain.WebhookSubscription{URL: srv.URL, Secret: "secret"}, event)
+		require.EqualError(t, err, "webhook responded with status 500")
+		assert.Equal(t, http.StatusInternalServerError, code)
+	})
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+
+	sig := Sign("secret", 1700000000, body)
+	assert.Equal(t, sig, Sign("secret", 1700000000, body))
+	assert.NotEqual(t, sig, Sign("other", 1700000000, body))
+	assert.NotEqual(t, sig, Sign("secret", 1700000001, body))
+	assert.Len(t, sig, len("sha256=")+64)
+}
@@ -0,0 +1,68 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+// WebhookPublisher отправляет событие POST-запросом с JSON телом на заданный URL.
+// Любой ответ кроме 2xx считается ошибкой, событие останется в outbox до следующей попытки.
+type WebhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookPublisher(url string, timeout time.Duration) *WebhookPublisher {
+	return &WebhookPublisher{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	body, err := json.Marshal(NewMessage(event))
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	req, err := newEventRequest(ctx, p.url, event, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = send(p.client, req)
+	return err
+}
+
+func newEventRequest(ctx context.Context, url string, event domain.OutboxEvent, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", event.ID.String())
+	req.Header.Set("X-Event-Type", string(event.Type))
+
+	return req, nil
+}
+
+// send выполняет запрос и возвращает код ответа. Ответ вне 2xx возвращается как ошибка.
+func send(client *http.Client, req *http.Request) (int, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,58 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+func TestWebhookPublisher_Publish(t *testing.T) {
+	event := domain.OutboxEvent{
+		ID:          uuid.New(),
+		Type:        domain.EventReceptionOpened,
+		AggregateID: uuid.New(),
+		PvzID:       uuid.New(),
+		Payload:     json.RawMessage(`{"id":"1"}`),
+		CreatedAt:   time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC),
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		var got Message
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, event.ID.String(), r.Header.Get("X-Event-Id"))
+			assert.Equal(t, string(event.Type), r.Header.Get("X-Event-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		err := NewWebhookPublisher(srv.URL, time.Second).Publish(context.Background(), event)
+		require.NoError(t, err)
+
+		assert.Equal(t, event.ID, got.ID)
+		assert.Equal(t, "ReceptionOpened", got.Type)
+		assert.Equal(t, event.AggregateID, got.AggregateID)
+		assert.Equal(t, event.PvzID, *got.PvzID)
+		assert.Equal(t, map[string]any{"id": "1"}, got.Payload)
+	})
+
+	t.Run("non 2xx response", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		err := NewWebhookPublisher(srv.URL, time.Second).Publish(context.Background(), event)
+		require.EqualError(t, err, "webhook responded with status 503")
+	})
+}
@@ -27,8 +27,19 @@ var (
 			Help: "Total number of created receptions.",
 		},
 	)
+
+	// pvzCapacityUtilization обновляется при каждом изменении остатка и лимитов PVZ, product_type=total для общей вместимости PVZ
+	pvzCapacityUtilization = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "pvz_capacity_utilization_ratio",
+			Help: "Share of PVZ capacity occupied by products on hand.",
+		},
+		[]string{"pvz_id", "product_type"},
+	)
 )
 
+const CapacityTotalLabel = "total"
+
 func CreatedPVZInc() {
 	createdPVZ.Inc()
 }
@@ -37,6 +48,19 @@ func CreatedProductsInc() {
 	createdProducts.Inc()
 }
 
+func CreatedProductsAdd(n int) {
+	createdProducts.Add(float64(n))
+}
+
 func CreatedReceptionsInc() {
 	createdReceptions.Inc()
 }
+
+func SetPVZCapacityUtilization(pvzID, productType string, ratio float64) {
+	pvzCapacityUtilization.WithLabelValues(pvzID, productType).Set(ratio)
+}
+
+// DeletePVZCapacityUtilization удаляет серию загрузки для снятого лимита вместимости.
+func DeletePVZCapacityUtilization(pvzID, productType string) {
+	pvzCapacityUtilization.DeleteLabelValues(pvzID, productType)
+}
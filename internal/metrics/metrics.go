@@ -9,6 +9,7 @@ func Init() {
 		createdPVZ,
 		createdProducts,
 		createdReceptions,
+		pvzCapacityUtilization,
 		httpRequestsTotal,
 		httpRequestDuration,
 		httpResponsesTotal,
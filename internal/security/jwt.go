@@ -1,8 +1,8 @@
 package security
 
 import (
+	"context"
 	"crypto/rsa"
-	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -12,11 +12,18 @@ import (
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 )
 
+// все ошибки отклонённого токена оборачивают domain.ErrInvalidToken
 var (
-	ErrInvalidToken     = errors.New("invalid token")
-	ErrUnknownPublisher = errors.New("unknown token publisher")
+	ErrInvalidToken     = domain.ErrInvalidToken
+	ErrUnknownPublisher = fmt.Errorf("%w: unknown token publisher", domain.ErrInvalidToken)
+	ErrRevokedToken     = fmt.Errorf("%w: token has been revoked", domain.ErrInvalidToken)
 )
 
+// tokenDenylist хранит jti отозванных access токенов
+type tokenDenylist interface {
+	Exists(ctx context.Context, jti uuid.UUID) (bool, error)
+}
+
 type claims struct {
 	Role string `json:"role"`
 	jwt.RegisteredClaims
@@ -27,11 +34,14 @@ type JwtService struct {
 	accessLifeTime time.Duration
 	privateKey     *rsa.PrivateKey
 	publicKey      *rsa.PublicKey
+	denylist       tokenDenylist
 }
 
+// denylist может быть nil, тогда отозванные токены не проверяются
 func New(
 	privatePemFile, publicPemFile, iss string,
 	accessLifetime time.Duration,
+	denylist tokenDenylist,
 ) (*JwtService, error) {
 	const op = "security.jwt.New"
 
@@ -57,6 +67,7 @@ func New(
 		accessLifeTime: accessLifetime,
 		privateKey:     privateKey,
 		publicKey:      publicKey,
+		denylist:       denylist,
 	}, nil
 }
 
@@ -66,6 +77,9 @@ func (j JwtService) SignJwt(userClaims domain.UserClaims) (string, error) {
 	}
 
 	userClaimsStruct.RegisteredClaims = j.registeredClaims()
+	if userClaims.UserID != uuid.Nil {
+		userClaimsStruct.Subject = userClaims.UserID.String()
+	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, userClaimsStruct)
 
@@ -79,17 +93,14 @@ func (j JwtService) SignJwt(userClaims domain.UserClaims) (string, error) {
 
 func (j JwtService) registeredClaims() jwt.RegisteredClaims {
 	return jwt.RegisteredClaims{
-		// NOTE: simplified JWT setup for testing purposes.
-		// In production: add `sub` (user ID), persist `jti` for revocation, implement refresh token rotation.
-		// Subject: userID
-		ID:        uuid.NewString(), // jti
+		ID:        uuid.NewString(), // jti, по нему токен отзывается через denylist
 		ExpiresAt: jwt.NewNumericDate(time.Now().Add(j.accessLifeTime)),
 		IssuedAt:  jwt.NewNumericDate(time.Now()),
 		Issuer:    j.iss,
 	}
 }
 
-func (j JwtService) ValidateJwt(incomingToken string) (*domain.UserClaims, error) {
+func (j JwtService) ValidateJwt(ctx context.Context, incomingToken string) (*domain.UserClaims, error) {
 	claims := &claims{}
 	keyFunc := func(token *jwt.Token) (any, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
@@ -108,8 +119,39 @@ func (j JwtService) ValidateJwt(incomingToken string) (*domain.UserClaims, error
 		return nil, ErrUnknownPublisher
 	}
 
+	tokenID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid jti: %w", ErrInvalidToken, err)
+	}
+
+	if j.denylist != nil {
+		revoked, err := j.denylist.Exists(ctx, tokenID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check token denylist: %w", err)
+		}
+		if revoked {
+			return nil, ErrRevokedToken
+		}
+	}
+
+	var userID uuid.UUID
+	if claims.Subject != "" {
+		userID, err = uuid.Parse(claims.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid sub: %w", ErrInvalidToken, err)
+		}
+	}
+
+	var expiresAt time.Time
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
+	}
+
 	return &domain.UserClaims{
-		Role: domain.Role(claims.Role),
+		UserID:    userID,
+		Role:      domain.Role(claims.Role),
+		TokenID:   tokenID,
+		ExpiresAt: expiresAt,
 	}, nil
 }
 
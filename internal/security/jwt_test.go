@@ -1,10 +1,12 @@
 package security_test
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,6 +14,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
@@ -76,7 +79,7 @@ func newServiceFromKeys(t *testing.T, priv *rsa.PrivateKey, pub *rsa.PublicKey,
 		encodePublicKeyToPEM(t, pub),
 	)
 
-	svc, err := security.New(privPath, pubPath, iss, time.Hour)
+	svc, err := security.New(privPath, pubPath, iss, time.Hour, nil)
 	require.NoError(t, err)
 
 	return svc
@@ -92,7 +95,7 @@ func newService(t *testing.T, iss string) *security.JwtService {
 		encodePublicKeyToPEM(t, pub),
 	)
 
-	svc, err := security.New(privPath, pubPath, iss, time.Hour)
+	svc, err := security.New(privPath, pubPath, iss, time.Hour, nil)
 	require.NoError(t, err)
 
 	return svc
@@ -201,7 +204,7 @@ func TestNew(t *testing.T) {
 			t.Parallel()
 
 			privPath, pubPath := tt.setupPaths(t)
-			svc, err := security.New(privPath, pubPath, tt.iss, tt.lifetime)
+			svc, err := security.New(privPath, pubPath, tt.iss, tt.lifetime, nil)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -342,7 +345,7 @@ func TestValidateJwt(t *testing.T) {
 			svc := newService(t, iss)
 			tokenStr := tt.buildToken(svc)
 
-			got, err := svc.ValidateJwt(tokenStr)
+			got, err := svc.ValidateJwt(context.Background(), tokenStr)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -372,7 +375,7 @@ func TestValidateJwt_WrongIssuer(t *testing.T) {
 	tokenStr, err := signer.SignJwt(domain.UserClaims{Role: domain.ModeratorRole})
 	require.NoError(t, err)
 
-	got, err := validator.ValidateJwt(tokenStr)
+	got, err := validator.ValidateJwt(context.Background(), tokenStr)
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, security.ErrUnknownPublisher)
@@ -392,7 +395,7 @@ func TestValidateJwt_SignedWithDifferentPrivateKey(t *testing.T) {
 	tokenStr, err := svc1.SignJwt(domain.UserClaims{Role: domain.ModeratorRole})
 	require.NoError(t, err)
 
-	got, err := svc2.ValidateJwt(tokenStr)
+	got, err := svc2.ValidateJwt(context.Background(), tokenStr)
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, security.ErrInvalidToken)
@@ -413,7 +416,7 @@ func TestValidateJwt_WrongAlgorithm_HS256(t *testing.T) {
 	tokenStr, err := hmacToken.SignedString([]byte("secret"))
 	require.NoError(t, err)
 
-	got, err := svc.ValidateJwt(tokenStr)
+	got, err := svc.ValidateJwt(context.Background(), tokenStr)
 
 	require.Error(t, err)
 	require.ErrorIs(t, err, security.ErrInvalidToken)
@@ -440,7 +443,7 @@ func TestValidateJwt_RoundTrip(t *testing.T) {
 			tokenStr, err := svc.SignJwt(original)
 			require.NoError(t, err)
 
-			got, err := svc.ValidateJwt(tokenStr)
+			got, err := svc.ValidateJwt(context.Background(), tokenStr)
 			require.NoError(t, err)
 			require.NotNil(t, got)
 
@@ -448,3 +451,83 @@ func TestValidateJwt_RoundTrip(t *testing.T) {
 		})
 	}
 }
+
+type stubDenylist struct {
+	revoked map[uuid.UUID]bool
+	err     error
+}
+
+func (d stubDenylist) Exists(_ context.Context, jti uuid.UUID) (bool, error) {
+	return d.revoked[jti], d.err
+}
+
+func TestValidateJwt_Denylist(t *testing.T) {
+	t.Parallel()
+
+	priv, pub := generateRSAKeyPair(t)
+	privPath, pubPath := writeKeyFiles(t, encodePrivateKeyToPEM(priv), encodePublicKeyToPEM(t, pub))
+
+	signer := newServiceFromKeys(t, priv, pub, "test-issuer")
+
+	tokenStr, err := signer.SignJwt(domain.UserClaims{Role: domain.EmployeeRole})
+	require.NoError(t, err)
+
+	got, err := signer.ValidateJwt(context.Background(), tokenStr)
+	require.NoError(t, err)
+	require.NotEqual(t, uuid.Nil, got.TokenID)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), got.ExpiresAt, time.Minute)
+
+	t.Run("revoked token", func(t *testing.T) {
+		t.Parallel()
+
+		svc, err := security.New(privPath, pubPath, "test-issuer", time.Hour, stubDenylist{
+			revoked: map[uuid.UUID]bool{got.TokenID: true},
+		})
+		require.NoError(t, err)
+
+		claims, err := svc.ValidateJwt(context.Background(), tokenStr)
+		assert.ErrorIs(t, err, security.ErrRevokedToken)
+		assert.Nil(t, claims)
+	})
+
+	t.Run("not revoked token", func(t *testing.T) {
+		t.Parallel()
+
+		svc, err := security.New(privPath, pubPath, "test-issuer", time.Hour, stubDenylist{})
+		require.NoError(t, err)
+
+		claims, err := svc.ValidateJwt(context.Background(), tokenStr)
+		require.NoError(t, err)
+		assert.Equal(t, domain.EmployeeRole, claims.Role)
+	})
+
+	t.Run("denylist error", func(t *testing.T) {
+		t.Parallel()
+
+		svc, err := security.New(privPath, pubPath, "test-issuer", time.Hour, stubDenylist{err: errors.New("db error")})
+		require.NoError(t, err)
+
+		claims, err := svc.ValidateJwt(context.Background(), tokenStr)
+		assert.Error(t, err)
+		assert.Nil(t, claims)
+	})
+}
+
+func TestValidateJwt_Subject(t *testing.T) {
+	t.Parallel()
+
+	svc := newService(t, "test-issuer")
+
+	userID := uuid.New()
+	tokenStr, err := svc.SignJwt(domain.UserClaims{UserID: userID, Role: domain.ModeratorRole})
+	require.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(tokenStr, &jwt.RegisteredClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, userID.String(), parsed.Claims.(*jwt.RegisteredClaims).Subject)
+
+	got, err := svc.ValidateJwt(context.Background(), tokenStr)
+	require.NoError(t, err)
+	assert.Equal(t, userID, got.UserID)
+	assert.Equal(t, domain.ModeratorRole, got.Role)
+}
@@ -0,0 +1,50 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+const refreshTokenBytes = 32
+
+// RefreshTokenService выпускает непрозрачные refresh токены.
+// В БД сохраняется только sha256 хеш, сам токен отдаётся клиенту один раз.
+type RefreshTokenService struct {
+	lifeTime time.Duration
+}
+
+func NewRefreshTokenService(lifeTime time.Duration) (*RefreshTokenService, error) {
+	const op = "security.refresh.New"
+
+	if lifeTime <= 0 {
+		return nil, fmt.Errorf("%s: refresh lifetime must be positive", op)
+	}
+
+	return &RefreshTokenService{
+		lifeTime: lifeTime,
+	}, nil
+}
+
+func (s RefreshTokenService) Generate() (token string, tokenHash string, err error) {
+	b := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("generate refresh token: %w", err)
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+
+	return token, s.Hash(token), nil
+}
+
+func (s RefreshTokenService) Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s RefreshTokenService) LifeTime() time.Duration {
+	return s.lifeTime
+}
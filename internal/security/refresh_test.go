@@ -0,0 +1,40 @@
+package security_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/security"
+)
+
+func TestNewRefreshTokenService(t *testing.T) {
+	t.Parallel()
+
+	_, err := security.NewRefreshTokenService(0)
+	assert.Error(t, err)
+
+	svc, err := security.NewRefreshTokenService(time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, svc.LifeTime())
+}
+
+func TestRefreshTokenService_Generate(t *testing.T) {
+	t.Parallel()
+
+	svc, err := security.NewRefreshTokenService(time.Hour)
+	require.NoError(t, err)
+
+	token, hash, err := svc.Generate()
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, svc.Hash(token))
+	assert.NotEqual(t, token, hash)
+
+	other, otherHash, err := svc.Generate()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+	assert.NotEqual(t, hash, otherHash)
+}
@@ -15,5 +15,13 @@ func StatusesEnt() []domain.ReceptionStatus {
 			ID:   uuid.New(),
 			Name: "close",
 		},
+		{
+			ID:   uuid.New(),
+			Name: "cancelled",
+		},
+		{
+			ID:   uuid.New(),
+			Name: "reopened",
+		},
 	}
 }
@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+	"github.com/valeragav/avito-pvz-service/pkg/requestid"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=audit.go -destination=./mocks/audit_mock.go -package=mocks
+type auditRepo interface {
+	Create(ctx context.Context, event domain.AuditEvent) (*domain.AuditEvent, error)
+	List(ctx context.Context, filter domain.AuditFilter, pagination *listparams.Pagination) ([]*domain.AuditEvent, error)
+}
+
+type AuditUseCase struct {
+	auditRepo auditRepo
+}
+
+func New(auditRepo auditRepo) *AuditUseCase {
+	return &AuditUseCase{
+		auditRepo,
+	}
+}
+
+// Record пишет событие в журнал. Вызывается внутри транзакции use case,
+// поэтому событие фиксируется или откатывается вместе с самим изменением.
+func (s *AuditUseCase) Record(ctx context.Context, event domain.AuditEvent) error {
+	const op = "audit.Record"
+
+	if event.RequestID == "" {
+		event.RequestID = requestid.GetReqID(ctx)
+	}
+
+	_, err := s.auditRepo.Create(ctx, event)
+	if err != nil {
+		return fmt.Errorf("%s: failed to save audit event: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *AuditUseCase) List(ctx context.Context, params *dto.AuditListParams) ([]*domain.AuditEvent, error) {
+	const op = "audit.List"
+
+	var (
+		filter     domain.AuditFilter
+		pagination *listparams.Pagination
+	)
+	if params != nil {
+		filter = params.Filter
+		pagination = params.Pagination
+	}
+
+	events, err := s.auditRepo.List(ctx, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list audit events: %w", op, err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,170 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/audit/mocks"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+	"github.com/valeragav/avito-pvz-service/pkg/requestid"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuditUseCase_Record(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := requestid.SetReqID(context.Background(), "req-1")
+
+	type fields struct {
+		name    string
+		event   domain.AuditEvent
+		mockFn  func(f fields, m *mocks.MockauditRepo)
+		wantErr error
+	}
+
+	testcases := []fields{
+		{
+			name: "ok, request id from context",
+			event: domain.AuditEvent{
+				ActorID:  uuid.New(),
+				Action:   domain.AuditActionReceptionOpened,
+				EntityID: uuid.New(),
+			},
+			mockFn: func(f fields, m *mocks.MockauditRepo) {
+				m.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) (*domain.AuditEvent, error) {
+						require.Equal(t, "req-1", e.RequestID)
+						require.Equal(t, f.event.Action, e.Action)
+						return &e, nil
+					}).
+					Times(1)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "ok, explicit request id kept",
+			event: domain.AuditEvent{
+				Action:    domain.AuditActionPVZCreated,
+				EntityID:  uuid.New(),
+				RequestID: "req-2",
+			},
+			mockFn: func(f fields, m *mocks.MockauditRepo) {
+				m.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) (*domain.AuditEvent, error) {
+						require.Equal(t, "req-2", e.RequestID)
+						return &e, nil
+					}).
+					Times(1)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "repo error",
+			event: domain.AuditEvent{
+				Action:   domain.AuditActionProductAdded,
+				EntityID: uuid.New(),
+			},
+			mockFn: func(f fields, m *mocks.MockauditRepo) {
+				m.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("audit.Record: failed to save audit event: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := mocks.NewMockauditRepo(ctrl)
+			tt.mockFn(tt, repo)
+
+			err := New(repo).Record(ctx, tt.event)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuditUseCase_List(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	pvzID := uuid.New()
+	params := &dto.AuditListParams{
+		Filter:     domain.AuditFilter{PvzID: &pvzID},
+		Pagination: &listparams.Pagination{Page: 1, Limit: 10},
+	}
+
+	type fields struct {
+		name    string
+		mockFn  func(m *mocks.MockauditRepo)
+		wantLen int
+		wantErr error
+	}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			mockFn: func(m *mocks.MockauditRepo) {
+				m.EXPECT().
+					List(ctx, params.Filter, params.Pagination).
+					Return([]*domain.AuditEvent{{ID: uuid.New()}, {ID: uuid.New()}}, nil).
+					Times(1)
+			},
+			wantLen: 2,
+		},
+		{
+			name: "repo error",
+			mockFn: func(m *mocks.MockauditRepo) {
+				m.EXPECT().
+					List(ctx, params.Filter, params.Pagination).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("audit.List: failed to list audit events: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := mocks.NewMockauditRepo(ctrl)
+			tt.mockFn(repo)
+
+			events, err := New(repo).List(ctx, params)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, events)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, events, tt.wantLen)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=audit.go -destination=./mocks/audit_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/valeragav/avito-pvz-service/internal/domain"
+	listparams "github.com/valeragav/avito-pvz-service/pkg/listparams"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockauditRepo is a mock of auditRepo interface.
+type MockauditRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockauditRepoMockRecorder is the mock recorder for MockauditRepo.
+type MockauditRepoMockRecorder struct {
+	mock *MockauditRepo
+}
+
+// NewMockauditRepo creates a new mock instance.
+func NewMockauditRepo(ctrl *gomock.Controller) *MockauditRepo {
+	mock := &MockauditRepo{ctrl: ctrl}
+	mock.recorder = &MockauditRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditRepo) EXPECT() *MockauditRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockauditRepo) Create(ctx context.Context, event domain.AuditEvent) (*domain.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, event)
+	ret0, _ := ret[0].(*domain.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockauditRepoMockRecorder) Create(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockauditRepo)(nil).Create), ctx, event)
+}
+
+// List mocks base method.
+func (m *MockauditRepo) List(ctx context.Context, filter domain.AuditFilter, pagination *listparams.Pagination) ([]*domain.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter, pagination)
+	ret0, _ := ret[0].([]*domain.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockauditRepoMockRecorder) List(ctx, filter, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockauditRepo)(nil).List), ctx, filter, pagination)
+}
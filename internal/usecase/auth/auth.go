@@ -5,17 +5,26 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/internal/infra"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
 	"golang.org/x/crypto/bcrypt"
 )
 
 //go:generate ${LOCAL_BIN}/mockgen -source=auth.go -destination=./mocks/auth_mock.go -package=mocks
 type jwtService interface {
 	SignJwt(userClaims domain.UserClaims) (string, error)
-	ValidateJwt(incomingToken string) (*domain.UserClaims, error)
+	ValidateJwt(ctx context.Context, incomingToken string) (*domain.UserClaims, error)
+}
+
+type refreshTokenService interface {
+	Generate() (token string, tokenHash string, err error)
+	Hash(token string) string
+	LifeTime() time.Duration
 }
 
 type userRepository interface {
@@ -23,15 +32,48 @@ type userRepository interface {
 	Get(ctx context.Context, filter domain.User) (*domain.User, error)
 }
 
+type refreshTokenRepository interface {
+	Create(ctx context.Context, token domain.RefreshToken) (*domain.RefreshToken, error)
+	GetByHashForUpdate(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	Revoke(ctx context.Context, tokenID uuid.UUID) error
+	RevokeAllByUser(ctx context.Context, userID uuid.UUID) error
+}
+
+type revokedTokenRepository interface {
+	Create(ctx context.Context, jti uuid.UUID, expiresAt time.Time) error
+}
+
+type txManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// dummyUserNamespace пространство имён для синтетических id пользователей dummyLogin
+var dummyUserNamespace = uuid.MustParse("6f1c2b7e-3a8d-4c1e-9b52-0d7a4e8f3c21")
+
 type AuthUseCase struct {
-	jwtService jwtService
-	userRepo   userRepository
+	jwtService          jwtService
+	refreshTokenService refreshTokenService
+	userRepo            userRepository
+	refreshTokenRepo    refreshTokenRepository
+	revokedTokenRepo    revokedTokenRepository
+	txManager           txManager
 }
 
-func New(jwtService jwtService, userRepo userRepository) *AuthUseCase {
+func New(
+	jwtService jwtService,
+	refreshTokenService refreshTokenService,
+	userRepo userRepository,
+	refreshTokenRepo refreshTokenRepository,
+	revokedTokenRepo revokedTokenRepository,
+	txManager txManager,
+) *AuthUseCase {
 	return &AuthUseCase{
 		jwtService,
+		refreshTokenService,
 		userRepo,
+		refreshTokenRepo,
+		revokedTokenRepo,
+		txManager,
 	}
 }
 
@@ -39,7 +81,8 @@ func (s *AuthUseCase) GenerateToken(role domain.Role) (*domain.Token, error) {
 	const op = "auth.GenerateToken"
 
 	token, err := s.jwtService.SignJwt(domain.UserClaims{
-		Role: role,
+		UserID: dummyUserID(role),
+		Role:   role,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to generate token: %w", op, err)
@@ -81,7 +124,7 @@ func (s *AuthUseCase) Register(ctx context.Context, registerReq dto.RegisterIn)
 	return createdUser, nil
 }
 
-func (s *AuthUseCase) Login(ctx context.Context, loginReq dto.LoginIn) (*domain.Token, error) {
+func (s *AuthUseCase) Login(ctx context.Context, loginReq dto.LoginIn) (*domain.TokenPair, error) {
 	const op = "auth.Login"
 
 	userFound, err := s.userRepo.Get(ctx, domain.User{Email: loginReq.Email})
@@ -94,14 +137,152 @@ func (s *AuthUseCase) Login(ctx context.Context, loginReq dto.LoginIn) (*domain.
 		return nil, domain.ErrInvalidEmailOrPassword
 	}
 
-	token, err := s.jwtService.SignJwt(domain.UserClaims{
-		Role: userFound.Role,
+	tokens, err := s.issueTokenPair(ctx, userFound)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tokens, nil
+}
+
+// Refresh меняет refresh токен на новую пару токенов, старый refresh токен отзывается.
+// Повторное использование уже отозванного токена считается компрометацией
+// и отзывает все refresh токены пользователя.
+func (s *AuthUseCase) Refresh(ctx context.Context, refreshIn dto.RefreshIn) (*domain.TokenPair, error) {
+	const op = "auth.Refresh"
+
+	var (
+		tokens *domain.TokenPair
+		reused bool
+	)
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		stored, err := s.refreshTokenRepo.GetByHashForUpdate(ctx, s.refreshTokenService.Hash(refreshIn.RefreshToken))
+		if err != nil {
+			if errors.Is(err, infra.ErrNotFound) {
+				return domain.ErrInvalidRefreshToken
+			}
+			return fmt.Errorf("failed to get refresh token: %w", err)
+		}
+
+		if stored.IsRevoked() {
+			reused = true
+			if err := s.refreshTokenRepo.RevokeAllByUser(ctx, stored.UserID); err != nil {
+				return fmt.Errorf("failed to revoke user refresh tokens: %w", err)
+			}
+			// коммитим отзыв, ошибку отдаём после транзакции
+			return nil
+		}
+
+		if stored.IsExpired(time.Now()) {
+			return domain.ErrInvalidRefreshToken
+		}
+
+		user, err := s.userRepo.Get(ctx, domain.User{ID: stored.UserID})
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		if err := s.refreshTokenRepo.Revoke(ctx, stored.ID); err != nil {
+			return fmt.Errorf("failed to revoke refresh token: %w", err)
+		}
+
+		tokens, err = s.issueTokenPair(ctx, user)
+		return err
 	})
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to generate token: %w", op, err)
+		if errors.Is(err, domain.ErrInvalidRefreshToken) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	domainToken := domain.Token(token)
+	if reused {
+		return nil, domain.ErrInvalidRefreshToken
+	}
 
-	return &domainToken, nil
+	return tokens, nil
+}
+
+// Logout отзывает refresh токен и, если передан, access токен текущей сессии.
+// Отозванный или просроченный refresh токен и access токен другого пользователя отклоняются.
+func (s *AuthUseCase) Logout(ctx context.Context, logoutIn dto.LogoutIn) error {
+	const op = "auth.Logout"
+
+	return s.txManager.Do(ctx, func(ctx context.Context) error {
+		stored, err := s.refreshTokenRepo.GetByHashForUpdate(ctx, s.refreshTokenService.Hash(logoutIn.RefreshToken))
+		if err != nil {
+			if errors.Is(err, infra.ErrNotFound) {
+				return domain.ErrInvalidRefreshToken
+			}
+			return fmt.Errorf("%s: failed to get refresh token: %w", op, err)
+		}
+
+		if stored.IsRevoked() || stored.IsExpired(time.Now()) {
+			return domain.ErrInvalidRefreshToken
+		}
+
+		var claims *domain.UserClaims
+		if logoutIn.AccessToken != "" {
+			// просроченный или уже отозванный access токен не мешает выходу
+			claims, err = s.jwtService.ValidateJwt(ctx, logoutIn.AccessToken)
+			if err != nil {
+				logger.DebugCtx(ctx, "skip access token revocation", "err", err)
+				claims = nil
+			}
+		}
+
+		// чужой access токен по своему refresh токену отозвать нельзя
+		if claims != nil && claims.UserID != stored.UserID {
+			return domain.ErrInvalidRefreshToken
+		}
+
+		if err := s.refreshTokenRepo.Revoke(ctx, stored.ID); err != nil {
+			return fmt.Errorf("%s: failed to revoke refresh token: %w", op, err)
+		}
+
+		if claims == nil {
+			return nil
+		}
+
+		if err := s.revokedTokenRepo.Create(ctx, claims.TokenID, claims.ExpiresAt); err != nil {
+			return fmt.Errorf("%s: failed to revoke access token: %w", op, err)
+		}
+
+		return nil
+	})
+}
+
+func (s *AuthUseCase) issueTokenPair(ctx context.Context, user *domain.User) (*domain.TokenPair, error) {
+	accessToken, err := s.jwtService.SignJwt(domain.UserClaims{
+		UserID: user.ID,
+		Role:   user.Role,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	refreshToken, refreshHash, err := s.refreshTokenService.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	_, err = s.refreshTokenRepo.Create(ctx, domain.RefreshToken{
+		UserID:    user.ID,
+		TokenHash: refreshHash,
+		ExpiresAt: time.Now().Add(s.refreshTokenService.LifeTime()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save refresh token: %w", err)
+	}
+
+	return &domain.TokenPair{
+		AccessToken:  domain.Token(accessToken),
+		RefreshToken: domain.Token(refreshToken),
+	}, nil
+}
+
+// dummyUserID у dummyLogin нет пользователя в БД, поэтому id детерминированно выводится из роли
+func dummyUserID(role domain.Role) uuid.UUID {
+	return uuid.NewSHA1(dummyUserNamespace, []byte(role))
 }
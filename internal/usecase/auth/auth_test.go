@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -17,19 +18,37 @@ import (
 )
 
 type authMocks struct {
-	MockJwtService *mocks.MockjwtService
-	MockUserRepo   *mocks.MockuserRepository
+	MockJwtService          *mocks.MockjwtService
+	MockRefreshTokenService *mocks.MockrefreshTokenService
+	MockUserRepo            *mocks.MockuserRepository
+	MockRefreshTokenRepo    *mocks.MockrefreshTokenRepository
+	MockRevokedTokenRepo    *mocks.MockrevokedTokenRepository
+	MockTxManager           *mocks.MocktxManager
 }
 
 func newAuthMocks(t *testing.T) *authMocks {
 	ctrl := gomock.NewController(t)
 
+	txManager := mocks.NewMocktxManager(ctrl)
+	txManager.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+
 	return &authMocks{
-		MockJwtService: mocks.NewMockjwtService(ctrl),
-		MockUserRepo:   mocks.NewMockuserRepository(ctrl),
+		MockJwtService:          mocks.NewMockjwtService(ctrl),
+		MockRefreshTokenService: mocks.NewMockrefreshTokenService(ctrl),
+		MockUserRepo:            mocks.NewMockuserRepository(ctrl),
+		MockRefreshTokenRepo:    mocks.NewMockrefreshTokenRepository(ctrl),
+		MockRevokedTokenRepo:    mocks.NewMockrevokedTokenRepository(ctrl),
+		MockTxManager:           txManager,
 	}
 }
 
+func newTestAuthUseCase(m *authMocks) *AuthUseCase {
+	return New(m.MockJwtService, m.MockRefreshTokenService, m.MockUserRepo, m.MockRefreshTokenRepo, m.MockRevokedTokenRepo, m.MockTxManager)
+}
+
 func TestAuthUseCase_Register(t *testing.T) {
 	t.Parallel()
 	testutils.InitTestLogger()
@@ -122,7 +141,7 @@ func TestAuthUseCase_Register(t *testing.T) {
 			authMocks := newAuthMocks(t)
 			tt.mockFn(tt, authMocks)
 
-			authUseCase := New(authMocks.MockJwtService, authMocks.MockUserRepo)
+			authUseCase := newTestAuthUseCase(authMocks)
 
 			user, err := authUseCase.Register(ctx, tt.req)
 
@@ -167,7 +186,7 @@ func TestAuthUseCase_GenerateToken(t *testing.T) {
 			token: domain.Token(uuid.New().String()),
 			mockFn: func(f fields, m *authMocks) {
 				m.MockJwtService.EXPECT().
-					SignJwt(domain.UserClaims{Role: domain.ModeratorRole}).
+					SignJwt(domain.UserClaims{UserID: dummyUserID(domain.ModeratorRole), Role: domain.ModeratorRole}).
 					Return(string(f.token), nil).
 					Times(1)
 			},
@@ -179,7 +198,7 @@ func TestAuthUseCase_GenerateToken(t *testing.T) {
 			token: "",
 			mockFn: func(f fields, m *authMocks) {
 				m.MockJwtService.EXPECT().
-					SignJwt(domain.UserClaims{Role: domain.ModeratorRole}).
+					SignJwt(domain.UserClaims{UserID: dummyUserID(domain.ModeratorRole), Role: domain.ModeratorRole}).
 					Return("", errors.New("jwt error")).
 					Times(1)
 			},
@@ -194,7 +213,7 @@ func TestAuthUseCase_GenerateToken(t *testing.T) {
 			authMocks := newAuthMocks(t)
 			tt.mockFn(tt, authMocks)
 
-			authUseCase := New(authMocks.MockJwtService, authMocks.MockUserRepo)
+			authUseCase := newTestAuthUseCase(authMocks)
 			token, err := authUseCase.GenerateToken(tt.role)
 
 			if tt.wantErr != nil {
@@ -257,6 +276,16 @@ func TestAuthUseCase_Login(t *testing.T) {
 					}).
 					Return(string(fields.token), nil).
 					Times(1)
+
+				m.MockRefreshTokenService.EXPECT().Generate().Return("refresh", "refresh-hash", nil).Times(1)
+				m.MockRefreshTokenService.EXPECT().LifeTime().Return(time.Hour).Times(1)
+				m.MockRefreshTokenRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, rt domain.RefreshToken) (*domain.RefreshToken, error) {
+						require.Equal(t, "refresh-hash", rt.TokenHash)
+						return &rt, nil
+					}).
+					Times(1)
 			},
 			wantErr: nil,
 		},
@@ -288,6 +317,34 @@ func TestAuthUseCase_Login(t *testing.T) {
 			},
 			wantErr: domain.ErrInvalidEmailOrPassword,
 		},
+		{
+			name:  "error save refresh token",
+			req:   loginInReq,
+			token: "",
+			mockFn: func(fields fields, m *authMocks) {
+				m.MockUserRepo.EXPECT().
+					Get(ctx, domain.User{Email: fields.req.Email}).
+					Return(&domain.User{
+						Email:        fields.req.Email,
+						PasswordHash: string(hashedPassword),
+						Role:         domain.ModeratorRole,
+					}, nil).
+					Times(1)
+
+				m.MockJwtService.EXPECT().
+					SignJwt(domain.UserClaims{Role: domain.ModeratorRole}).
+					Return("access", nil).
+					Times(1)
+
+				m.MockRefreshTokenService.EXPECT().Generate().Return("refresh", "refresh-hash", nil).Times(1)
+				m.MockRefreshTokenService.EXPECT().LifeTime().Return(time.Hour).Times(1)
+				m.MockRefreshTokenRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("auth.Login: failed to save refresh token: db error"),
+		},
 		{
 			name:  "error generate token",
 			req:   loginInReq,
@@ -318,7 +375,7 @@ func TestAuthUseCase_Login(t *testing.T) {
 			authMocks := newAuthMocks(t)
 			tt.mockFn(tt, authMocks)
 
-			authUseCase := New(authMocks.MockJwtService, authMocks.MockUserRepo)
+			authUseCase := newTestAuthUseCase(authMocks)
 
 			token, err := authUseCase.Login(ctx, tt.req)
 
@@ -331,7 +388,235 @@ func TestAuthUseCase_Login(t *testing.T) {
 
 			require.NoError(t, err)
 			require.NotNil(t, token)
-			require.Equal(t, tt.token, *token)
+			require.Equal(t, tt.token, token.AccessToken)
+			require.Equal(t, domain.Token("refresh"), token.RefreshToken)
 		})
 	}
 }
+
+func TestAuthUseCase_Refresh(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+
+	ctx := context.Background()
+
+	user := &domain.User{
+		ID:    uuid.New(),
+		Email: "test@email.ru",
+		Role:  domain.EmployeeRole,
+	}
+
+	refreshIn := dto.RefreshIn{RefreshToken: "old-refresh"}
+
+	type fields struct {
+		name    string
+		wantErr error
+		mockFn  func(m *authMocks)
+	}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			mockFn: func(m *authMocks) {
+				stored := &domain.RefreshToken{ID: uuid.New(), UserID: user.ID, ExpiresAt: time.Now().Add(time.Hour)}
+
+				m.MockRefreshTokenService.EXPECT().Hash("old-refresh").Return("old-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "old-hash").Return(stored, nil).Times(1)
+				m.MockUserRepo.EXPECT().Get(gomock.Any(), domain.User{ID: user.ID}).Return(user, nil).Times(1)
+				m.MockRefreshTokenRepo.EXPECT().Revoke(gomock.Any(), stored.ID).Return(nil).Times(1)
+
+				m.MockJwtService.EXPECT().SignJwt(domain.UserClaims{UserID: user.ID, Role: user.Role}).Return("access", nil).Times(1)
+				m.MockRefreshTokenService.EXPECT().Generate().Return("refresh", "refresh-hash", nil).Times(1)
+				m.MockRefreshTokenService.EXPECT().LifeTime().Return(time.Hour).Times(1)
+				m.MockRefreshTokenRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, rt domain.RefreshToken) (*domain.RefreshToken, error) {
+						return &rt, nil
+					}).Times(1)
+			},
+		},
+		{
+			name: "token not found",
+			mockFn: func(m *authMocks) {
+				m.MockRefreshTokenService.EXPECT().Hash("old-refresh").Return("old-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "old-hash").Return(nil, infra.ErrNotFound).Times(1)
+			},
+			wantErr: domain.ErrInvalidRefreshToken,
+		},
+		{
+			name: "reused revoked token revokes all user tokens",
+			mockFn: func(m *authMocks) {
+				revokedAt := time.Now().Add(-time.Minute)
+				stored := &domain.RefreshToken{ID: uuid.New(), UserID: user.ID, ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}
+
+				m.MockRefreshTokenService.EXPECT().Hash("old-refresh").Return("old-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "old-hash").Return(stored, nil).Times(1)
+				m.MockRefreshTokenRepo.EXPECT().RevokeAllByUser(gomock.Any(), user.ID).Return(nil).Times(1)
+			},
+			wantErr: domain.ErrInvalidRefreshToken,
+		},
+		{
+			name: "expired token",
+			mockFn: func(m *authMocks) {
+				stored := &domain.RefreshToken{ID: uuid.New(), UserID: user.ID, ExpiresAt: time.Now().Add(-time.Hour)}
+
+				m.MockRefreshTokenService.EXPECT().Hash("old-refresh").Return("old-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "old-hash").Return(stored, nil).Times(1)
+			},
+			wantErr: domain.ErrInvalidRefreshToken,
+		},
+		{
+			name: "repo error",
+			mockFn: func(m *authMocks) {
+				m.MockRefreshTokenService.EXPECT().Hash("old-refresh").Return("old-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "old-hash").Return(nil, errors.New("db error")).Times(1)
+			},
+			wantErr: errors.New("auth.Refresh: failed to get refresh token: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authMocks := newAuthMocks(t)
+			tt.mockFn(authMocks)
+
+			authUseCase := newTestAuthUseCase(authMocks)
+
+			tokens, err := authUseCase.Refresh(ctx, refreshIn)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, tokens)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, domain.Token("access"), tokens.AccessToken)
+			require.Equal(t, domain.Token("refresh"), tokens.RefreshToken)
+		})
+	}
+}
+
+func TestAuthUseCase_Logout(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+
+	ctx := context.Background()
+
+	stored := &domain.RefreshToken{ID: uuid.New(), UserID: uuid.New(), ExpiresAt: time.Now().Add(time.Hour)}
+	claims := &domain.UserClaims{UserID: stored.UserID, Role: domain.EmployeeRole, TokenID: uuid.New(), ExpiresAt: time.Now().Add(time.Minute)}
+	otherClaims := &domain.UserClaims{UserID: uuid.New(), Role: domain.EmployeeRole, TokenID: uuid.New(), ExpiresAt: time.Now().Add(time.Minute)}
+	revokedAt := time.Now().Add(-time.Minute)
+	revoked := &domain.RefreshToken{ID: uuid.New(), UserID: stored.UserID, ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}
+	expired := &domain.RefreshToken{ID: uuid.New(), UserID: stored.UserID, ExpiresAt: time.Now().Add(-time.Minute)}
+
+	type fields struct {
+		name    string
+		req     dto.LogoutIn
+		wantErr error
+		mockFn  func(m *authMocks)
+	}
+
+	testcases := []fields{
+		{
+			name: "ok with access token",
+			req:  dto.LogoutIn{RefreshToken: "refresh", AccessToken: "access"},
+			mockFn: func(m *authMocks) {
+				m.MockRefreshTokenService.EXPECT().Hash("refresh").Return("refresh-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "refresh-hash").Return(stored, nil).Times(1)
+				m.MockRefreshTokenRepo.EXPECT().Revoke(gomock.Any(), stored.ID).Return(nil).Times(1)
+				m.MockJwtService.EXPECT().ValidateJwt(gomock.Any(), "access").Return(claims, nil).Times(1)
+				m.MockRevokedTokenRepo.EXPECT().Create(gomock.Any(), claims.TokenID, claims.ExpiresAt).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "ok without access token",
+			req:  dto.LogoutIn{RefreshToken: "refresh"},
+			mockFn: func(m *authMocks) {
+				m.MockRefreshTokenService.EXPECT().Hash("refresh").Return("refresh-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "refresh-hash").Return(stored, nil).Times(1)
+				m.MockRefreshTokenRepo.EXPECT().Revoke(gomock.Any(), stored.ID).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "invalid access token is skipped",
+			req:  dto.LogoutIn{RefreshToken: "refresh", AccessToken: "bad"},
+			mockFn: func(m *authMocks) {
+				m.MockRefreshTokenService.EXPECT().Hash("refresh").Return("refresh-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "refresh-hash").Return(stored, nil).Times(1)
+				m.MockRefreshTokenRepo.EXPECT().Revoke(gomock.Any(), stored.ID).Return(nil).Times(1)
+				m.MockJwtService.EXPECT().ValidateJwt(gomock.Any(), "bad").Return(nil, errors.New("token expired")).Times(1)
+			},
+		},
+		{
+			name: "refresh token not found",
+			req:  dto.LogoutIn{RefreshToken: "refresh"},
+			mockFn: func(m *authMocks) {
+				m.MockRefreshTokenService.EXPECT().Hash("refresh").Return("refresh-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "refresh-hash").Return(nil, infra.ErrNotFound).Times(1)
+			},
+			wantErr: domain.ErrInvalidRefreshToken,
+		},
+		{
+			name: "access token of another user",
+			req:  dto.LogoutIn{RefreshToken: "refresh", AccessToken: "foreign"},
+			mockFn: func(m *authMocks) {
+				m.MockRefreshTokenService.EXPECT().Hash("refresh").Return("refresh-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "refresh-hash").Return(stored, nil).Times(1)
+				m.MockJwtService.EXPECT().ValidateJwt(gomock.Any(), "foreign").Return(otherClaims, nil).Times(1)
+			},
+			wantErr: domain.ErrInvalidRefreshToken,
+		},
+		{
+			name: "refresh token already revoked",
+			req:  dto.LogoutIn{RefreshToken: "refresh", AccessToken: "access"},
+			mockFn: func(m *authMocks) {
+				m.MockRefreshTokenService.EXPECT().Hash("refresh").Return("refresh-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "refresh-hash").Return(revoked, nil).Times(1)
+			},
+			wantErr: domain.ErrInvalidRefreshToken,
+		},
+		{
+			name: "refresh token expired",
+			req:  dto.LogoutIn{RefreshToken: "refresh"},
+			mockFn: func(m *authMocks) {
+				m.MockRefreshTokenService.EXPECT().Hash("refresh").Return("refresh-hash").Times(1)
+				m.MockRefreshTokenRepo.EXPECT().GetByHashForUpdate(gomock.Any(), "refresh-hash").Return(expired, nil).Times(1)
+			},
+			wantErr: domain.ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authMocks := newAuthMocks(t)
+			tt.mockFn(authMocks)
+
+			authUseCase := newTestAuthUseCase(authMocks)
+
+			err := authUseCase.Logout(ctx, tt.req)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDummyUserID(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, dummyUserID(domain.EmployeeRole), dummyUserID(domain.EmployeeRole))
+	require.NotEqual(t, dummyUserID(domain.EmployeeRole), dummyUserID(domain.ModeratorRole))
+	require.NotEqual(t, uuid.Nil, dummyUserID(domain.ModeratorRole))
+}
@@ -12,7 +12,9 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	uuid "github.com/google/uuid"
 	domain "github.com/valeragav/avito-pvz-service/internal/domain"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -57,18 +59,86 @@ func (mr *MockjwtServiceMockRecorder) SignJwt(userClaims any) *gomock.Call {
 }
 
 // ValidateJwt mocks base method.
-func (m *MockjwtService) ValidateJwt(incomingToken string) (*domain.UserClaims, error) {
+func (m *MockjwtService) ValidateJwt(ctx context.Context, incomingToken string) (*domain.UserClaims, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateJwt", incomingToken)
+	ret := m.ctrl.Call(m, "ValidateJwt", ctx, incomingToken)
 	ret0, _ := ret[0].(*domain.UserClaims)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ValidateJwt indicates an expected call of ValidateJwt.
-func (mr *MockjwtServiceMockRecorder) ValidateJwt(incomingToken any) *gomock.Call {
+func (mr *MockjwtServiceMockRecorder) ValidateJwt(ctx, incomingToken any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateJwt", reflect.TypeOf((*MockjwtService)(nil).ValidateJwt), incomingToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateJwt", reflect.TypeOf((*MockjwtService)(nil).ValidateJwt), ctx, incomingToken)
+}
+
+// MockrefreshTokenService is a mock of refreshTokenService interface.
+type MockrefreshTokenService struct {
+	ctrl     *gomock.Controller
+	recorder *MockrefreshTokenServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockrefreshTokenServiceMockRecorder is the mock recorder for MockrefreshTokenService.
+type MockrefreshTokenServiceMockRecorder struct {
+	mock *MockrefreshTokenService
+}
+
+// NewMockrefreshTokenService creates a new mock instance.
+func NewMockrefreshTokenService(ctrl *gomock.Controller) *MockrefreshTokenService {
+	mock := &MockrefreshTokenService{ctrl: ctrl}
+	mock.recorder = &MockrefreshTokenServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrefreshTokenService) EXPECT() *MockrefreshTokenServiceMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockrefreshTokenService) Generate() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockrefreshTokenServiceMockRecorder) Generate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockrefreshTokenService)(nil).Generate))
+}
+
+// Hash mocks base method.
+func (m *MockrefreshTokenService) Hash(token string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", token)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockrefreshTokenServiceMockRecorder) Hash(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockrefreshTokenService)(nil).Hash), token)
+}
+
+// LifeTime mocks base method.
+func (m *MockrefreshTokenService) LifeTime() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LifeTime")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// LifeTime indicates an expected call of LifeTime.
+func (mr *MockrefreshTokenServiceMockRecorder) LifeTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LifeTime", reflect.TypeOf((*MockrefreshTokenService)(nil).LifeTime))
 }
 
 // MockuserRepository is a mock of userRepository interface.
@@ -124,3 +194,161 @@ func (mr *MockuserRepositoryMockRecorder) Get(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockuserRepository)(nil).Get), ctx, filter)
 }
+
+// MockrefreshTokenRepository is a mock of refreshTokenRepository interface.
+type MockrefreshTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockrefreshTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockrefreshTokenRepositoryMockRecorder is the mock recorder for MockrefreshTokenRepository.
+type MockrefreshTokenRepositoryMockRecorder struct {
+	mock *MockrefreshTokenRepository
+}
+
+// NewMockrefreshTokenRepository creates a new mock instance.
+func NewMockrefreshTokenRepository(ctrl *gomock.Controller) *MockrefreshTokenRepository {
+	mock := &MockrefreshTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockrefreshTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrefreshTokenRepository) EXPECT() *MockrefreshTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockrefreshTokenRepository) Create(ctx context.Context, token domain.RefreshToken) (*domain.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(*domain.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockrefreshTokenRepositoryMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockrefreshTokenRepository)(nil).Create), ctx, token)
+}
+
+// GetByHashForUpdate mocks base method.
+func (m *MockrefreshTokenRepository) GetByHashForUpdate(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByHashForUpdate", ctx, tokenHash)
+	ret0, _ := ret[0].(*domain.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByHashForUpdate indicates an expected call of GetByHashForUpdate.
+func (mr *MockrefreshTokenRepositoryMockRecorder) GetByHashForUpdate(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHashForUpdate", reflect.TypeOf((*MockrefreshTokenRepository)(nil).GetByHashForUpdate), ctx, tokenHash)
+}
+
+// Revoke mocks base method.
+func (m *MockrefreshTokenRepository) Revoke(ctx context.Context, tokenID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockrefreshTokenRepositoryMockRecorder) Revoke(ctx, tokenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockrefreshTokenRepository)(nil).Revoke), ctx, tokenID)
+}
+
+// RevokeAllByUser mocks base method.
+func (m *MockrefreshTokenRepository) RevokeAllByUser(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllByUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllByUser indicates an expected call of RevokeAllByUser.
+func (mr *MockrefreshTokenRepositoryMockRecorder) RevokeAllByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllByUser", reflect.TypeOf((*MockrefreshTokenRepository)(nil).RevokeAllByUser), ctx, userID)
+}
+
+// MockrevokedTokenRepository is a mock of revokedTokenRepository interface.
+type MockrevokedTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockrevokedTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockrevokedTokenRepositoryMockRecorder is the mock recorder for MockrevokedTokenRepository.
+type MockrevokedTokenRepositoryMockRecorder struct {
+	mock *MockrevokedTokenRepository
+}
+
+// NewMockrevokedTokenRepository creates a new mock instance.
+func NewMockrevokedTokenRepository(ctrl *gomock.Controller) *MockrevokedTokenRepository {
+	mock := &MockrevokedTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockrevokedTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrevokedTokenRepository) EXPECT() *MockrevokedTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockrevokedTokenRepository) Create(ctx context.Context, jti uuid.UUID, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, jti, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockrevokedTokenRepositoryMockRecorder) Create(ctx, jti, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockrevokedTokenRepository)(nil).Create), ctx, jti, expiresAt)
+}
+
+// MocktxManager is a mock of txManager interface.
+type MocktxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktxManagerMockRecorder is the mock recorder for MocktxManager.
+type MocktxManagerMockRecorder struct {
+	mock *MocktxManager
+}
+
+// NewMocktxManager creates a new mock instance.
+func NewMocktxManager(ctrl *gomock.Controller) *MocktxManager {
+	mock := &MocktxManager{ctrl: ctrl}
+	mock.recorder = &MocktxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktxManager) EXPECT() *MocktxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktxManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktxManager)(nil).Do), ctx, fn)
+}
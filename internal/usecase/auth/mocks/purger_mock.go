@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: purger.go
+//
+// Generated by this command:
+//
+//	mockgen -source=purger.go -destination=./mocks/purger_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockexpiredTokenRepository is a mock of expiredTokenRepository interface.
+type MockexpiredTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockexpiredTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockexpiredTokenRepositoryMockRecorder is the mock recorder for MockexpiredTokenRepository.
+type MockexpiredTokenRepositoryMockRecorder struct {
+	mock *MockexpiredTokenRepository
+}
+
+// NewMockexpiredTokenRepository creates a new mock instance.
+func NewMockexpiredTokenRepository(ctrl *gomock.Controller) *MockexpiredTokenRepository {
+	mock := &MockexpiredTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockexpiredTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexpiredTokenRepository) EXPECT() *MockexpiredTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteExpired mocks base method.
+func (m *MockexpiredTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockexpiredTokenRepositoryMockRecorder) DeleteExpired(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockexpiredTokenRepository)(nil).DeleteExpired), ctx)
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=purger.go -destination=./mocks/purger_mock.go -package=mocks
+type expiredTokenRepository interface {
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// TokenPurger периодически удаляет истёкшие refresh токены и записи denylist access токенов.
+// Истёкший токен отклоняется по exp, поэтому его строки больше не нужны.
+type TokenPurger struct {
+	refreshTokenRepo expiredTokenRepository
+	revokedTokenRepo expiredTokenRepository
+	interval         time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewTokenPurger(refreshTokenRepo, revokedTokenRepo expiredTokenRepository, interval time.Duration) *TokenPurger {
+	return &TokenPurger{
+		refreshTokenRepo: refreshTokenRepo,
+		revokedTokenRepo: revokedTokenRepo,
+		interval:         interval,
+	}
+}
+
+// Start запускает фоновую горутину очистки. Остановка через Stop или отмену ctx.
+func (p *TokenPurger) Start(ctx context.Context) {
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+
+	go p.run(ctx)
+}
+
+// Stop останавливает очистку и ждёт завершения текущего прохода.
+func (p *TokenPurger) Stop(ctx context.Context) error {
+	if p.cancel == nil {
+		return nil
+	}
+	p.cancel()
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *TokenPurger) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.Purge(ctx); err != nil && ctx.Err() == nil {
+			logger.ErrorCtx(ctx, "token purger: failed to purge expired tokens", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge удаляет истёкшие токены обеих таблиц и возвращает число удалённых строк.
+// Ошибка одной таблицы не мешает очистке другой.
+func (p *TokenPurger) Purge(ctx context.Context) (int64, error) {
+	const op = "auth.Purge"
+
+	refreshDeleted, refreshErr := p.refreshTokenRepo.DeleteExpired(ctx)
+	if refreshErr != nil {
+		refreshErr = fmt.Errorf("%s: failed to delete expired refresh tokens: %w", op, refreshErr)
+	}
+
+	revokedDeleted, revokedErr := p.revokedTokenRepo.DeleteExpired(ctx)
+	if revokedErr != nil {
+		revokedErr = fmt.Errorf("%s: failed to delete expired revoked tokens: %w", op, revokedErr)
+	}
+
+	return refreshDeleted + revokedDeleted, errors.Join(refreshErr, revokedErr)
+}
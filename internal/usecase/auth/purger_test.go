@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/auth/mocks"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTokenPurger_Purge(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	type fields struct {
+		name        string
+		mockFn      func(refreshRepo, revokedRepo *mocks.MockexpiredTokenRepository)
+		wantDeleted int64
+		wantErr     error
+	}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			mockFn: func(refreshRepo, revokedRepo *mocks.MockexpiredTokenRepository) {
+				refreshRepo.EXPECT().DeleteExpired(ctx).Return(int64(3), nil).Times(1)
+				revokedRepo.EXPECT().DeleteExpired(ctx).Return(int64(2), nil).Times(1)
+			},
+			wantDeleted: 5,
+		},
+		{
+			name: "refresh tokens error does not stop denylist purge",
+			mockFn: func(refreshRepo, revokedRepo *mocks.MockexpiredTokenRepository) {
+				refreshRepo.EXPECT().DeleteExpired(ctx).Return(int64(0), errors.New("db error")).Times(1)
+				revokedRepo.EXPECT().DeleteExpired(ctx).Return(int64(2), nil).Times(1)
+			},
+			wantDeleted: 2,
+			wantErr:     errors.New("auth.Purge: failed to delete expired refresh tokens: db error"),
+		},
+		{
+			name: "revoked tokens error",
+			mockFn: func(refreshRepo, revokedRepo *mocks.MockexpiredTokenRepository) {
+				refreshRepo.EXPECT().DeleteExpired(ctx).Return(int64(1), nil).Times(1)
+				revokedRepo.EXPECT().DeleteExpired(ctx).Return(int64(0), errors.New("db error")).Times(1)
+			},
+			wantDeleted: 1,
+			wantErr:     errors.New("auth.Purge: failed to delete expired revoked tokens: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			refreshRepo := mocks.NewMockexpiredTokenRepository(ctrl)
+			revokedRepo := mocks.NewMockexpiredTokenRepository(ctrl)
+			tt.mockFn(refreshRepo, revokedRepo)
+
+			deleted, err := NewTokenPurger(refreshRepo, revokedRepo, time.Hour).Purge(ctx)
+
+			require.Equal(t, tt.wantDeleted, deleted)
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTokenPurger_StartStop(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+
+	ctrl := gomock.NewController(t)
+	refreshRepo := mocks.NewMockexpiredTokenRepository(ctrl)
+	revokedRepo := mocks.NewMockexpiredTokenRepository(ctrl)
+
+	purged := make(chan struct{}, 1)
+	refreshRepo.EXPECT().DeleteExpired(gomock.Any()).Return(int64(0), nil).MinTimes(1)
+	revokedRepo.EXPECT().
+		DeleteExpired(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) (int64, error) {
+			select {
+			case purged <- struct{}{}:
+			default:
+			}
+			return 0, nil
+		}).
+		MinTimes(1)
+
+	purger := NewTokenPurger(refreshRepo, revokedRepo, time.Hour)
+	purger.Start(context.Background())
+
+	select {
+	case <-purged:
+	case <-time.After(time.Second):
+		t.Fatal("purger did not run on start")
+	}
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, purger.Stop(stopCtx))
+}
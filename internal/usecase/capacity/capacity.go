@@ -0,0 +1,93 @@
+package capacity
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/metrics"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=capacity.go -destination=./mocks/capacity_mock.go -package=mocks
+type inventoryRepo interface {
+	InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error)
+}
+
+// Usage остаток PVZ с лимитами вместимости.
+// Загружается внутри транзакции под блокировкой PVZ, метрика обновляется после фиксации.
+type Usage struct {
+	PVZ   *domain.PVZ
+	Stock map[string]int
+}
+
+// Load возвращает текущий остаток PVZ или nil, если лимиты вместимости не заданы.
+func Load(ctx context.Context, repo inventoryRepo, pvzEnt *domain.PVZ) (*Usage, error) {
+	if !pvzEnt.HasCapacityLimits() {
+		return nil, nil
+	}
+
+	stock, err := repo.InventoryByType(ctx, pvzEnt.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Usage{PVZ: pvzEnt, Stock: stock}, nil
+}
+
+// Change загрузка PVZ после смены лимитов вместимости.
+// Кроме загрузки по действующим лимитам удаляет серии метрики снятых лимитов,
+// иначе они продолжали бы показывать последнее значение.
+type Change struct {
+	Usage *Usage
+
+	pvzID   uuid.UUID
+	removed []string
+}
+
+// LoadChange возвращает загрузку PVZ after и лимиты, снятые относительно before.
+func LoadChange(ctx context.Context, repo inventoryRepo, before, after *domain.PVZ) (*Change, error) {
+	usage, err := Load(ctx, repo, after)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &Change{Usage: usage, pvzID: after.ID}
+	if before.Capacity != nil && after.Capacity == nil {
+		change.removed = append(change.removed, metrics.CapacityTotalLabel)
+	}
+	for typeName := range before.TypeCapacities {
+		if _, ok := after.TypeCapacities[typeName]; !ok {
+			change.removed = append(change.removed, typeName)
+		}
+	}
+
+	return change, nil
+}
+
+// Report обновляет метрику загрузки PVZ, вызывается после фиксации транзакции.
+func (c *Change) Report() {
+	if c == nil {
+		return
+	}
+
+	for _, productType := range c.removed {
+		metrics.DeletePVZCapacityUtilization(c.pvzID.String(), productType)
+	}
+	c.Usage.Report()
+}
+
+// Report обновляет метрику загрузки PVZ, вызывается после фиксации транзакции.
+func (u *Usage) Report() {
+	if u == nil {
+		return
+	}
+
+	pvzID := u.PVZ.ID.String()
+	if u.PVZ.Capacity != nil {
+		metrics.SetPVZCapacityUtilization(pvzID, metrics.CapacityTotalLabel, float64(domain.StockTotal(u.Stock))/float64(*u.PVZ.Capacity))
+	}
+	for typeName, capacity := range u.PVZ.TypeCapacities {
+		metrics.SetPVZCapacityUtilization(pvzID, typeName, float64(u.Stock[typeName])/float64(capacity))
+	}
+}
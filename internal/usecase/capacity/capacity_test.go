@@ -0,0 +1,144 @@
+package capacity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/metrics"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/capacity/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	capacity := 10
+
+	type fields struct {
+		name      string
+		pvz       *domain.PVZ
+		mockFn    func(pvz *domain.PVZ, repo *mocks.MockinventoryRepo)
+		wantStock map[string]int
+		wantErr   error
+	}
+
+	testcases := []fields{
+		{
+			name:   "pvz without limits",
+			pvz:    &domain.PVZ{ID: uuid.New()},
+			mockFn: func(pvz *domain.PVZ, repo *mocks.MockinventoryRepo) {},
+		},
+		{
+			name: "pvz with total capacity",
+			pvz:  &domain.PVZ{ID: uuid.New(), Capacity: &capacity},
+			mockFn: func(pvz *domain.PVZ, repo *mocks.MockinventoryRepo) {
+				repo.EXPECT().
+					InventoryByType(ctx, pvz.ID, nil).
+					Return(map[string]int{"обувь": 3}, nil).
+					Times(1)
+			},
+			wantStock: map[string]int{"обувь": 3},
+		},
+		{
+			name: "pvz with type capacity",
+			pvz:  &domain.PVZ{ID: uuid.New(), TypeCapacities: map[string]int{"обувь": 5}},
+			mockFn: func(pvz *domain.PVZ, repo *mocks.MockinventoryRepo) {
+				repo.EXPECT().
+					InventoryByType(ctx, pvz.ID, nil).
+					Return(map[string]int{}, nil).
+					Times(1)
+			},
+			wantStock: map[string]int{},
+		},
+		{
+			name: "repo error",
+			pvz:  &domain.PVZ{ID: uuid.New(), Capacity: &capacity},
+			mockFn: func(pvz *domain.PVZ, repo *mocks.MockinventoryRepo) {
+				repo.EXPECT().
+					InventoryByType(ctx, pvz.ID, nil).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := mocks.NewMockinventoryRepo(gomock.NewController(t))
+			tt.mockFn(tt.pvz, repo)
+
+			usage, err := Load(ctx, repo, tt.pvz)
+
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+				require.Nil(t, usage)
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.wantStock == nil {
+				require.Nil(t, usage)
+				usage.Report()
+				return
+			}
+
+			require.Equal(t, tt.pvz, usage.PVZ)
+			require.Equal(t, tt.wantStock, usage.Stock)
+			usage.Report()
+		})
+	}
+}
+
+func TestLoadChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	capacity := 10
+
+	t.Run("removed limits", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockinventoryRepo(gomock.NewController(t))
+
+		pvzID := uuid.New()
+		before := &domain.PVZ{ID: pvzID, Capacity: &capacity, TypeCapacities: map[string]int{"обувь": 5, "одежда": 5}}
+		after := &domain.PVZ{ID: pvzID, TypeCapacities: map[string]int{"обувь": 5}}
+
+		repo.EXPECT().
+			InventoryByType(ctx, pvzID, nil).
+			Return(map[string]int{"обувь": 2}, nil).
+			Times(1)
+
+		change, err := LoadChange(ctx, repo, before, after)
+
+		require.NoError(t, err)
+		require.Equal(t, after, change.Usage.PVZ)
+		require.ElementsMatch(t, []string{metrics.CapacityTotalLabel, "одежда"}, change.removed)
+		change.Report()
+	})
+
+	t.Run("all limits removed", func(t *testing.T) {
+		t.Parallel()
+
+		repo := mocks.NewMockinventoryRepo(gomock.NewController(t))
+
+		pvzID := uuid.New()
+		before := &domain.PVZ{ID: pvzID, Capacity: &capacity}
+		after := &domain.PVZ{ID: pvzID}
+
+		change, err := LoadChange(ctx, repo, before, after)
+
+		require.NoError(t, err)
+		require.Nil(t, change.Usage)
+		require.Equal(t, []string{metrics.CapacityTotalLabel}, change.removed)
+		change.Report()
+	})
+}
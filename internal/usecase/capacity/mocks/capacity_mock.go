@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: capacity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=capacity.go -destination=./mocks/capacity_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockinventoryRepo is a mock of inventoryRepo interface.
+type MockinventoryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockinventoryRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockinventoryRepoMockRecorder is the mock recorder for MockinventoryRepo.
+type MockinventoryRepoMockRecorder struct {
+	mock *MockinventoryRepo
+}
+
+// NewMockinventoryRepo creates a new mock instance.
+func NewMockinventoryRepo(ctrl *gomock.Controller) *MockinventoryRepo {
+	mock := &MockinventoryRepo{ctrl: ctrl}
+	mock.recorder = &MockinventoryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinventoryRepo) EXPECT() *MockinventoryRepoMockRecorder {
+	return m.recorder
+}
+
+// InventoryByType mocks base method.
+func (m *MockinventoryRepo) InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InventoryByType", ctx, pvzID, asOf)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InventoryByType indicates an expected call of InventoryByType.
+func (mr *MockinventoryRepoMockRecorder) InventoryByType(ctx, pvzID, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InventoryByType", reflect.TypeOf((*MockinventoryRepo)(nil).InventoryByType), ctx, pvzID, asOf)
+}
@@ -0,0 +1,122 @@
+package city
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=city.go -destination=./mocks/city_mock.go -package=mocks
+type cityRepo interface {
+	Create(ctx context.Context, city domain.City) (*domain.City, error)
+	Get(ctx context.Context, filter domain.City) (*domain.City, error)
+	List(ctx context.Context, pagination *listparams.Pagination) ([]*domain.City, error)
+	Update(ctx context.Context, city domain.City) (*domain.City, error)
+	Delete(ctx context.Context, cityID uuid.UUID) error
+	HasPVZ(ctx context.Context, cityID uuid.UUID) (bool, error)
+}
+
+type txManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type CityUseCase struct {
+	cityRepo  cityRepo
+	txManager txManager
+}
+
+func New(cityRepo cityRepo, txManager txManager) *CityUseCase {
+	return &CityUseCase{
+		cityRepo,
+		txManager,
+	}
+}
+
+func (s *CityUseCase) Create(ctx context.Context, name string) (*domain.City, error) {
+	const op = "city.Create"
+
+	city, err := s.cityRepo.Create(ctx, domain.City{Name: name})
+	if err != nil {
+		if errors.Is(err, infra.ErrDuplicate) {
+			return nil, domain.ErrCityDuplicate
+		}
+		return nil, fmt.Errorf("%s: failed to create city: %w", op, err)
+	}
+
+	return city, nil
+}
+
+func (s *CityUseCase) List(ctx context.Context, pagination *listparams.Pagination) ([]*domain.City, error) {
+	const op = "city.List"
+
+	cities, err := s.cityRepo.List(ctx, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list cities: %w", op, err)
+	}
+
+	return cities, nil
+}
+
+// Update переименовывает город, PVZ города остаются привязаны к нему по id.
+func (s *CityUseCase) Update(ctx context.Context, city domain.City) (*domain.City, error) {
+	const op = "city.Update"
+
+	updated, err := s.cityRepo.Update(ctx, city)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, domain.ErrCityNotFound
+		}
+		if errors.Is(err, infra.ErrDuplicate) {
+			return nil, domain.ErrCityDuplicate
+		}
+		return nil, fmt.Errorf("%s: failed to update city: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+func (s *CityUseCase) Delete(ctx context.Context, cityID uuid.UUID) error {
+	return s.txManager.Do(ctx, func(ctx context.Context) error {
+		return s.delete(ctx, cityID)
+	})
+}
+
+// delete удаляет город без PVZ. Внешний ключ pvz.city_id страхует от PVZ,
+// созданного параллельно после проверки.
+func (s *CityUseCase) delete(ctx context.Context, cityID uuid.UUID) error {
+	const op = "city.Delete"
+
+	_, err := s.cityRepo.Get(ctx, domain.City{ID: cityID})
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return domain.ErrCityNotFound
+		}
+		return fmt.Errorf("%s: failed to get city: %w", op, err)
+	}
+
+	hasPVZ, err := s.cityRepo.HasPVZ(ctx, cityID)
+	if err != nil {
+		return fmt.Errorf("%s: failed to check city pvz: %w", op, err)
+	}
+	if hasPVZ {
+		return domain.ErrCityHasPVZ
+	}
+
+	err = s.cityRepo.Delete(ctx, cityID)
+	if err != nil {
+		switch {
+		case errors.Is(err, infra.ErrNotFound):
+			return domain.ErrCityNotFound
+		case errors.Is(err, infra.ErrReferenced):
+			return domain.ErrCityHasPVZ
+		}
+		return fmt.Errorf("%s: failed to delete city: %w", op, err)
+	}
+
+	return nil
+}
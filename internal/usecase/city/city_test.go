@@ -0,0 +1,323 @@
+package city
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/city/mocks"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+type cityMocks struct {
+	MockCityRepo  *mocks.MockcityRepo
+	MockTxManager *mocks.MocktxManager
+}
+
+func newCityMocks(t *testing.T) *cityMocks {
+	ctrl := gomock.NewController(t)
+
+	txManager := mocks.NewMocktxManager(ctrl)
+	txManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
+	return &cityMocks{
+		MockCityRepo:  mocks.NewMockcityRepo(ctrl),
+		MockTxManager: txManager,
+	}
+}
+
+func TestCityUseCase_Create(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	type fields struct {
+		name     string
+		cityName string
+		mockFn   func(f fields, m *cityMocks)
+		wantErr  error
+	}
+
+	testcases := []fields{
+		{
+			name:     "ok",
+			cityName: "Новосибирск",
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Create(ctx, domain.City{Name: f.cityName}).
+					Return(&domain.City{ID: uuid.New(), Name: f.cityName}, nil).
+					Times(1)
+			},
+		},
+		{
+			name:     "duplicate",
+			cityName: "Москва",
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Create(ctx, domain.City{Name: f.cityName}).
+					Return(nil, infra.ErrDuplicate).
+					Times(1)
+			},
+			wantErr: domain.ErrCityDuplicate,
+		},
+		{
+			name:     "repo error",
+			cityName: "Омск",
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Create(ctx, domain.City{Name: f.cityName}).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("city.Create: failed to create city: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := newCityMocks(t)
+			tt.mockFn(tt, m)
+
+			useCase := New(m.MockCityRepo, m.MockTxManager)
+
+			city, err := useCase.Create(ctx, tt.cityName)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, city)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.cityName, city.Name)
+		})
+	}
+}
+
+func TestCityUseCase_List(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pagination := &listparams.Pagination{Page: 1, Limit: 10}
+
+	m := newCityMocks(t)
+	cities := []*domain.City{{ID: uuid.New(), Name: "Казань"}, {ID: uuid.New(), Name: "Москва"}}
+	m.MockCityRepo.EXPECT().
+		List(ctx, pagination).
+		Return(cities, nil).
+		Times(1)
+
+	useCase := New(m.MockCityRepo, m.MockTxManager)
+
+	res, err := useCase.List(ctx, pagination)
+	require.NoError(t, err)
+	require.Equal(t, cities, res)
+}
+
+func TestCityUseCase_Update(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	type fields struct {
+		name    string
+		city    domain.City
+		mockFn  func(f fields, m *cityMocks)
+		wantErr error
+	}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			city: domain.City{ID: uuid.New(), Name: "Санкт-Петербург"},
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Update(ctx, f.city).
+					Return(&f.city, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "not found",
+			city: domain.City{ID: uuid.New(), Name: "Санкт-Петербург"},
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Update(ctx, f.city).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrCityNotFound,
+		},
+		{
+			name: "duplicate name",
+			city: domain.City{ID: uuid.New(), Name: "Москва"},
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Update(ctx, f.city).
+					Return(nil, infra.ErrDuplicate).
+					Times(1)
+			},
+			wantErr: domain.ErrCityDuplicate,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := newCityMocks(t)
+			tt.mockFn(tt, m)
+
+			useCase := New(m.MockCityRepo, m.MockTxManager)
+
+			city, err := useCase.Update(ctx, tt.city)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, city)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.city, *city)
+		})
+	}
+}
+
+func TestCityUseCase_Delete(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	type fields struct {
+		name    string
+		cityID  uuid.UUID
+		mockFn  func(f fields, m *cityMocks)
+		wantErr error
+	}
+
+	testcases := []fields{
+		{
+			name:   "ok",
+			cityID: uuid.New(),
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Get(ctx, domain.City{ID: f.cityID}).
+					Return(&domain.City{ID: f.cityID, Name: "Казань"}, nil).
+					Times(1)
+
+				m.MockCityRepo.EXPECT().
+					HasPVZ(ctx, f.cityID).
+					Return(false, nil).
+					Times(1)
+
+				m.MockCityRepo.EXPECT().
+					Delete(ctx, f.cityID).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:   "not found",
+			cityID: uuid.New(),
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Get(ctx, domain.City{ID: f.cityID}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrCityNotFound,
+		},
+		{
+			name:   "city has pvz",
+			cityID: uuid.New(),
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Get(ctx, domain.City{ID: f.cityID}).
+					Return(&domain.City{ID: f.cityID, Name: "Казань"}, nil).
+					Times(1)
+
+				m.MockCityRepo.EXPECT().
+					HasPVZ(ctx, f.cityID).
+					Return(true, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrCityHasPVZ,
+		},
+		{
+			name:   "pvz created concurrently",
+			cityID: uuid.New(),
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Get(ctx, domain.City{ID: f.cityID}).
+					Return(&domain.City{ID: f.cityID, Name: "Казань"}, nil).
+					Times(1)
+
+				m.MockCityRepo.EXPECT().
+					HasPVZ(ctx, f.cityID).
+					Return(false, nil).
+					Times(1)
+
+				m.MockCityRepo.EXPECT().
+					Delete(ctx, f.cityID).
+					Return(infra.ErrReferenced).
+					Times(1)
+			},
+			wantErr: domain.ErrCityHasPVZ,
+		},
+		{
+			name:   "check pvz error",
+			cityID: uuid.New(),
+			mockFn: func(f fields, m *cityMocks) {
+				m.MockCityRepo.EXPECT().
+					Get(ctx, domain.City{ID: f.cityID}).
+					Return(&domain.City{ID: f.cityID, Name: "Казань"}, nil).
+					Times(1)
+
+				m.MockCityRepo.EXPECT().
+					HasPVZ(ctx, f.cityID).
+					Return(false, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("city.Delete: failed to check city pvz: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := newCityMocks(t)
+			tt.mockFn(tt, m)
+
+			useCase := New(m.MockCityRepo, m.MockTxManager)
+
+			err := useCase.Delete(ctx, tt.cityID)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: city.go
+//
+// Generated by this command:
+//
+//	mockgen -source=city.go -destination=./mocks/city_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	domain "github.com/valeragav/avito-pvz-service/internal/domain"
+	listparams "github.com/valeragav/avito-pvz-service/pkg/listparams"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockcityRepo is a mock of cityRepo interface.
+type MockcityRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockcityRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockcityRepoMockRecorder is the mock recorder for MockcityRepo.
+type MockcityRepoMockRecorder struct {
+	mock *MockcityRepo
+}
+
+// NewMockcityRepo creates a new mock instance.
+func NewMockcityRepo(ctrl *gomock.Controller) *MockcityRepo {
+	mock := &MockcityRepo{ctrl: ctrl}
+	mock.recorder = &MockcityRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcityRepo) EXPECT() *MockcityRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockcityRepo) Create(ctx context.Context, city domain.City) (*domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, city)
+	ret0, _ := ret[0].(*domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockcityRepoMockRecorder) Create(ctx, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockcityRepo)(nil).Create), ctx, city)
+}
+
+// Delete mocks base method.
+func (m *MockcityRepo) Delete(ctx context.Context, cityID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, cityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockcityRepoMockRecorder) Delete(ctx, cityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockcityRepo)(nil).Delete), ctx, cityID)
+}
+
+// Get mocks base method.
+func (m *MockcityRepo) Get(ctx context.Context, filter domain.City) (*domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, filter)
+	ret0, _ := ret[0].(*domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockcityRepoMockRecorder) Get(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockcityRepo)(nil).Get), ctx, filter)
+}
+
+// HasPVZ mocks base method.
+func (m *MockcityRepo) HasPVZ(ctx context.Context, cityID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPVZ", ctx, cityID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPVZ indicates an expected call of HasPVZ.
+func (mr *MockcityRepoMockRecorder) HasPVZ(ctx, cityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPVZ", reflect.TypeOf((*MockcityRepo)(nil).HasPVZ), ctx, cityID)
+}
+
+// List mocks base method.
+func (m *MockcityRepo) List(ctx context.Context, pagination *listparams.Pagination) ([]*domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, pagination)
+	ret0, _ := ret[0].([]*domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockcityRepoMockRecorder) List(ctx, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockcityRepo)(nil).List), ctx, pagination)
+}
+
+// Update mocks base method.
+func (m *MockcityRepo) Update(ctx context.Context, city domain.City) (*domain.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, city)
+	ret0, _ := ret[0].(*domain.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockcityRepoMockRecorder) Update(ctx, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockcityRepo)(nil).Update), ctx, city)
+}
+
+// MocktxManager is a mock of txManager interface.
+type MocktxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktxManagerMockRecorder is the mock recorder for MocktxManager.
+type MocktxManagerMockRecorder struct {
+	mock *MocktxManager
+}
+
+// NewMocktxManager creates a new mock instance.
+func NewMocktxManager(ctrl *gomock.Controller) *MocktxManager {
+	mock := &MocktxManager{ctrl: ctrl}
+	mock.recorder = &MocktxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktxManager) EXPECT() *MocktxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktxManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktxManager)(nil).Do), ctx, fn)
+}
@@ -0,0 +1,11 @@
+package dto
+
+import (
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+)
+
+type AuditListParams struct {
+	Filter     domain.AuditFilter
+	Pagination *listparams.Pagination
+}
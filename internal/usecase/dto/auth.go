@@ -10,3 +10,12 @@ type LoginIn struct {
 	Email    string
 	Password string
 }
+
+type RefreshIn struct {
+	RefreshToken string
+}
+
+type LogoutIn struct {
+	RefreshToken string
+	AccessToken  string
+}
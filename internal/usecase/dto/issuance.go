@@ -0,0 +1,15 @@
+package dto
+
+import "github.com/google/uuid"
+
+type IssuanceCreate struct {
+	ProductID uuid.UUID
+	Customer  string
+	IssuedBy  uuid.UUID
+}
+
+type IssuanceReturn struct {
+	ProductID  uuid.UUID
+	Reason     string
+	ReturnedBy uuid.UUID
+}
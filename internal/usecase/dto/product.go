@@ -2,9 +2,48 @@ package dto
 
 import (
 	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
 )
 
 type ProductCreate struct {
+	TypeName  string
+	Barcode   string
+	PvzID     uuid.UUID
+	CreatedBy uuid.UUID
+}
+
+type ProductDeleteLast struct {
+	PvzID     uuid.UUID
+	DeletedBy uuid.UUID
+}
+
+type ProductDelete struct {
+	ProductID uuid.UUID
+	DeletedBy uuid.UUID
+}
+
+type ProductBatchItem struct {
 	TypeName string
-	PvzID    uuid.UUID
+	Barcode  string
+}
+
+type ProductBatchCreate struct {
+	PvzID     uuid.UUID
+	Items     []ProductBatchItem
+	CreatedBy uuid.UUID
+	// AllOrNothing отменяет весь пакет при ошибке хотя бы в одном товаре
+	AllOrNothing bool
+}
+
+// ProductBatchResult результат по одному товару пакета, в порядке входных items.
+type ProductBatchResult struct {
+	Product *domain.Product
+	Err     error
+}
+
+// ProductLocation товар вместе с приёмкой и PVZ, которые его приняли.
+type ProductLocation struct {
+	Product   *domain.Product
+	Reception *domain.Reception
+	PVZ       *domain.PVZ
 }
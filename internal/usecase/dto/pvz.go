@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 )
 
@@ -11,14 +12,68 @@ type PVZCreate struct {
 	ID               uuid.UUID
 	CityName         string
 	RegistrationDate time.Time
+	CreatedBy        uuid.UUID
+	Address          string
+	Location         *domain.GeoPoint
+	WorkingHours     domain.WorkingHours
+	Capacity         *int
+	TypeCapacities   map[string]int
+}
+
+// PVZProfileUpdate частичное обновление профиля PVZ, nil поля не меняются.
+// Пустые не nil WorkingHours и TypeCapacities очищают расписание и лимиты по типам,
+// ClearLocation и ClearCapacity удаляют координаты и общий лимит вместимости.
+type PVZProfileUpdate struct {
+	PvzID          uuid.UUID
+	Address        *string
+	Location       *domain.GeoPoint
+	ClearLocation  bool
+	WorkingHours   domain.WorkingHours
+	Capacity       *int
+	ClearCapacity  bool
+	TypeCapacities map[string]int
+	ActorID        uuid.UUID
+}
+
+// PVZStatusChange EffectiveAt nil означает текущий момент, другое значение должно с ним совпадать.
+type PVZStatusChange struct {
+	PvzID       uuid.UUID
+	Status      domain.PVZStatus
+	Reason      string
+	EffectiveAt *time.Time
+	ActorID     uuid.UUID
 }
 
 type PVZListParams struct {
 	Filter     *PVZFilter
+	Sort       []listparams.Sort
 	Pagination *listparams.Pagination
+	Cursor     *listparams.Cursor
 }
 
 type PVZFilter struct {
-	StartDate *time.Time
-	EndDate   *time.Time
+	StartDate         *time.Time
+	EndDate           *time.Time
+	Cities            []string
+	ReceptionStatuses []domain.ReceptionStatusCode
+	ProductTypes      []string
+}
+
+// PVZNearbyParams нулевые RadiusKm и Limit заменяются значениями по умолчанию.
+type PVZNearbyParams struct {
+	Point    domain.GeoPoint
+	RadiusKm float64
+	Limit    uint
+}
+
+type PVZInventoryParams struct {
+	PvzID uuid.UUID
+	AsOf  *time.Time
+}
+
+type PVZDetailParams struct {
+	PvzID           uuid.UUID
+	Filter          *PVZFilter
+	Pagination      *listparams.Pagination
+	IncludeProducts bool
 }
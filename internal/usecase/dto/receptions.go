@@ -1,7 +1,28 @@
 package dto
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type ReceptionCreate struct {
-	PvzID uuid.UUID
+	PvzID     uuid.UUID
+	CreatedBy uuid.UUID
+}
+
+type ReceptionClose struct {
+	PvzID    uuid.UUID
+	ClosedBy uuid.UUID
+}
+
+type ReceptionTransition struct {
+	ReceptionID uuid.UUID
+	ActorID     uuid.UUID
+}
+
+// ReceptionExport период выгрузки приёмок, nil означает без ограничения.
+type ReceptionExport struct {
+	StartDate *time.Time
+	EndDate   *time.Time
 }
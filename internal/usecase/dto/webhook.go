@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+)
+
+type WebhookSubscriptionCreate struct {
+	URL        string
+	Secret     string
+	EventTypes []domain.EventType
+	CreatedBy  uuid.UUID
+}
+
+type WebhookDeliveryListParams struct {
+	SubscriptionID uuid.UUID
+	Pagination     *listparams.Pagination
+}
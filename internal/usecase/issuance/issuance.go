@@ -0,0 +1,279 @@
+package issuance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/capacity"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=issuance.go -destination=./mocks/issuance_mock.go -package=mocks
+type issuanceRepo interface {
+	Create(ctx context.Context, issuance domain.Issuance) (*domain.Issuance, error)
+	GetLastByProduct(ctx context.Context, productID uuid.UUID, kind domain.IssuanceKind) (*domain.Issuance, error)
+	ListByProduct(ctx context.Context, productID uuid.UUID) ([]*domain.Issuance, error)
+}
+
+type productRepo interface {
+	Get(ctx context.Context, filter domain.Product) (*domain.Product, error)
+	SetIssued(ctx context.Context, productID uuid.UUID, issued bool) (*domain.Product, error)
+	InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error)
+}
+
+type receptionRepo interface {
+	GetWithStatus(ctx context.Context, receptionID uuid.UUID) (*domain.Reception, error)
+}
+
+type pvzRepo interface {
+	GetForUpdate(ctx context.Context, pvzID uuid.UUID) (*domain.PVZ, error)
+}
+
+type txManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type auditRecorder interface {
+	Record(ctx context.Context, event domain.AuditEvent) error
+}
+
+type eventEmitter interface {
+	Emit(ctx context.Context, event domain.OutboxEvent) error
+}
+
+type IssuanceUseCase struct {
+	issuanceRepo  issuanceRepo
+	productRepo   productRepo
+	receptionRepo receptionRepo
+	pvzRepo       pvzRepo
+	txManager     txManager
+	auditRecorder auditRecorder
+	eventEmitter  eventEmitter
+}
+
+func New(
+	issuanceRepo issuanceRepo,
+	productRepo productRepo,
+	receptionRepo receptionRepo,
+	pvzRepo pvzRepo,
+	txManager txManager,
+	auditRecorder auditRecorder,
+	eventEmitter eventEmitter,
+) *IssuanceUseCase {
+	return &IssuanceUseCase{
+		issuanceRepo,
+		productRepo,
+		receptionRepo,
+		pvzRepo,
+		txManager,
+		auditRecorder,
+		eventEmitter,
+	}
+}
+
+func (s *IssuanceUseCase) Issue(ctx context.Context, issueIn dto.IssuanceCreate) (*domain.Issuance, error) {
+	var (
+		res   *domain.Issuance
+		usage *capacity.Usage
+	)
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, usage, err = s.issue(ctx, issueIn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Report()
+
+	return res, nil
+}
+
+// issue выдаёт клиенту товар из закрытой приёмки.
+func (s *IssuanceUseCase) issue(ctx context.Context, issueIn dto.IssuanceCreate) (*domain.Issuance, *capacity.Usage, error) {
+	const op = "issuance.Issue"
+
+	pvzEnt, reception, err := s.lockProductReception(ctx, issueIn.ProductID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// товары открытой или отменённой приёмки ещё не приняты на склад
+	if reception.ReceptionStatus == nil || reception.ReceptionStatus.Name != domain.ReceptionStatusClose {
+		return nil, nil, domain.ErrProductNotReceived
+	}
+
+	product, err := s.productRepo.SetIssued(ctx, issueIn.ProductID, true)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, domain.ErrProductAlreadyIssued
+		}
+		return nil, nil, fmt.Errorf("%s: failed to mark product issued: %w", op, err)
+	}
+
+	issuance, err := s.issuanceRepo.Create(ctx, domain.Issuance{
+		ProductID: product.ID,
+		PvzID:     reception.PvzID,
+		Kind:      domain.IssuanceKindIssue,
+		Customer:  issueIn.Customer,
+		ActorID:   issueIn.IssuedBy,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to create issuance: %w", op, err)
+	}
+
+	usage, err := capacity.Load(ctx, s.productRepo, pvzEnt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get pvz stock: %w", op, err)
+	}
+
+	err = s.record(ctx, issuance, domain.AuditActionProductIssued, domain.EventProductIssued)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return issuance, usage, nil
+}
+
+func (s *IssuanceUseCase) Return(ctx context.Context, returnIn dto.IssuanceReturn) (*domain.Issuance, error) {
+	var (
+		res   *domain.Issuance
+		usage *capacity.Usage
+	)
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, usage, err = s.returnProduct(ctx, returnIn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Report()
+
+	return res, nil
+}
+
+// returnProduct принимает от клиента выданный товар обратно на склад PVZ.
+func (s *IssuanceUseCase) returnProduct(ctx context.Context, returnIn dto.IssuanceReturn) (*domain.Issuance, *capacity.Usage, error) {
+	const op = "issuance.Return"
+
+	pvzEnt, reception, err := s.lockProductReception(ctx, returnIn.ProductID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	product, err := s.productRepo.SetIssued(ctx, returnIn.ProductID, false)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, domain.ErrProductNotIssued
+		}
+		return nil, nil, fmt.Errorf("%s: failed to mark product returned: %w", op, err)
+	}
+
+	issue, err := s.issuanceRepo.GetLastByProduct(ctx, product.ID, domain.IssuanceKindIssue)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get issuance: %w", op, err)
+	}
+
+	issuance, err := s.issuanceRepo.Create(ctx, domain.Issuance{
+		ProductID: product.ID,
+		PvzID:     reception.PvzID,
+		Kind:      domain.IssuanceKindReturn,
+		IssueID:   issue.ID,
+		Customer:  issue.Customer,
+		Reason:    returnIn.Reason,
+		ActorID:   returnIn.ReturnedBy,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to create return: %w", op, err)
+	}
+
+	usage, err := capacity.Load(ctx, s.productRepo, pvzEnt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get pvz stock: %w", op, err)
+	}
+
+	err = s.record(ctx, issuance, domain.AuditActionProductReturned, domain.EventProductReturned)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return issuance, usage, nil
+}
+
+func (s *IssuanceUseCase) ListByProduct(ctx context.Context, productID uuid.UUID) ([]*domain.Issuance, error) {
+	const op = "issuance.ListByProduct"
+
+	_, err := s.productRepo.Get(ctx, domain.Product{ID: productID})
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("%s: failed to get product: %w", op, err)
+	}
+
+	issuances, err := s.issuanceRepo.ListByProduct(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list issuances: %w", op, err)
+	}
+
+	return issuances, nil
+}
+
+// lockProductReception блокирует PVZ товара и возвращает его вместе с приёмкой,
+// перечитанной после блокировки, чтобы статус не поменялся параллельно.
+func (s *IssuanceUseCase) lockProductReception(ctx context.Context, productID uuid.UUID) (*domain.PVZ, *domain.Reception, error) {
+	product, err := s.productRepo.Get(ctx, domain.Product{ID: productID})
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, domain.ErrProductNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	reception, err := s.receptionRepo.GetWithStatus(ctx, product.ReceptionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get reception: %w", err)
+	}
+
+	pvzEnt, err := s.pvzRepo.GetForUpdate(ctx, reception.PvzID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to lock pvz: %w", err)
+	}
+
+	reception, err = s.receptionRepo.GetWithStatus(ctx, product.ReceptionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get reception: %w", err)
+	}
+
+	return pvzEnt, reception, nil
+}
+
+func (s *IssuanceUseCase) record(ctx context.Context, issuance *domain.Issuance, action domain.AuditAction, eventType domain.EventType) error {
+	err := s.auditRecorder.Record(ctx, domain.AuditEvent{
+		ActorID:  issuance.ActorID,
+		Action:   action,
+		EntityID: issuance.ProductID,
+		PvzID:    issuance.PvzID,
+		After:    issuance,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+		Type:        eventType,
+		AggregateID: issuance.ProductID,
+		PvzID:       issuance.PvzID,
+		Payload:     issuance,
+	})
+}
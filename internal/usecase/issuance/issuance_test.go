@@ -0,0 +1,436 @@
+package issuance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/issuance/mocks"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+type issuanceMocks struct {
+	MockIssuanceRepo  *mocks.MockissuanceRepo
+	MockProductRepo   *mocks.MockproductRepo
+	MockReceptionRepo *mocks.MockreceptionRepo
+	MockPvzRepo       *mocks.MockpvzRepo
+	MockTxManager     *mocks.MocktxManager
+	MockAuditRecorder *mocks.MockauditRecorder
+	MockEventEmitter  *mocks.MockeventEmitter
+}
+
+func newIssuanceMocks(t *testing.T) *issuanceMocks {
+	ctrl := gomock.NewController(t)
+
+	txManager := mocks.NewMocktxManager(ctrl)
+	txManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
+	return &issuanceMocks{
+		MockIssuanceRepo:  mocks.NewMockissuanceRepo(ctrl),
+		MockProductRepo:   mocks.NewMockproductRepo(ctrl),
+		MockReceptionRepo: mocks.NewMockreceptionRepo(ctrl),
+		MockPvzRepo:       mocks.NewMockpvzRepo(ctrl),
+		MockTxManager:     txManager,
+		MockAuditRecorder: mocks.NewMockauditRecorder(ctrl),
+		MockEventEmitter:  mocks.NewMockeventEmitter(ctrl),
+	}
+}
+
+func (m *issuanceMocks) useCase() *IssuanceUseCase {
+	return New(
+		m.MockIssuanceRepo,
+		m.MockProductRepo,
+		m.MockReceptionRepo,
+		m.MockPvzRepo,
+		m.MockTxManager,
+		m.MockAuditRecorder,
+		m.MockEventEmitter,
+	)
+}
+
+// expectLock настраивает чтение товара, его приёмки и блокировку PVZ.
+func (m *issuanceMocks) expectLock(ctx context.Context, productID, pvzID uuid.UUID, status domain.ReceptionStatusCode) {
+	receptionID := uuid.New()
+	reception := &domain.Reception{
+		ID:              receptionID,
+		PvzID:           pvzID,
+		ReceptionStatus: &domain.ReceptionStatus{Name: status},
+	}
+
+	m.MockProductRepo.EXPECT().
+		Get(ctx, domain.Product{ID: productID}).
+		Return(&domain.Product{ID: productID, ReceptionID: receptionID}, nil).
+		Times(1)
+
+	m.MockReceptionRepo.EXPECT().
+		GetWithStatus(ctx, receptionID).
+		Return(reception, nil).
+		Times(2)
+
+	m.MockPvzRepo.EXPECT().
+		GetForUpdate(ctx, pvzID).
+		Return(&domain.PVZ{ID: pvzID}, nil).
+		Times(1)
+}
+
+func TestIssuanceUseCase_Issue(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	type fields struct {
+		name    string
+		req     dto.IssuanceCreate
+		mockFn  func(f fields, m *issuanceMocks)
+		wantErr error
+	}
+
+	pvzID := uuid.New()
+
+	testcases := []fields{
+		{
+			name: "ok",
+			req: dto.IssuanceCreate{
+				ProductID: uuid.New(),
+				Customer:  "Ivanov",
+				IssuedBy:  uuid.New(),
+			},
+			mockFn: func(f fields, m *issuanceMocks) {
+				m.expectLock(ctx, f.req.ProductID, pvzID, domain.ReceptionStatusClose)
+
+				m.MockProductRepo.EXPECT().
+					SetIssued(ctx, f.req.ProductID, true).
+					Return(&domain.Product{ID: f.req.ProductID, Issued: true}, nil).
+					Times(1)
+
+				m.MockIssuanceRepo.EXPECT().
+					Create(ctx, domain.Issuance{
+						ProductID: f.req.ProductID,
+						PvzID:     pvzID,
+						Kind:      domain.IssuanceKindIssue,
+						Customer:  f.req.Customer,
+						ActorID:   f.req.IssuedBy,
+					}).
+					DoAndReturn(func(ctx context.Context, i domain.Issuance) (*domain.Issuance, error) {
+						i.ID = uuid.New()
+						return &i, nil
+					}).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionProductIssued, e.Action)
+						require.Equal(t, pvzID, e.PvzID)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventProductIssued, e.Type)
+						require.Equal(t, f.req.ProductID, e.AggregateID)
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "product not found",
+			req:  dto.IssuanceCreate{ProductID: uuid.New(), Customer: "Ivanov"},
+			mockFn: func(f fields, m *issuanceMocks) {
+				m.MockProductRepo.EXPECT().
+					Get(ctx, domain.Product{ID: f.req.ProductID}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrProductNotFound,
+		},
+		{
+			name: "reception in progress",
+			req:  dto.IssuanceCreate{ProductID: uuid.New(), Customer: "Ivanov"},
+			mockFn: func(f fields, m *issuanceMocks) {
+				m.expectLock(ctx, f.req.ProductID, pvzID, domain.ReceptionStatusInProgress)
+			},
+			wantErr: domain.ErrProductNotReceived,
+		},
+		{
+			name: "already issued",
+			req:  dto.IssuanceCreate{ProductID: uuid.New(), Customer: "Ivanov"},
+			mockFn: func(f fields, m *issuanceMocks) {
+				m.expectLock(ctx, f.req.ProductID, pvzID, domain.ReceptionStatusClose)
+
+				m.MockProductRepo.EXPECT().
+					SetIssued(ctx, f.req.ProductID, true).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrProductAlreadyIssued,
+		},
+		{
+			name: "create issuance error",
+			req:  dto.IssuanceCreate{ProductID: uuid.New(), Customer: "Ivanov"},
+			mockFn: func(f fields, m *issuanceMocks) {
+				m.expectLock(ctx, f.req.ProductID, pvzID, domain.ReceptionStatusClose)
+
+				m.MockProductRepo.EXPECT().
+					SetIssued(ctx, f.req.ProductID, true).
+					Return(&domain.Product{ID: f.req.ProductID, Issued: true}, nil).
+					Times(1)
+
+				m.MockIssuanceRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("issuance.Issue: failed to create issuance: db error"),
+		},
+		{
+			name: "stock error after issue",
+			req:  dto.IssuanceCreate{ProductID: uuid.New(), Customer: "Ivanov"},
+			mockFn: func(f fields, m *issuanceMocks) {
+				receptionID := uuid.New()
+				capacity := 10
+
+				m.MockProductRepo.EXPECT().
+					Get(ctx, domain.Product{ID: f.req.ProductID}).
+					Return(&domain.Product{ID: f.req.ProductID, ReceptionID: receptionID}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					GetWithStatus(ctx, receptionID).
+					Return(&domain.Reception{
+						ID:              receptionID,
+						PvzID:           pvzID,
+						ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusClose},
+					}, nil).
+					Times(2)
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, pvzID).
+					Return(&domain.PVZ{ID: pvzID, Capacity: &capacity}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					SetIssued(ctx, f.req.ProductID, true).
+					Return(&domain.Product{ID: f.req.ProductID, Issued: true}, nil).
+					Times(1)
+
+				m.MockIssuanceRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, i domain.Issuance) (*domain.Issuance, error) {
+						i.ID = uuid.New()
+						return &i, nil
+					}).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, pvzID, nil).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("issuance.Issue: failed to get pvz stock: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			issuanceMocks := newIssuanceMocks(t)
+			tt.mockFn(tt, issuanceMocks)
+
+			issuance, err := issuanceMocks.useCase().Issue(ctx, tt.req)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, issuance)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, issuance)
+			require.NotEqual(t, uuid.Nil, issuance.ID)
+		})
+	}
+}
+
+func TestIssuanceUseCase_Return(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	type fields struct {
+		name    string
+		req     dto.IssuanceReturn
+		mockFn  func(f fields, m *issuanceMocks)
+		wantErr error
+	}
+
+	pvzID := uuid.New()
+	issueID := uuid.New()
+
+	testcases := []fields{
+		{
+			name: "ok",
+			req: dto.IssuanceReturn{
+				ProductID:  uuid.New(),
+				Reason:     "damaged",
+				ReturnedBy: uuid.New(),
+			},
+			mockFn: func(f fields, m *issuanceMocks) {
+				m.expectLock(ctx, f.req.ProductID, pvzID, domain.ReceptionStatusClose)
+
+				m.MockProductRepo.EXPECT().
+					SetIssued(ctx, f.req.ProductID, false).
+					Return(&domain.Product{ID: f.req.ProductID}, nil).
+					Times(1)
+
+				m.MockIssuanceRepo.EXPECT().
+					GetLastByProduct(ctx, f.req.ProductID, domain.IssuanceKindIssue).
+					Return(&domain.Issuance{ID: issueID, Customer: "Ivanov"}, nil).
+					Times(1)
+
+				// возврат ссылается на исходную выдачу и наследует клиента
+				m.MockIssuanceRepo.EXPECT().
+					Create(ctx, domain.Issuance{
+						ProductID: f.req.ProductID,
+						PvzID:     pvzID,
+						Kind:      domain.IssuanceKindReturn,
+						IssueID:   issueID,
+						Customer:  "Ivanov",
+						Reason:    f.req.Reason,
+						ActorID:   f.req.ReturnedBy,
+					}).
+					DoAndReturn(func(ctx context.Context, i domain.Issuance) (*domain.Issuance, error) {
+						i.ID = uuid.New()
+						return &i, nil
+					}).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionProductReturned, e.Action)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventProductReturned, e.Type)
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "not issued",
+			req:  dto.IssuanceReturn{ProductID: uuid.New()},
+			mockFn: func(f fields, m *issuanceMocks) {
+				m.expectLock(ctx, f.req.ProductID, pvzID, domain.ReceptionStatusClose)
+
+				m.MockProductRepo.EXPECT().
+					SetIssued(ctx, f.req.ProductID, false).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrProductNotIssued,
+		},
+		{
+			name: "issue lookup error",
+			req:  dto.IssuanceReturn{ProductID: uuid.New()},
+			mockFn: func(f fields, m *issuanceMocks) {
+				m.expectLock(ctx, f.req.ProductID, pvzID, domain.ReceptionStatusClose)
+
+				m.MockProductRepo.EXPECT().
+					SetIssued(ctx, f.req.ProductID, false).
+					Return(&domain.Product{ID: f.req.ProductID}, nil).
+					Times(1)
+
+				m.MockIssuanceRepo.EXPECT().
+					GetLastByProduct(ctx, f.req.ProductID, domain.IssuanceKindIssue).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("issuance.Return: failed to get issuance: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			issuanceMocks := newIssuanceMocks(t)
+			tt.mockFn(tt, issuanceMocks)
+
+			issuance, err := issuanceMocks.useCase().Return(ctx, tt.req)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, issuance)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, issuance)
+			require.Equal(t, issueID, issuance.IssueID)
+		})
+	}
+}
+
+func TestIssuanceUseCase_ListByProduct(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	t.Run("ok", func(t *testing.T) {
+		t.Parallel()
+
+		m := newIssuanceMocks(t)
+		productID := uuid.New()
+
+		m.MockProductRepo.EXPECT().
+			Get(ctx, domain.Product{ID: productID}).
+			Return(&domain.Product{ID: productID}, nil)
+		m.MockIssuanceRepo.EXPECT().
+			ListByProduct(ctx, productID).
+			Return([]*domain.Issuance{{ProductID: productID}}, nil)
+
+		issuances, err := m.useCase().ListByProduct(ctx, productID)
+		require.NoError(t, err)
+		require.Len(t, issuances, 1)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		t.Parallel()
+
+		m := newIssuanceMocks(t)
+		productID := uuid.New()
+
+		m.MockProductRepo.EXPECT().
+			Get(ctx, domain.Product{ID: productID}).
+			Return(nil, infra.ErrNotFound)
+
+		_, err := m.useCase().ListByProduct(ctx, productID)
+		require.ErrorIs(t, err, domain.ErrProductNotFound)
+	})
+}
@@ -0,0 +1,350 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: issuance.go
+//
+// Generated by this command:
+//
+//	mockgen -source=issuance.go -destination=./mocks/issuance_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	domain "github.com/valeragav/avito-pvz-service/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockissuanceRepo is a mock of issuanceRepo interface.
+type MockissuanceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockissuanceRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockissuanceRepoMockRecorder is the mock recorder for MockissuanceRepo.
+type MockissuanceRepoMockRecorder struct {
+	mock *MockissuanceRepo
+}
+
+// NewMockissuanceRepo creates a new mock instance.
+func NewMockissuanceRepo(ctrl *gomock.Controller) *MockissuanceRepo {
+	mock := &MockissuanceRepo{ctrl: ctrl}
+	mock.recorder = &MockissuanceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockissuanceRepo) EXPECT() *MockissuanceRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockissuanceRepo) Create(ctx context.Context, issuance domain.Issuance) (*domain.Issuance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, issuance)
+	ret0, _ := ret[0].(*domain.Issuance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockissuanceRepoMockRecorder) Create(ctx, issuance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockissuanceRepo)(nil).Create), ctx, issuance)
+}
+
+// GetLastByProduct mocks base method.
+func (m *MockissuanceRepo) GetLastByProduct(ctx context.Context, productID uuid.UUID, kind domain.IssuanceKind) (*domain.Issuance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastByProduct", ctx, productID, kind)
+	ret0, _ := ret[0].(*domain.Issuance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastByProduct indicates an expected call of GetLastByProduct.
+func (mr *MockissuanceRepoMockRecorder) GetLastByProduct(ctx, productID, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastByProduct", reflect.TypeOf((*MockissuanceRepo)(nil).GetLastByProduct), ctx, productID, kind)
+}
+
+// ListByProduct mocks base method.
+func (m *MockissuanceRepo) ListByProduct(ctx context.Context, productID uuid.UUID) ([]*domain.Issuance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByProduct", ctx, productID)
+	ret0, _ := ret[0].([]*domain.Issuance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByProduct indicates an expected call of ListByProduct.
+func (mr *MockissuanceRepoMockRecorder) ListByProduct(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByProduct", reflect.TypeOf((*MockissuanceRepo)(nil).ListByProduct), ctx, productID)
+}
+
+// MockproductRepo is a mock of productRepo interface.
+type MockproductRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockproductRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockproductRepoMockRecorder is the mock recorder for MockproductRepo.
+type MockproductRepoMockRecorder struct {
+	mock *MockproductRepo
+}
+
+// NewMockproductRepo creates a new mock instance.
+func NewMockproductRepo(ctrl *gomock.Controller) *MockproductRepo {
+	mock := &MockproductRepo{ctrl: ctrl}
+	mock.recorder = &MockproductRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproductRepo) EXPECT() *MockproductRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockproductRepo) Get(ctx context.Context, filter domain.Product) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, filter)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockproductRepoMockRecorder) Get(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockproductRepo)(nil).Get), ctx, filter)
+}
+
+// InventoryByType mocks base method.
+func (m *MockproductRepo) InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InventoryByType", ctx, pvzID, asOf)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InventoryByType indicates an expected call of InventoryByType.
+func (mr *MockproductRepoMockRecorder) InventoryByType(ctx, pvzID, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InventoryByType", reflect.TypeOf((*MockproductRepo)(nil).InventoryByType), ctx, pvzID, asOf)
+}
+
+// SetIssued mocks base method.
+func (m *MockproductRepo) SetIssued(ctx context.Context, productID uuid.UUID, issued bool) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIssued", ctx, productID, issued)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIssued indicates an expected call of SetIssued.
+func (mr *MockproductRepoMockRecorder) SetIssued(ctx, productID, issued any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIssued", reflect.TypeOf((*MockproductRepo)(nil).SetIssued), ctx, productID, issued)
+}
+
+// MockreceptionRepo is a mock of receptionRepo interface.
+type MockreceptionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockreceptionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockreceptionRepoMockRecorder is the mock recorder for MockreceptionRepo.
+type MockreceptionRepoMockRecorder struct {
+	mock *MockreceptionRepo
+}
+
+// NewMockreceptionRepo creates a new mock instance.
+func NewMockreceptionRepo(ctrl *gomock.Controller) *MockreceptionRepo {
+	mock := &MockreceptionRepo{ctrl: ctrl}
+	mock.recorder = &MockreceptionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreceptionRepo) EXPECT() *MockreceptionRepoMockRecorder {
+	return m.recorder
+}
+
+// GetWithStatus mocks base method.
+func (m *MockreceptionRepo) GetWithStatus(ctx context.Context, receptionID uuid.UUID) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithStatus", ctx, receptionID)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithStatus indicates an expected call of GetWithStatus.
+func (mr *MockreceptionRepoMockRecorder) GetWithStatus(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithStatus", reflect.TypeOf((*MockreceptionRepo)(nil).GetWithStatus), ctx, receptionID)
+}
+
+// MockpvzRepo is a mock of pvzRepo interface.
+type MockpvzRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockpvzRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockpvzRepoMockRecorder is the mock recorder for MockpvzRepo.
+type MockpvzRepoMockRecorder struct {
+	mock *MockpvzRepo
+}
+
+// NewMockpvzRepo creates a new mock instance.
+func NewMockpvzRepo(ctrl *gomock.Controller) *MockpvzRepo {
+	mock := &MockpvzRepo{ctrl: ctrl}
+	mock.recorder = &MockpvzRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpvzRepo) EXPECT() *MockpvzRepoMockRecorder {
+	return m.recorder
+}
+
+// GetForUpdate mocks base method.
+func (m *MockpvzRepo) GetForUpdate(ctx context.Context, pvzID uuid.UUID) (*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, pvzID)
+	ret0, _ := ret[0].(*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockpvzRepoMockRecorder) GetForUpdate(ctx, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockpvzRepo)(nil).GetForUpdate), ctx, pvzID)
+}
+
+// MocktxManager is a mock of txManager interface.
+type MocktxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktxManagerMockRecorder is the mock recorder for MocktxManager.
+type MocktxManagerMockRecorder struct {
+	mock *MocktxManager
+}
+
+// NewMocktxManager creates a new mock instance.
+func NewMocktxManager(ctrl *gomock.Controller) *MocktxManager {
+	mock := &MocktxManager{ctrl: ctrl}
+	mock.recorder = &MocktxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktxManager) EXPECT() *MocktxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktxManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktxManager)(nil).Do), ctx, fn)
+}
+
+// MockauditRecorder is a mock of auditRecorder interface.
+type MockauditRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockauditRecorderMockRecorder is the mock recorder for MockauditRecorder.
+type MockauditRecorderMockRecorder struct {
+	mock *MockauditRecorder
+}
+
+// NewMockauditRecorder creates a new mock instance.
+func NewMockauditRecorder(ctrl *gomock.Controller) *MockauditRecorder {
+	mock := &MockauditRecorder{ctrl: ctrl}
+	mock.recorder = &MockauditRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditRecorder) EXPECT() *MockauditRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockauditRecorder) Record(ctx context.Context, event domain.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockauditRecorderMockRecorder) Record(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockauditRecorder)(nil).Record), ctx, event)
+}
+
+// MockeventEmitter is a mock of eventEmitter interface.
+type MockeventEmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventEmitterMockRecorder
+	isgomock struct{}
+}
+
+// MockeventEmitterMockRecorder is the mock recorder for MockeventEmitter.
+type MockeventEmitterMockRecorder struct {
+	mock *MockeventEmitter
+}
+
+// NewMockeventEmitter creates a new mock instance.
+func NewMockeventEmitter(ctrl *gomock.Controller) *MockeventEmitter {
+	mock := &MockeventEmitter{ctrl: ctrl}
+	mock.recorder = &MockeventEmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventEmitter) EXPECT() *MockeventEmitterMockRecorder {
+	return m.recorder
+}
+
+// Emit mocks base method.
+func (m *MockeventEmitter) Emit(ctx context.Context, event domain.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Emit", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Emit indicates an expected call of Emit.
+func (mr *MockeventEmitterMockRecorder) Emit(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockeventEmitter)(nil).Emit), ctx, event)
+}
@@ -0,0 +1,206 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox.go
+//
+// Generated by this command:
+//
+//	mockgen -source=outbox.go -destination=./mocks/outbox_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	domain "github.com/valeragav/avito-pvz-service/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockoutboxRepo is a mock of outboxRepo interface.
+type MockoutboxRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockoutboxRepoMockRecorder is the mock recorder for MockoutboxRepo.
+type MockoutboxRepoMockRecorder struct {
+	mock *MockoutboxRepo
+}
+
+// NewMockoutboxRepo creates a new mock instance.
+func NewMockoutboxRepo(ctrl *gomock.Controller) *MockoutboxRepo {
+	mock := &MockoutboxRepo{ctrl: ctrl}
+	mock.recorder = &MockoutboxRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxRepo) EXPECT() *MockoutboxRepoMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockoutboxRepo) ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]*domain.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, limit, lease)
+	ret0, _ := ret[0].([]*domain.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockoutboxRepoMockRecorder) ClaimDue(ctx, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockoutboxRepo)(nil).ClaimDue), ctx, limit, lease)
+}
+
+// Create mocks base method.
+func (m *MockoutboxRepo) Create(ctx context.Context, event domain.OutboxEvent) (*domain.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, event)
+	ret0, _ := ret[0].(*domain.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockoutboxRepoMockRecorder) Create(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockoutboxRepo)(nil).Create), ctx, event)
+}
+
+// MarkDead mocks base method.
+func (m *MockoutboxRepo) MarkDead(ctx context.Context, eventID uuid.UUID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDead", ctx, eventID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDead indicates an expected call of MarkDead.
+func (mr *MockoutboxRepoMockRecorder) MarkDead(ctx, eventID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDead", reflect.TypeOf((*MockoutboxRepo)(nil).MarkDead), ctx, eventID, reason)
+}
+
+// MarkFailed mocks base method.
+func (m *MockoutboxRepo) MarkFailed(ctx context.Context, eventID uuid.UUID, reason string, nextAttemptAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, eventID, reason, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockoutboxRepoMockRecorder) MarkFailed(ctx, eventID, reason, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockoutboxRepo)(nil).MarkFailed), ctx, eventID, reason, nextAttemptAt)
+}
+
+// MarkPublished mocks base method.
+func (m *MockoutboxRepo) MarkPublished(ctx context.Context, eventID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPublished", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPublished indicates an expected call of MarkPublished.
+func (mr *MockoutboxRepoMockRecorder) MarkPublished(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublished", reflect.TypeOf((*MockoutboxRepo)(nil).MarkPublished), ctx, eventID)
+}
+
+// Release mocks base method.
+func (m *MockoutboxRepo) Release(ctx context.Context, eventIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, eventIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockoutboxRepoMockRecorder) Release(ctx, eventIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockoutboxRepo)(nil).Release), ctx, eventIDs)
+}
+
+// Mockpublisher is a mock of publisher interface.
+type Mockpublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockpublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockpublisherMockRecorder is the mock recorder for Mockpublisher.
+type MockpublisherMockRecorder struct {
+	mock *Mockpublisher
+}
+
+// NewMockpublisher creates a new mock instance.
+func NewMockpublisher(ctrl *gomock.Controller) *Mockpublisher {
+	mock := &Mockpublisher{ctrl: ctrl}
+	mock.recorder = &MockpublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockpublisher) EXPECT() *MockpublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *Mockpublisher) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockpublisherMockRecorder) Publish(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*Mockpublisher)(nil).Publish), ctx, event)
+}
+
+// Mocknotifier is a mock of notifier interface.
+type Mocknotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MocknotifierMockRecorder
+	isgomock struct{}
+}
+
+// MocknotifierMockRecorder is the mock recorder for Mocknotifier.
+type MocknotifierMockRecorder struct {
+	mock *Mocknotifier
+}
+
+// NewMocknotifier creates a new mock instance.
+func NewMocknotifier(ctrl *gomock.Controller) *Mocknotifier {
+	mock := &Mocknotifier{ctrl: ctrl}
+	mock.recorder = &MocknotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocknotifier) EXPECT() *MocknotifierMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *Mocknotifier) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MocknotifierMockRecorder) Publish(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*Mocknotifier)(nil).Publish), ctx, event)
+}
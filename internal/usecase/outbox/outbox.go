@@ -0,0 +1,59 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=outbox.go -destination=./mocks/outbox_mock.go -package=mocks
+type outboxRepo interface {
+	Create(ctx context.Context, event domain.OutboxEvent) (*domain.OutboxEvent, error)
+	ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]*domain.OutboxEvent, error)
+	MarkPublished(ctx context.Context, eventID uuid.UUID) error
+	MarkFailed(ctx context.Context, eventID uuid.UUID, reason string, nextAttemptAt time.Time) error
+	MarkDead(ctx context.Context, eventID uuid.UUID, reason string) error
+	Release(ctx context.Context, eventIDs []uuid.UUID) error
+}
+
+type publisher interface {
+	Publish(ctx context.Context, event domain.OutboxEvent) error
+}
+
+// notifier рассылает событие подписчикам WatchPVZ на всех экземплярах сервиса.
+type notifier interface {
+	Publish(ctx context.Context, event domain.OutboxEvent) error
+}
+
+type OutboxUseCase struct {
+	outboxRepo outboxRepo
+	notifier   notifier
+}
+
+func New(outboxRepo outboxRepo, notifier notifier) *OutboxUseCase {
+	return &OutboxUseCase{
+		outboxRepo,
+		notifier,
+	}
+}
+
+// Emit кладёт событие в outbox и уведомляет подписчиков WatchPVZ. Вызывается внутри
+// транзакции use case, поэтому событие и уведомление появляются только вместе с самим изменением,
+// ровно один раз и без ожидания relay.
+func (s *OutboxUseCase) Emit(ctx context.Context, event domain.OutboxEvent) error {
+	const op = "outbox.Emit"
+
+	created, err := s.outboxRepo.Create(ctx, event)
+	if err != nil {
+		return fmt.Errorf("%s: failed to save event: %w", op, err)
+	}
+
+	if err := s.notifier.Publish(ctx, *created); err != nil {
+		return fmt.Errorf("%s: failed to notify watchers: %w", op, err)
+	}
+
+	return nil
+}
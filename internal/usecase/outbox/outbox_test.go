@@ -0,0 +1,97 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/outbox/mocks"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOutboxUseCase_Emit(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	event := domain.OutboxEvent{
+		Type:        domain.EventReceptionOpened,
+		AggregateID: uuid.New(),
+		PvzID:       uuid.New(),
+	}
+
+	created := event
+	created.ID = uuid.New()
+
+	type fields struct {
+		name    string
+		mockFn  func(repo *mocks.MockoutboxRepo, notifier *mocks.Mocknotifier)
+		wantErr error
+	}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			mockFn: func(repo *mocks.MockoutboxRepo, notifier *mocks.Mocknotifier) {
+				repo.EXPECT().
+					Create(ctx, event).
+					Return(&created, nil).
+					Times(1)
+				notifier.EXPECT().
+					Publish(ctx, created).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "repo error",
+			mockFn: func(repo *mocks.MockoutboxRepo, _ *mocks.Mocknotifier) {
+				repo.EXPECT().
+					Create(ctx, event).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("outbox.Emit: failed to save event: db error"),
+		},
+		{
+			name: "notify error",
+			mockFn: func(repo *mocks.MockoutboxRepo, notifier *mocks.Mocknotifier) {
+				repo.EXPECT().
+					Create(ctx, event).
+					Return(&created, nil).
+					Times(1)
+				notifier.EXPECT().
+					Publish(ctx, created).
+					Return(errors.New("notify error")).
+					Times(1)
+			},
+			wantErr: errors.New("outbox.Emit: failed to notify watchers: notify error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := mocks.NewMockoutboxRepo(ctrl)
+			notifier := mocks.NewMocknotifier(ctrl)
+			tt.mockFn(repo, notifier)
+
+			err := New(repo, notifier).Emit(ctx, event)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
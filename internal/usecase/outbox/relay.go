@@ -0,0 +1,166 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+)
+
+type RelayConfig struct {
+	Interval    time.Duration
+	BatchSize   uint64
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// LeaseTimeout на сколько событие закрепляется за экземпляром relay,
+	// должен покрывать публикацию всей пачки
+	LeaseTimeout time.Duration
+}
+
+// Relay периодически забирает неопубликованные события из outbox и отправляет их в publisher.
+// Доставка at-least-once: событие помечается опубликованным только после успешной отправки.
+// Неудачная публикация повторяется с экспоненциальной задержкой, после MaxAttempts
+// событие помечается failed и больше не публикуется.
+type Relay struct {
+	outboxRepo outboxRepo
+	publisher  publisher
+	cfg        RelayConfig
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewRelay(outboxRepo outboxRepo, publisher publisher, cfg RelayConfig) *Relay {
+	return &Relay{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		cfg:        cfg,
+	}
+}
+
+// Start запускает фоновую горутину relay. Остановка через Stop или отмену ctx.
+func (r *Relay) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+
+	go r.run(ctx)
+}
+
+// Stop останавливает relay и ждёт завершения текущей пачки.
+func (r *Relay) Stop(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *Relay) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.ProcessBatch(ctx); err != nil && ctx.Err() == nil {
+			logger.ErrorCtx(ctx, "outbox relay: failed to process batch", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch публикует одну пачку событий и возвращает число опубликованных.
+// События берутся в аренду, публикуются вне транзакции, а результат каждой попытки
+// сохраняется отдельным запросом. После неудачной отправки остальные события того же PVZ
+// в пачке возвращаются в очередь, чтобы получатель не увидел их раньше предыдущего.
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	const op = "outbox.ProcessBatch"
+
+	events, err := r.outboxRepo.ClaimDue(ctx, r.cfg.BatchSize, r.cfg.LeaseTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to claim events: %w", op, err)
+	}
+
+	var published int
+	failedPvz := make(map[uuid.UUID]struct{})
+	var skipped []uuid.UUID
+
+	for _, event := range events {
+		if _, ok := failedPvz[event.PvzID]; ok {
+			skipped = append(skipped, event.ID)
+			continue
+		}
+
+		if err := r.publisher.Publish(ctx, *event); err != nil {
+			if event.PvzID != uuid.Nil {
+				failedPvz[event.PvzID] = struct{}{}
+			}
+			if err := r.recordFailure(ctx, event, err); err != nil {
+				return published, fmt.Errorf("%s: %w", op, err)
+			}
+			continue
+		}
+
+		if err := r.outboxRepo.MarkPublished(ctx, event.ID); err != nil {
+			return published, fmt.Errorf("%s: failed to mark event published: %w", op, err)
+		}
+		published++
+	}
+
+	if len(skipped) > 0 {
+		if err := r.outboxRepo.Release(ctx, skipped); err != nil {
+			return published, fmt.Errorf("%s: failed to release skipped events: %w", op, err)
+		}
+	}
+
+	return published, nil
+}
+
+func (r *Relay) recordFailure(ctx context.Context, event *domain.OutboxEvent, publishErr error) error {
+	attempts := event.Attempts + 1
+
+	if attempts >= r.cfg.MaxAttempts {
+		logger.ErrorCtx(ctx, "outbox relay: event dropped after max attempts",
+			"eventId", event.ID, "type", event.Type, "attempt", attempts, "error", publishErr)
+
+		if err := r.outboxRepo.MarkDead(ctx, event.ID, publishErr.Error()); err != nil {
+			return fmt.Errorf("failed to mark event dead: %w", err)
+		}
+		return nil
+	}
+
+	logger.WarnCtx(ctx, "outbox relay: failed to publish event",
+		"eventId", event.ID, "type", event.Type, "attempt", attempts, "error", publishErr)
+
+	if err := r.outboxRepo.MarkFailed(ctx, event.ID, publishErr.Error(), time.Now().Add(r.backoff(attempts))); err != nil {
+		return fmt.Errorf("failed to mark event failed: %w", err)
+	}
+	return nil
+}
+
+// backoff возвращает задержку перед следующей попыткой: base, 2*base, 4*base... но не больше max.
+func (r *Relay) backoff(attempt int) time.Duration {
+	delay := r.cfg.BackoffBase
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= r.cfg.BackoffMax {
+			return r.cfg.BackoffMax
+		}
+	}
+	return delay
+}
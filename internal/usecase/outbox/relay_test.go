@@ -0,0 +1,232 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/outbox/mocks"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+type relayMocks struct {
+	MockOutboxRepo *mocks.MockoutboxRepo
+	MockPublisher  *mocks.Mockpublisher
+}
+
+func newRelayMocks(t *testing.T) *relayMocks {
+	ctrl := gomock.NewController(t)
+
+	return &relayMocks{
+		MockOutboxRepo: mocks.NewMockoutboxRepo(ctrl),
+		MockPublisher:  mocks.NewMockpublisher(ctrl),
+	}
+}
+
+func newTestRelay(m *relayMocks, interval time.Duration, batchSize uint64) *Relay {
+	return NewRelay(m.MockOutboxRepo, m.MockPublisher, RelayConfig{
+		Interval:     interval,
+		BatchSize:    batchSize,
+		MaxAttempts:  3,
+		BackoffBase:  time.Second,
+		BackoffMax:   3 * time.Second,
+		LeaseTimeout: time.Minute,
+	})
+}
+
+func TestRelay_ProcessBatch(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	const batchSize = 10
+
+	type fields struct {
+		name          string
+		mockFn        func(m *relayMocks)
+		wantPublished int
+		wantErr       error
+	}
+
+	testcases := []fields{
+		{
+			name: "all published",
+			mockFn: func(m *relayMocks) {
+				first := &domain.OutboxEvent{ID: uuid.New(), Type: domain.EventReceptionOpened, PvzID: uuid.New()}
+				second := &domain.OutboxEvent{ID: uuid.New(), Type: domain.EventProductAdded, PvzID: first.PvzID}
+
+				m.MockOutboxRepo.EXPECT().
+					ClaimDue(ctx, uint64(batchSize), time.Minute).
+					Return([]*domain.OutboxEvent{first, second}, nil).
+					Times(1)
+
+				gomock.InOrder(
+					m.MockPublisher.EXPECT().Publish(ctx, *first).Return(nil),
+					m.MockOutboxRepo.EXPECT().MarkPublished(ctx, first.ID).Return(nil),
+					m.MockPublisher.EXPECT().Publish(ctx, *second).Return(nil),
+					m.MockOutboxRepo.EXPECT().MarkPublished(ctx, second.ID).Return(nil),
+				)
+			},
+			wantPublished: 2,
+		},
+		{
+			name: "failed event is retried with backoff and blocks later events of same pvz",
+			mockFn: func(m *relayMocks) {
+				pvzID := uuid.New()
+				failed := &domain.OutboxEvent{ID: uuid.New(), Type: domain.EventReceptionOpened, PvzID: pvzID, Attempts: 1}
+				skipped := &domain.OutboxEvent{ID: uuid.New(), Type: domain.EventProductAdded, PvzID: pvzID}
+				other := &domain.OutboxEvent{ID: uuid.New(), Type: domain.EventPVZCreated, PvzID: uuid.New()}
+
+				m.MockOutboxRepo.EXPECT().
+					ClaimDue(ctx, uint64(batchSize), time.Minute).
+					Return([]*domain.OutboxEvent{failed, skipped, other}, nil).
+					Times(1)
+
+				before := time.Now()
+				m.MockPublisher.EXPECT().Publish(ctx, *failed).Return(errors.New("timeout")).Times(1)
+				m.MockOutboxRepo.EXPECT().
+					MarkFailed(ctx, failed.ID, "timeout", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, nextAttemptAt time.Time) error {
+						// вторая неудачная попытка откладывается на 2*base
+						require.WithinDuration(t, before.Add(2*time.Second), nextAttemptAt, time.Second)
+						return nil
+					}).
+					Times(1)
+				m.MockPublisher.EXPECT().Publish(ctx, *other).Return(nil).Times(1)
+				m.MockOutboxRepo.EXPECT().MarkPublished(ctx, other.ID).Return(nil).Times(1)
+				m.MockOutboxRepo.EXPECT().Release(ctx, []uuid.UUID{skipped.ID}).Return(nil).Times(1)
+			},
+			wantPublished: 1,
+		},
+		{
+			name: "event dropped after max attempts",
+			mockFn: func(m *relayMocks) {
+				event := &domain.OutboxEvent{ID: uuid.New(), Type: domain.EventReceptionClosed, PvzID: uuid.New(), Attempts: 2}
+
+				m.MockOutboxRepo.EXPECT().
+					ClaimDue(ctx, uint64(batchSize), time.Minute).
+					Return([]*domain.OutboxEvent{event}, nil).
+					Times(1)
+
+				m.MockPublisher.EXPECT().Publish(ctx, *event).Return(errors.New("bad gateway")).Times(1)
+				m.MockOutboxRepo.EXPECT().MarkDead(ctx, event.ID, "bad gateway").Return(nil).Times(1)
+			},
+			wantPublished: 0,
+		},
+		{
+			name: "events without pvz do not block each other",
+			mockFn: func(m *relayMocks) {
+				failed := &domain.OutboxEvent{ID: uuid.New(), Type: domain.EventPVZCreated}
+				next := &domain.OutboxEvent{ID: uuid.New(), Type: domain.EventPVZCreated}
+
+				m.MockOutboxRepo.EXPECT().
+					ClaimDue(ctx, uint64(batchSize), time.Minute).
+					Return([]*domain.OutboxEvent{failed, next}, nil).
+					Times(1)
+
+				m.MockPublisher.EXPECT().Publish(ctx, *failed).Return(errors.New("timeout")).Times(1)
+				m.MockOutboxRepo.EXPECT().MarkFailed(ctx, failed.ID, "timeout", gomock.Any()).Return(nil).Times(1)
+				m.MockPublisher.EXPECT().Publish(ctx, *next).Return(nil).Times(1)
+				m.MockOutboxRepo.EXPECT().MarkPublished(ctx, next.ID).Return(nil).Times(1)
+			},
+			wantPublished: 1,
+		},
+		{
+			name: "claim error",
+			mockFn: func(m *relayMocks) {
+				m.MockOutboxRepo.EXPECT().
+					ClaimDue(ctx, uint64(batchSize), time.Minute).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("outbox.ProcessBatch: failed to claim events: db error"),
+		},
+		{
+			name: "mark published error",
+			mockFn: func(m *relayMocks) {
+				event := &domain.OutboxEvent{ID: uuid.New(), Type: domain.EventReceptionClosed}
+
+				m.MockOutboxRepo.EXPECT().
+					ClaimDue(ctx, uint64(batchSize), time.Minute).
+					Return([]*domain.OutboxEvent{event}, nil).
+					Times(1)
+
+				m.MockPublisher.EXPECT().Publish(ctx, *event).Return(nil).Times(1)
+				m.MockOutboxRepo.EXPECT().MarkPublished(ctx, event.ID).Return(errors.New("db error")).Times(1)
+			},
+			wantErr: errors.New("outbox.ProcessBatch: failed to mark event published: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			relayMocks := newRelayMocks(t)
+			tt.mockFn(relayMocks)
+
+			relay := newTestRelay(relayMocks, time.Second, batchSize)
+
+			published, err := relay.ProcessBatch(ctx)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPublished, published)
+		})
+	}
+}
+
+func TestRelay_backoff(t *testing.T) {
+	t.Parallel()
+
+	relay := newTestRelay(newRelayMocks(t), time.Second, 1)
+
+	require.Equal(t, time.Second, relay.backoff(1))
+	require.Equal(t, 2*time.Second, relay.backoff(2))
+	require.Equal(t, 3*time.Second, relay.backoff(3))
+	require.Equal(t, 3*time.Second, relay.backoff(10))
+}
+
+func TestRelay_StartStop(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+
+	relayMocks := newRelayMocks(t)
+
+	polled := make(chan struct{}, 1)
+	relayMocks.MockOutboxRepo.EXPECT().
+		ClaimDue(gomock.Any(), uint64(1), time.Minute).
+		DoAndReturn(func(ctx context.Context, limit uint64, lease time.Duration) ([]*domain.OutboxEvent, error) {
+			select {
+			case polled <- struct{}{}:
+			default:
+			}
+			return nil, nil
+		}).
+		MinTimes(1)
+
+	relay := newTestRelay(relayMocks, 10*time.Millisecond, 1)
+	relay.Start(context.Background())
+
+	select {
+	case <-polled:
+	case <-time.After(time.Second):
+		t.Fatal("relay did not poll outbox")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, relay.Stop(ctx))
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	domain "github.com/valeragav/avito-pvz-service/internal/domain"
@@ -57,6 +58,21 @@ func (mr *MockproductRepoMockRecorder) Create(ctx, product any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockproductRepo)(nil).Create), ctx, product)
 }
 
+// CreateBatch mocks base method.
+func (m *MockproductRepo) CreateBatch(ctx context.Context, products []domain.Product) ([]*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, products)
+	ret0, _ := ret[0].([]*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockproductRepoMockRecorder) CreateBatch(ctx, products any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockproductRepo)(nil).CreateBatch), ctx, products)
+}
+
 // DeleteProduct mocks base method.
 func (m *MockproductRepo) DeleteProduct(ctx context.Context, productID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -71,6 +87,36 @@ func (mr *MockproductRepoMockRecorder) DeleteProduct(ctx, productID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockproductRepo)(nil).DeleteProduct), ctx, productID)
 }
 
+// Get mocks base method.
+func (m *MockproductRepo) Get(ctx context.Context, filter domain.Product) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, filter)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockproductRepoMockRecorder) Get(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockproductRepo)(nil).Get), ctx, filter)
+}
+
+// GetLastByBarcode mocks base method.
+func (m *MockproductRepo) GetLastByBarcode(ctx context.Context, barcode string) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastByBarcode", ctx, barcode)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastByBarcode indicates an expected call of GetLastByBarcode.
+func (mr *MockproductRepoMockRecorder) GetLastByBarcode(ctx, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastByBarcode", reflect.TypeOf((*MockproductRepo)(nil).GetLastByBarcode), ctx, barcode)
+}
+
 // GetLastProductInReception mocks base method.
 func (m *MockproductRepo) GetLastProductInReception(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +132,36 @@ func (mr *MockproductRepoMockRecorder) GetLastProductInReception(ctx, receptionI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastProductInReception", reflect.TypeOf((*MockproductRepo)(nil).GetLastProductInReception), ctx, receptionID)
 }
 
+// InventoryByType mocks base method.
+func (m *MockproductRepo) InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InventoryByType", ctx, pvzID, asOf)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InventoryByType indicates an expected call of InventoryByType.
+func (mr *MockproductRepoMockRecorder) InventoryByType(ctx, pvzID, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InventoryByType", reflect.TypeOf((*MockproductRepo)(nil).InventoryByType), ctx, pvzID, asOf)
+}
+
+// ListOpenBarcodes mocks base method.
+func (m *MockproductRepo) ListOpenBarcodes(ctx context.Context, barcodes []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenBarcodes", ctx, barcodes)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenBarcodes indicates an expected call of ListOpenBarcodes.
+func (mr *MockproductRepoMockRecorder) ListOpenBarcodes(ctx, barcodes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenBarcodes", reflect.TypeOf((*MockproductRepo)(nil).ListOpenBarcodes), ctx, barcodes)
+}
+
 // MockreceptionRepo is a mock of receptionRepo interface.
 type MockreceptionRepo struct {
 	ctrl     *gomock.Controller
@@ -110,19 +186,34 @@ func (m *MockreceptionRepo) EXPECT() *MockreceptionRepoMockRecorder {
 	return m.recorder
 }
 
-// FindByStatus mocks base method.
-func (m *MockreceptionRepo) FindByStatus(ctx context.Context, statusName domain.ReceptionStatusCode, filter domain.Reception) (*domain.Reception, error) {
+// FindOpen mocks base method.
+func (m *MockreceptionRepo) FindOpen(ctx context.Context, filter domain.Reception) (*domain.Reception, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByStatus", ctx, statusName, filter)
+	ret := m.ctrl.Call(m, "FindOpen", ctx, filter)
 	ret0, _ := ret[0].(*domain.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByStatus indicates an expected call of FindByStatus.
-func (mr *MockreceptionRepoMockRecorder) FindByStatus(ctx, statusName, filter any) *gomock.Call {
+// FindOpen indicates an expected call of FindOpen.
+func (mr *MockreceptionRepoMockRecorder) FindOpen(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStatus", reflect.TypeOf((*MockreceptionRepo)(nil).FindByStatus), ctx, statusName, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpen", reflect.TypeOf((*MockreceptionRepo)(nil).FindOpen), ctx, filter)
+}
+
+// GetWithStatus mocks base method.
+func (m *MockreceptionRepo) GetWithStatus(ctx context.Context, receptionID uuid.UUID) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithStatus", ctx, receptionID)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithStatus indicates an expected call of GetWithStatus.
+func (mr *MockreceptionRepoMockRecorder) GetWithStatus(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithStatus", reflect.TypeOf((*MockreceptionRepo)(nil).GetWithStatus), ctx, receptionID)
 }
 
 // MockproductTypeRepo is a mock of productTypeRepo interface.
@@ -202,3 +293,132 @@ func (mr *MockpvzRepoMockRecorder) Get(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockpvzRepo)(nil).Get), ctx, filter)
 }
+
+// GetForUpdate mocks base method.
+func (m *MockpvzRepo) GetForUpdate(ctx context.Context, pvzID uuid.UUID) (*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, pvzID)
+	ret0, _ := ret[0].(*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockpvzRepoMockRecorder) GetForUpdate(ctx, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockpvzRepo)(nil).GetForUpdate), ctx, pvzID)
+}
+
+// MocktxManager is a mock of txManager interface.
+type MocktxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktxManagerMockRecorder is the mock recorder for MocktxManager.
+type MocktxManagerMockRecorder struct {
+	mock *MocktxManager
+}
+
+// NewMocktxManager creates a new mock instance.
+func NewMocktxManager(ctrl *gomock.Controller) *MocktxManager {
+	mock := &MocktxManager{ctrl: ctrl}
+	mock.recorder = &MocktxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktxManager) EXPECT() *MocktxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktxManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktxManager)(nil).Do), ctx, fn)
+}
+
+// MockauditRecorder is a mock of auditRecorder interface.
+type MockauditRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockauditRecorderMockRecorder is the mock recorder for MockauditRecorder.
+type MockauditRecorderMockRecorder struct {
+	mock *MockauditRecorder
+}
+
+// NewMockauditRecorder creates a new mock instance.
+func NewMockauditRecorder(ctrl *gomock.Controller) *MockauditRecorder {
+	mock := &MockauditRecorder{ctrl: ctrl}
+	mock.recorder = &MockauditRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditRecorder) EXPECT() *MockauditRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockauditRecorder) Record(ctx context.Context, event domain.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockauditRecorderMockRecorder) Record(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockauditRecorder)(nil).Record), ctx, event)
+}
+
+// MockeventEmitter is a mock of eventEmitter interface.
+type MockeventEmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventEmitterMockRecorder
+	isgomock struct{}
+}
+
+// MockeventEmitterMockRecorder is the mock recorder for MockeventEmitter.
+type MockeventEmitterMockRecorder struct {
+	mock *MockeventEmitter
+}
+
+// NewMockeventEmitter creates a new mock instance.
+func NewMockeventEmitter(ctrl *gomock.Controller) *MockeventEmitter {
+	mock := &MockeventEmitter{ctrl: ctrl}
+	mock.recorder = &MockeventEmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventEmitter) EXPECT() *MockeventEmitterMockRecorder {
+	return m.recorder
+}
+
+// Emit mocks base method.
+func (m *MockeventEmitter) Emit(ctx context.Context, event domain.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Emit", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Emit indicates an expected call of Emit.
+func (mr *MockeventEmitterMockRecorder) Emit(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockeventEmitter)(nil).Emit), ctx, event)
+}
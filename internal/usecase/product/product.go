@@ -9,18 +9,25 @@ import (
 	"github.com/google/uuid"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/capacity"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
 )
 
 //go:generate ${LOCAL_BIN}/mockgen -source=product.go -destination=./mocks/product_mock.go -package=mocks
 type productRepo interface {
 	Create(ctx context.Context, product domain.Product) (*domain.Product, error)
+	CreateBatch(ctx context.Context, products []domain.Product) ([]*domain.Product, error)
+	GetLastByBarcode(ctx context.Context, barcode string) (*domain.Product, error)
+	ListOpenBarcodes(ctx context.Context, barcodes []string) ([]string, error)
+	Get(ctx context.Context, filter domain.Product) (*domain.Product, error)
 	DeleteProduct(ctx context.Context, productID uuid.UUID) error
 	GetLastProductInReception(ctx context.Context, receptionID uuid.UUID) (*domain.Product, error)
+	InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error)
 }
 
 type receptionRepo interface {
-	FindByStatus(ctx context.Context, statusName domain.ReceptionStatusCode, filter domain.Reception) (*domain.Reception, error)
+	FindOpen(ctx context.Context, filter domain.Reception) (*domain.Reception, error)
+	GetWithStatus(ctx context.Context, receptionID uuid.UUID) (*domain.Reception, error)
 }
 
 type productTypeRepo interface {
@@ -29,6 +36,19 @@ type productTypeRepo interface {
 
 type pvzRepo interface {
 	Get(ctx context.Context, filter domain.PVZ) (*domain.PVZ, error)
+	GetForUpdate(ctx context.Context, pvzID uuid.UUID) (*domain.PVZ, error)
+}
+
+type txManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type auditRecorder interface {
+	Record(ctx context.Context, event domain.AuditEvent) error
+}
+
+type eventEmitter interface {
+	Emit(ctx context.Context, event domain.OutboxEvent) error
 }
 
 type ProductUseCase struct {
@@ -36,84 +56,534 @@ type ProductUseCase struct {
 	receptionRepo   receptionRepo
 	productTypeRepo productTypeRepo
 	pvzRepo         pvzRepo
+	txManager       txManager
+	auditRecorder   auditRecorder
+	eventEmitter    eventEmitter
 }
 
-func New(productRepo productRepo, receptionRepo receptionRepo, productTypeRepo productTypeRepo, pvzRepo pvzRepo) *ProductUseCase {
+func New(
+	productRepo productRepo,
+	receptionRepo receptionRepo,
+	productTypeRepo productTypeRepo,
+	pvzRepo pvzRepo,
+	txManager txManager,
+	auditRecorder auditRecorder,
+	eventEmitter eventEmitter,
+) *ProductUseCase {
 	return &ProductUseCase{
 		productRepo,
 		receptionRepo,
 		productTypeRepo,
 		pvzRepo,
+		txManager,
+		auditRecorder,
+		eventEmitter,
 	}
 }
 
 func (s *ProductUseCase) Create(ctx context.Context, createIn dto.ProductCreate) (*domain.Product, error) {
+	var (
+		res   *domain.Product
+		usage *capacity.Usage
+	)
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, usage, err = s.create(ctx, createIn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Report()
+
+	return res, nil
+}
+
+func (s *ProductUseCase) create(ctx context.Context, createIn dto.ProductCreate) (*domain.Product, *capacity.Usage, error) {
 	const op = "products.Create"
 
-	lastReception, err := s.receptionRepo.FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+	// Блокируем PVZ, чтобы приёмку не закрыли, пока добавляется товар
+	pvzEnt, err := s.pvzRepo.GetForUpdate(ctx, createIn.PvzID)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, domain.ErrPVZNotFound
+		}
+		return nil, nil, fmt.Errorf("%s: failed to lock pvz: %w", op, err)
+	}
+
+	if !pvzEnt.IsActive() {
+		return nil, nil, domain.ErrPVZNotActive
+	}
+
+	lastReception, err := s.receptionRepo.FindOpen(ctx, domain.Reception{
 		PvzID: createIn.PvzID,
 	})
 	if err != nil {
 		if errors.Is(err, infra.ErrNotFound) {
-			return nil, domain.ErrNoReceptionIsCurrentlyInProgress
+			return nil, nil, domain.ErrNoReceptionIsCurrentlyInProgress
 		}
-		return nil, fmt.Errorf("%s: failed to find in progress reception: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: failed to find in progress reception: %w", op, err)
 	}
 
 	productType, err := s.productTypeRepo.Get(ctx, domain.ProductType{Name: createIn.TypeName})
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to find product type '%s': %w", op, createIn.TypeName, err)
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, domain.ErrProductTypeNotFound
+		}
+		return nil, nil, fmt.Errorf("%s: failed to find product type '%s': %w", op, createIn.TypeName, err)
+	}
+
+	// Остаток считается под блокировкой PVZ, поэтому параллельные сканы не превысят лимит
+	usage, err := capacity.Load(ctx, s.productRepo, pvzEnt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get pvz stock: %w", op, err)
+	}
+	if usage != nil {
+		if err := pvzEnt.CheckCapacity(usage.Stock, createIn.TypeName); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	product, err := s.productRepo.Create(ctx, domain.Product{
 		DateTime:    time.Now(),
 		TypeID:      productType.ID,
 		ReceptionID: lastReception.ID,
+		CreatedBy:   createIn.CreatedBy,
+		Barcode:     createIn.Barcode,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to create product: %w", op, err)
+		if errors.Is(err, infra.ErrDuplicate) {
+			return nil, nil, domain.ErrProductBarcodeDuplicate
+		}
+		return nil, nil, fmt.Errorf("%s: failed to create product: %w", op, err)
 	}
 
 	product.ProductType = productType
+	if usage != nil {
+		usage.Stock[createIn.TypeName]++
+	}
+
+	err = s.auditRecorder.Record(ctx, domain.AuditEvent{
+		ActorID:  createIn.CreatedBy,
+		Action:   domain.AuditActionProductAdded,
+		EntityID: product.ID,
+		PvzID:    createIn.PvzID,
+		After:    product,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+		Type:        domain.EventProductAdded,
+		AggregateID: product.ID,
+		PvzID:       createIn.PvzID,
+		Payload:     product,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
 
-	return product, nil
+	return product, usage, nil
 }
 
-func (s *ProductUseCase) DeleteLastProduct(ctx context.Context, pvzID uuid.UUID) (*domain.Product, error) {
-	const op = "products.DeleteLastProduct"
+func (s *ProductUseCase) CreateBatch(ctx context.Context, createIn dto.ProductBatchCreate) ([]dto.ProductBatchResult, error) {
+	if len(createIn.Items) == 0 || len(createIn.Items) > domain.MaxProductBatchSize {
+		return nil, domain.ErrProductBatchSize
+	}
+
+	var (
+		res   []dto.ProductBatchResult
+		usage *capacity.Usage
+	)
 
-	_, err := s.pvzRepo.Get(ctx, domain.PVZ{
-		ID: pvzID,
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, usage, err = s.createBatch(ctx, createIn)
+		return err
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Report()
+
+	return res, nil
+}
+
+// createBatch добавляет пакет товаров в открытую приёмку за одну блокировку PVZ.
+// Товары с ошибкой попадают в результат с Err, если не выставлен AllOrNothing.
+// Вместимость проверяется с учётом товаров, уже принятых из этого же пакета.
+func (s *ProductUseCase) createBatch(ctx context.Context, createIn dto.ProductBatchCreate) ([]dto.ProductBatchResult, *capacity.Usage, error) {
+	const op = "products.CreateBatch"
+
+	pvzEnt, err := s.pvzRepo.GetForUpdate(ctx, createIn.PvzID)
 	if err != nil {
 		if errors.Is(err, infra.ErrNotFound) {
-			return nil, domain.ErrPVZNotFound
+			return nil, nil, domain.ErrPVZNotFound
 		}
-		return nil, fmt.Errorf("%s: failed to find pvz: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: failed to lock pvz: %w", op, err)
 	}
 
-	lastReception, err := s.receptionRepo.FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
-		PvzID: pvzID,
+	if !pvzEnt.IsActive() {
+		return nil, nil, domain.ErrPVZNotActive
+	}
+
+	lastReception, err := s.receptionRepo.FindOpen(ctx, domain.Reception{
+		PvzID: createIn.PvzID,
 	})
 	if err != nil {
 		if errors.Is(err, infra.ErrNotFound) {
-			return nil, domain.ErrNoReceptionIsCurrentlyInProgress
+			return nil, nil, domain.ErrNoReceptionIsCurrentlyInProgress
+		}
+		return nil, nil, fmt.Errorf("%s: failed to find in progress reception: %w", op, err)
+	}
+
+	results := make([]dto.ProductBatchResult, len(createIn.Items))
+	productTypes := make(map[string]*domain.ProductType)
+
+	takenBarcodes, err := s.openBarcodes(ctx, createIn.Items)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to check barcodes: %w", op, err)
+	}
+
+	usage, err := capacity.Load(ctx, s.productRepo, pvzEnt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get pvz stock: %w", op, err)
+	}
+
+	toCreate := make([]domain.Product, 0, len(createIn.Items))
+	createdIdx := make([]int, 0, len(createIn.Items))
+
+	now := time.Now()
+	for i, item := range createIn.Items {
+		productType, ok := productTypes[item.TypeName]
+		if !ok {
+			productType, err = s.productTypeRepo.Get(ctx, domain.ProductType{Name: item.TypeName})
+			if err != nil && !errors.Is(err, infra.ErrNotFound) {
+				return nil, nil, fmt.Errorf("%s: failed to find product type '%s': %w", op, item.TypeName, err)
+			}
+			productTypes[item.TypeName] = productType
+		}
+
+		var itemErr error
+		switch {
+		case productType == nil:
+			itemErr = domain.ErrProductTypeNotFound
+		case item.Barcode != "" && takenBarcodes[item.Barcode]:
+			itemErr = domain.ErrProductBarcodeDuplicate
+		case usage != nil:
+			itemErr = pvzEnt.CheckCapacity(usage.Stock, item.TypeName)
+		}
+
+		if itemErr != nil {
+			if createIn.AllOrNothing {
+				return nil, nil, fmt.Errorf("%s: item %d: %w", op, i, itemErr)
+			}
+			results[i].Err = itemErr
+			continue
 		}
-		return nil, fmt.Errorf("%s: failed to find open reception: %w", op, err)
+
+		// повторный скан того же штрихкода внутри пакета тоже дубль
+		if item.Barcode != "" {
+			takenBarcodes[item.Barcode] = true
+		}
+		if usage != nil {
+			usage.Stock[item.TypeName]++
+		}
+
+		// Разносим время на микросекунду, чтобы порядок сканирования сохранился для DeleteLastProduct
+		toCreate = append(toCreate, domain.Product{
+			DateTime:    now.Add(time.Duration(len(toCreate)) * time.Microsecond),
+			TypeID:      productType.ID,
+			ReceptionID: lastReception.ID,
+			CreatedBy:   createIn.CreatedBy,
+			Barcode:     item.Barcode,
+		})
+		createdIdx = append(createdIdx, i)
+	}
+
+	if len(toCreate) == 0 {
+		return results, usage, nil
+	}
+
+	products, err := s.productRepo.CreateBatch(ctx, toCreate)
+	if err != nil {
+		if errors.Is(err, infra.ErrDuplicate) {
+			return nil, nil, domain.ErrProductBarcodeDuplicate
+		}
+		return nil, nil, fmt.Errorf("%s: failed to create products: %w", op, err)
+	}
+
+	for j, product := range products {
+		product.ProductType = productTypes[createIn.Items[createdIdx[j]].TypeName]
+		results[createdIdx[j]].Product = product
+
+		err = s.auditRecorder.Record(ctx, domain.AuditEvent{
+			ActorID:  createIn.CreatedBy,
+			Action:   domain.AuditActionProductAdded,
+			EntityID: product.ID,
+			PvzID:    createIn.PvzID,
+			After:    product,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		err = s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+			Type:        domain.EventProductAdded,
+			AggregateID: product.ID,
+			PvzID:       createIn.PvzID,
+			Payload:     product,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return results, usage, nil
+}
+
+// openBarcodes возвращает штрихкоды пакета, которые уже заняты в открытых приёмках.
+func (s *ProductUseCase) openBarcodes(ctx context.Context, items []dto.ProductBatchItem) (map[string]bool, error) {
+	barcodes := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.Barcode != "" {
+			barcodes = append(barcodes, item.Barcode)
+		}
+	}
+
+	taken := make(map[string]bool, len(barcodes))
+	if len(barcodes) == 0 {
+		return taken, nil
+	}
+
+	open, err := s.productRepo.ListOpenBarcodes(ctx, barcodes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, barcode := range open {
+		taken[barcode] = true
+	}
+
+	return taken, nil
+}
+
+func (s *ProductUseCase) GetByBarcode(ctx context.Context, barcode string) (*dto.ProductLocation, error) {
+	const op = "products.GetByBarcode"
+
+	product, err := s.productRepo.GetLastByBarcode(ctx, barcode)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, fmt.Errorf("%s: failed to get product: %w", op, err)
+	}
+
+	reception, err := s.receptionRepo.GetWithStatus(ctx, product.ReceptionID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to get reception: %w", op, err)
+	}
+
+	pvz, err := s.pvzRepo.Get(ctx, domain.PVZ{ID: reception.PvzID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to get pvz: %w", op, err)
+	}
+
+	return &dto.ProductLocation{
+		Product:   product,
+		Reception: reception,
+		PVZ:       pvz,
+	}, nil
+}
+
+func (s *ProductUseCase) DeleteLastProduct(ctx context.Context, deleteIn dto.ProductDeleteLast) (*domain.Product, error) {
+	var (
+		res   *domain.Product
+		usage *capacity.Usage
+	)
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, usage, err = s.deleteLastProduct(ctx, deleteIn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Report()
+
+	return res, nil
+}
+
+func (s *ProductUseCase) deleteLastProduct(ctx context.Context, deleteIn dto.ProductDeleteLast) (*domain.Product, *capacity.Usage, error) {
+	const op = "products.DeleteLastProduct"
+
+	pvzEnt, err := s.pvzRepo.GetForUpdate(ctx, deleteIn.PvzID)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, domain.ErrPVZNotFound
+		}
+		return nil, nil, fmt.Errorf("%s: failed to find pvz: %w", op, err)
+	}
+
+	lastReception, err := s.receptionRepo.FindOpen(ctx, domain.Reception{
+		PvzID: deleteIn.PvzID,
+	})
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, domain.ErrNoReceptionIsCurrentlyInProgress
+		}
+		return nil, nil, fmt.Errorf("%s: failed to find open reception: %w", op, err)
 	}
 
 	lastProduct, err := s.productRepo.GetLastProductInReception(ctx, lastReception.ID)
 	if err != nil {
 		if errors.Is(err, infra.ErrNotFound) {
-			return nil, domain.ErrProductToDelete
+			return nil, nil, domain.ErrProductToDelete
 		}
-		return nil, fmt.Errorf("%s: failed to get last product: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: failed to get last product: %w", op, err)
 	}
 
+	// выданный клиенту товар в переоткрытой приёмке удалять нельзя
+	if lastProduct.Issued {
+		return nil, nil, domain.ErrProductAlreadyIssued
+	}
+
+	// возвращённый товар не выдан, но история выдач на него ссылается
 	err = s.productRepo.DeleteProduct(ctx, lastProduct.ID)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to delete product: %w", op, err)
+		if errors.Is(err, infra.ErrReferenced) {
+			return nil, nil, domain.ErrProductHasIssuances
+		}
+		return nil, nil, fmt.Errorf("%s: failed to delete product: %w", op, err)
+	}
+
+	usage, err := capacity.Load(ctx, s.productRepo, pvzEnt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get pvz stock: %w", op, err)
+	}
+
+	err = s.auditRecorder.Record(ctx, domain.AuditEvent{
+		ActorID:  deleteIn.DeletedBy,
+		Action:   domain.AuditActionProductRemoved,
+		EntityID: lastProduct.ID,
+		PvzID:    deleteIn.PvzID,
+		Before:   lastProduct,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+		Type:        domain.EventProductRemoved,
+		AggregateID: lastProduct.ID,
+		PvzID:       deleteIn.PvzID,
+		Payload:     lastProduct,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return lastProduct, usage, nil
+}
+
+func (s *ProductUseCase) DeleteProduct(ctx context.Context, deleteIn dto.ProductDelete) (*domain.Product, error) {
+	var (
+		res   *domain.Product
+		usage *capacity.Usage
+	)
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, usage, err = s.deleteProduct(ctx, deleteIn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Report()
+
+	return res, nil
+}
+
+// deleteProduct удаляет произвольный товар, если его приёмка сейчас открыта.
+func (s *ProductUseCase) deleteProduct(ctx context.Context, deleteIn dto.ProductDelete) (*domain.Product, *capacity.Usage, error) {
+	const op = "products.DeleteProduct"
+
+	product, err := s.productRepo.Get(ctx, domain.Product{ID: deleteIn.ProductID})
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, domain.ErrProductNotFound
+		}
+		return nil, nil, fmt.Errorf("%s: failed to get product: %w", op, err)
+	}
+
+	reception, err := s.receptionRepo.GetWithStatus(ctx, product.ReceptionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get reception: %w", op, err)
+	}
+
+	if product.Issued {
+		return nil, nil, domain.ErrProductAlreadyIssued
+	}
+
+	// Статус приёмки меняется только под блокировкой PVZ, поэтому проверяем его после блокировки
+	pvzEnt, err := s.pvzRepo.GetForUpdate(ctx, reception.PvzID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to lock pvz: %w", op, err)
+	}
+
+	reception, err = s.receptionRepo.GetWithStatus(ctx, product.ReceptionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get reception: %w", op, err)
+	}
+
+	if reception.ReceptionStatus == nil || !reception.ReceptionStatus.Name.IsOpen() {
+		return nil, nil, domain.ErrProductReceptionClosed
+	}
+
+	err = s.productRepo.DeleteProduct(ctx, product.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, infra.ErrNotFound):
+			return nil, nil, domain.ErrProductNotFound
+		case errors.Is(err, infra.ErrReferenced):
+			return nil, nil, domain.ErrProductHasIssuances
+		}
+		return nil, nil, fmt.Errorf("%s: failed to delete product: %w", op, err)
+	}
+
+	usage, err := capacity.Load(ctx, s.productRepo, pvzEnt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get pvz stock: %w", op, err)
+	}
+
+	err = s.auditRecorder.Record(ctx, domain.AuditEvent{
+		ActorID:  deleteIn.DeletedBy,
+		Action:   domain.AuditActionProductRemoved,
+		EntityID: product.ID,
+		PvzID:    reception.PvzID,
+		Before:   product,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+		Type:        domain.EventProductRemoved,
+		AggregateID: product.ID,
+		PvzID:       reception.PvzID,
+		Payload:     product,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return lastProduct, nil
+	return product, usage, nil
 }
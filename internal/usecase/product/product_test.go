@@ -20,16 +20,30 @@ type productMocks struct {
 	MockReceptionRepo   *mocks.MockreceptionRepo
 	MockProductTypeRepo *mocks.MockproductTypeRepo
 	MockPvzRepo         *mocks.MockpvzRepo
+	MockTxManager       *mocks.MocktxManager
+	MockAuditRecorder   *mocks.MockauditRecorder
+	MockEventEmitter    *mocks.MockeventEmitter
 }
 
 func newProductMocks(t *testing.T) *productMocks {
 	ctrl := gomock.NewController(t)
 
+	txManager := mocks.NewMocktxManager(ctrl)
+	txManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
 	return &productMocks{
 		MockProductRepo:     mocks.NewMockproductRepo(ctrl),
 		MockReceptionRepo:   mocks.NewMockreceptionRepo(ctrl),
 		MockProductTypeRepo: mocks.NewMockproductTypeRepo(ctrl),
 		MockPvzRepo:         mocks.NewMockpvzRepo(ctrl),
+		MockTxManager:       txManager,
+		MockAuditRecorder:   mocks.NewMockauditRecorder(ctrl),
+		MockEventEmitter:    mocks.NewMockeventEmitter(ctrl),
 	}
 }
 
@@ -54,11 +68,16 @@ func TestProductUseCase_Create(t *testing.T) {
 				TypeName: "Electronics",
 			},
 			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
 				lastReception := &domain.Reception{ID: uuid.New()}
 				productType := &domain.ProductType{ID: uuid.New()}
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{PvzID: f.req.PvzID}).
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
 					Return(lastReception, nil).
 					Times(1)
 
@@ -74,9 +93,41 @@ func TestProductUseCase_Create(t *testing.T) {
 						return &p, nil
 					}).
 					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionProductAdded, e.Action)
+						require.Equal(t, f.req.PvzID, e.PvzID)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventProductAdded, e.Type)
+						require.Equal(t, f.req.PvzID, e.PvzID)
+						return nil
+					}).
+					Times(1)
 			},
 			wantErr: nil,
 		},
+		{
+			name: "pvz closed",
+			req: dto.ProductCreate{
+				PvzID:    uuid.New(),
+				TypeName: "Electronics",
+			},
+			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusClosed}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZNotActive,
+		},
 		{
 			name: "no reception in progress",
 			req: dto.ProductCreate{
@@ -84,8 +135,13 @@ func TestProductUseCase_Create(t *testing.T) {
 				TypeName: "Electronics",
 			},
 			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{PvzID: f.req.PvzID}).
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
 					Return(nil, infra.ErrNotFound).
 					Times(1)
 			},
@@ -98,8 +154,13 @@ func TestProductUseCase_Create(t *testing.T) {
 				TypeName: "Electronics",
 			},
 			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{PvzID: f.req.PvzID}).
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
 					Return(nil, errors.New("db error")).
 					Times(1)
 			},
@@ -112,18 +173,48 @@ func TestProductUseCase_Create(t *testing.T) {
 				TypeName: "Electronics",
 			},
 			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
 				lastReception := &domain.Reception{ID: uuid.New()}
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{PvzID: f.req.PvzID}).
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
 					Return(lastReception, nil).
 					Times(1)
 
 				m.MockProductTypeRepo.EXPECT().
 					Get(ctx, domain.ProductType{Name: f.req.TypeName}).
-					Return(nil, errors.New("not found")).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrProductTypeNotFound,
+		},
+		{
+			name: "product type lookup error",
+			req: dto.ProductCreate{
+				PvzID:    uuid.New(),
+				TypeName: "Electronics",
+			},
+			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
+				lastReception := &domain.Reception{ID: uuid.New()}
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
+					Return(lastReception, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: f.req.TypeName}).
+					Return(nil, errors.New("db error")).
 					Times(1)
 			},
-			wantErr: errors.New("products.Create: failed to find product type 'Electronics': not found"),
+			wantErr: errors.New("products.Create: failed to find product type 'Electronics': db error"),
 		},
 		{
 			name: "repo create error",
@@ -132,11 +223,16 @@ func TestProductUseCase_Create(t *testing.T) {
 				TypeName: "Electronics",
 			},
 			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
 				lastReception := &domain.Reception{ID: uuid.New()}
 				productType := &domain.ProductType{ID: uuid.New()}
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{PvzID: f.req.PvzID}).
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
 					Return(lastReception, nil).
 					Times(1)
 
@@ -152,6 +248,204 @@ func TestProductUseCase_Create(t *testing.T) {
 			},
 			wantErr: errors.New("products.Create: failed to create product: db error"),
 		},
+		{
+			name: "pvz not found",
+			req: dto.ProductCreate{
+				PvzID:    uuid.New(),
+				TypeName: "Electronics",
+			},
+			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZNotFound,
+		},
+		{
+			name: "duplicate barcode",
+			req: dto.ProductCreate{
+				PvzID:    uuid.New(),
+				TypeName: "Electronics",
+				Barcode:  "4601234567890",
+			},
+			mockFn: func(f fields, m *productMocks) {
+				reception := &domain.Reception{ID: uuid.New()}
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
+					Return(reception, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: f.req.TypeName}).
+					Return(&domain.ProductType{ID: uuid.New(), Name: f.req.TypeName}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, p domain.Product) (*domain.Product, error) {
+						require.Equal(t, f.req.Barcode, p.Barcode)
+						return nil, infra.ErrDuplicate
+					}).
+					Times(1)
+			},
+			wantErr: domain.ErrProductBarcodeDuplicate,
+		},
+		{
+			name: "ok within capacity",
+			req: dto.ProductCreate{
+				PvzID:    uuid.New(),
+				TypeName: "Electronics",
+			},
+			mockFn: func(f fields, m *productMocks) {
+				capacity := 3
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{
+						ID:             f.req.PvzID,
+						Status:         domain.PVZStatusActive,
+						Capacity:       &capacity,
+						TypeCapacities: map[string]int{"Electronics": 2},
+					}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
+					Return(&domain.Reception{ID: uuid.New()}, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: f.req.TypeName}).
+					Return(&domain.ProductType{ID: uuid.New(), Name: f.req.TypeName}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.req.PvzID, nil).
+					Return(map[string]int{"Electronics": 1, "Clothes": 1}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, p domain.Product) (*domain.Product, error) {
+						p.ID = uuid.New()
+						return &p, nil
+					}).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "pvz capacity exceeded",
+			req: dto.ProductCreate{
+				PvzID:    uuid.New(),
+				TypeName: "Electronics",
+			},
+			mockFn: func(f fields, m *productMocks) {
+				capacity := 2
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive, Capacity: &capacity}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
+					Return(&domain.Reception{ID: uuid.New()}, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: f.req.TypeName}).
+					Return(&domain.ProductType{ID: uuid.New(), Name: f.req.TypeName}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.req.PvzID, nil).
+					Return(map[string]int{"Electronics": 1, "Clothes": 1}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZCapacityExceeded,
+		},
+		{
+			name: "product type capacity exceeded",
+			req: dto.ProductCreate{
+				PvzID:    uuid.New(),
+				TypeName: "Electronics",
+			},
+			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{
+						ID:             f.req.PvzID,
+						Status:         domain.PVZStatusActive,
+						TypeCapacities: map[string]int{"Electronics": 1},
+					}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
+					Return(&domain.Reception{ID: uuid.New()}, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: f.req.TypeName}).
+					Return(&domain.ProductType{ID: uuid.New(), Name: f.req.TypeName}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.req.PvzID, nil).
+					Return(map[string]int{"Electronics": 1}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZCapacityExceeded,
+		},
+		{
+			name: "stock error",
+			req: dto.ProductCreate{
+				PvzID:    uuid.New(),
+				TypeName: "Electronics",
+			},
+			mockFn: func(f fields, m *productMocks) {
+				capacity := 10
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive, Capacity: &capacity}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
+					Return(&domain.Reception{ID: uuid.New()}, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: f.req.TypeName}).
+					Return(&domain.ProductType{ID: uuid.New(), Name: f.req.TypeName}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.req.PvzID, nil).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("products.Create: failed to get pvz stock: db error"),
+		},
 	}
 
 	for _, tt := range testcases {
@@ -166,6 +460,9 @@ func TestProductUseCase_Create(t *testing.T) {
 				productMocks.MockReceptionRepo,
 				productMocks.MockProductTypeRepo,
 				productMocks.MockPvzRepo,
+				productMocks.MockTxManager,
+				productMocks.MockAuditRecorder,
+				productMocks.MockEventEmitter,
 			)
 
 			product, err := useCase.Create(ctx, tt.req)
@@ -192,6 +489,7 @@ func TestProductUseCase_DeleteLastProduct(t *testing.T) {
 
 	testutils.InitTestLogger()
 	ctx := context.Background()
+	deletedBy := uuid.New()
 
 	type fields struct {
 		name    string
@@ -210,12 +508,12 @@ func TestProductUseCase_DeleteLastProduct(t *testing.T) {
 				lastProduct := &domain.Product{ID: uuid.New()}
 
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
+					GetForUpdate(ctx, f.pvzID).
 					Return(pvz, nil).
 					Times(1)
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{PvzID: f.pvzID}).
+					FindOpen(ctx, domain.Reception{PvzID: f.pvzID}).
 					Return(lastReception, nil).
 					Times(1)
 
@@ -228,15 +526,68 @@ func TestProductUseCase_DeleteLastProduct(t *testing.T) {
 					DeleteProduct(ctx, lastProduct.ID).
 					Return(nil).
 					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionProductRemoved, e.Action)
+						require.Equal(t, deletedBy, e.ActorID)
+						require.Equal(t, lastProduct.ID, e.EntityID)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventProductRemoved, e.Type)
+						require.Equal(t, lastProduct.ID, e.AggregateID)
+						return nil
+					}).
+					Times(1)
 			},
 			wantErr: nil,
 		},
+		{
+			name:  "failed to record audit",
+			pvzID: uuid.New(),
+			mockFn: func(f fields, m *productMocks) {
+				lastReception := &domain.Reception{ID: uuid.New()}
+				lastProduct := &domain.Product{ID: uuid.New()}
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.pvzID}).
+					Return(lastReception, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					GetLastProductInReception(ctx, lastReception.ID).
+					Return(lastProduct, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					DeleteProduct(ctx, lastProduct.ID).
+					Return(nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					Return(errors.New("audit error")).
+					Times(1)
+			},
+			wantErr: errors.New("products.DeleteLastProduct: audit error"),
+		},
 		{
 			name:  "pvz not found",
 			pvzID: uuid.New(),
 			mockFn: func(f fields, m *productMocks) {
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
+					GetForUpdate(ctx, f.pvzID).
 					Return(nil, infra.ErrNotFound).
 					Times(1)
 			},
@@ -247,12 +598,12 @@ func TestProductUseCase_DeleteLastProduct(t *testing.T) {
 			pvzID: uuid.New(),
 			mockFn: func(f fields, m *productMocks) {
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
-					Return(&domain.PVZ{ID: f.pvzID}, nil).
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
 					Times(1)
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{PvzID: f.pvzID}).
+					FindOpen(ctx, domain.Reception{PvzID: f.pvzID}).
 					Return(nil, infra.ErrNotFound).
 					Times(1)
 			},
@@ -265,12 +616,12 @@ func TestProductUseCase_DeleteLastProduct(t *testing.T) {
 				lastReception := &domain.Reception{ID: uuid.New()}
 
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
-					Return(&domain.PVZ{ID: f.pvzID}, nil).
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
 					Times(1)
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{PvzID: f.pvzID}).
+					FindOpen(ctx, domain.Reception{PvzID: f.pvzID}).
 					Return(lastReception, nil).
 					Times(1)
 
@@ -289,12 +640,12 @@ func TestProductUseCase_DeleteLastProduct(t *testing.T) {
 				lastProduct := &domain.Product{ID: uuid.New()}
 
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
-					Return(&domain.PVZ{ID: f.pvzID}, nil).
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
 					Times(1)
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{PvzID: f.pvzID}).
+					FindOpen(ctx, domain.Reception{PvzID: f.pvzID}).
 					Return(lastReception, nil).
 					Times(1)
 
@@ -310,6 +661,41 @@ func TestProductUseCase_DeleteLastProduct(t *testing.T) {
 			},
 			wantErr: errors.New("products.DeleteLastProduct: failed to delete product: delete error"),
 		},
+		{
+			name:  "stock error after delete",
+			pvzID: uuid.New(),
+			mockFn: func(f fields, m *productMocks) {
+				capacity := 10
+				lastReception := &domain.Reception{ID: uuid.New()}
+				lastProduct := &domain.Product{ID: uuid.New()}
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive, Capacity: &capacity}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.pvzID}).
+					Return(lastReception, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					GetLastProductInReception(ctx, lastReception.ID).
+					Return(lastProduct, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					DeleteProduct(ctx, lastProduct.ID).
+					Return(nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.pvzID, nil).
+					Return(nil, errors.New("stock error")).
+					Times(1)
+			},
+			wantErr: errors.New("products.DeleteLastProduct: failed to get pvz stock: stock error"),
+		},
 	}
 
 	for _, tt := range testcases {
@@ -324,9 +710,12 @@ func TestProductUseCase_DeleteLastProduct(t *testing.T) {
 				productMocks.MockReceptionRepo,
 				productMocks.MockProductTypeRepo,
 				productMocks.MockPvzRepo,
+				productMocks.MockTxManager,
+				productMocks.MockAuditRecorder,
+				productMocks.MockEventEmitter,
 			)
 
-			product, err := useCase.DeleteLastProduct(ctx, tt.pvzID)
+			product, err := useCase.DeleteLastProduct(ctx, dto.ProductDeleteLast{PvzID: tt.pvzID, DeletedBy: deletedBy})
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -339,3 +728,635 @@ func TestProductUseCase_DeleteLastProduct(t *testing.T) {
 		})
 	}
 }
+
+func TestProductUseCase_DeleteProduct(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+	deletedBy := uuid.New()
+
+	type fields struct {
+		name      string
+		productID uuid.UUID
+		mockFn    func(f fields, m *productMocks)
+		wantErr   error
+	}
+
+	expectLockedReception := func(f fields, m *productMocks, reception *domain.Reception) {
+		m.MockProductRepo.EXPECT().
+			Get(ctx, domain.Product{ID: f.productID}).
+			Return(&domain.Product{ID: f.productID, ReceptionID: reception.ID}, nil).
+			Times(1)
+
+		m.MockReceptionRepo.EXPECT().
+			GetWithStatus(ctx, reception.ID).
+			Return(reception, nil).
+			Times(2)
+
+		m.MockPvzRepo.EXPECT().
+			GetForUpdate(ctx, reception.PvzID).
+			Return(&domain.PVZ{ID: reception.PvzID, Status: domain.PVZStatusActive}, nil).
+			Times(1)
+	}
+
+	testcases := []fields{
+		{
+			name:      "ok",
+			productID: uuid.New(),
+			mockFn: func(f fields, m *productMocks) {
+				reception := &domain.Reception{
+					ID:              uuid.New(),
+					PvzID:           uuid.New(),
+					ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusReopened},
+				}
+				expectLockedReception(f, m, reception)
+
+				m.MockProductRepo.EXPECT().
+					DeleteProduct(ctx, f.productID).
+					Return(nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionProductRemoved, e.Action)
+						require.Equal(t, deletedBy, e.ActorID)
+						require.Equal(t, f.productID, e.EntityID)
+						require.Equal(t, reception.PvzID, e.PvzID)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventProductRemoved, e.Type)
+						require.Equal(t, f.productID, e.AggregateID)
+						return nil
+					}).
+					Times(1)
+			},
+			wantErr: nil,
+		},
+		{
+			name:      "product not found",
+			productID: uuid.New(),
+			mockFn: func(f fields, m *productMocks) {
+				m.MockProductRepo.EXPECT().
+					Get(ctx, domain.Product{ID: f.productID}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrProductNotFound,
+		},
+		{
+			name:      "product issued",
+			productID: uuid.New(),
+			mockFn: func(f fields, m *productMocks) {
+				receptionID := uuid.New()
+
+				m.MockProductRepo.EXPECT().
+					Get(ctx, domain.Product{ID: f.productID}).
+					Return(&domain.Product{ID: f.productID, ReceptionID: receptionID, Issued: true}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					GetWithStatus(ctx, receptionID).
+					Return(&domain.Reception{ID: receptionID, PvzID: uuid.New()}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrProductAlreadyIssued,
+		},
+		{
+			name:      "reception closed",
+			productID: uuid.New(),
+			mockFn: func(f fields, m *productMocks) {
+				expectLockedReception(f, m, &domain.Reception{
+					ID:              uuid.New(),
+					PvzID:           uuid.New(),
+					ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusClose},
+				})
+			},
+			wantErr: domain.ErrProductReceptionClosed,
+		},
+		{
+			name:      "delete product error",
+			productID: uuid.New(),
+			mockFn: func(f fields, m *productMocks) {
+				expectLockedReception(f, m, &domain.Reception{
+					ID:              uuid.New(),
+					PvzID:           uuid.New(),
+					ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusInProgress},
+				})
+
+				m.MockProductRepo.EXPECT().
+					DeleteProduct(ctx, f.productID).
+					Return(errors.New("delete error")).
+					Times(1)
+			},
+			wantErr: errors.New("products.DeleteProduct: failed to delete product: delete error"),
+		},
+		{
+			name:      "returned product has issuance history",
+			productID: uuid.New(),
+			mockFn: func(f fields, m *productMocks) {
+				expectLockedReception(f, m, &domain.Reception{
+					ID:              uuid.New(),
+					PvzID:           uuid.New(),
+					ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusReopened},
+				})
+
+				m.MockProductRepo.EXPECT().
+					DeleteProduct(ctx, f.productID).
+					Return(infra.ErrReferenced).
+					Times(1)
+			},
+			wantErr: domain.ErrProductHasIssuances,
+		},
+		{
+			name:      "stock error after delete",
+			productID: uuid.New(),
+			mockFn: func(f fields, m *productMocks) {
+				capacity := 10
+				reception := &domain.Reception{
+					ID:              uuid.New(),
+					PvzID:           uuid.New(),
+					ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusInProgress},
+				}
+
+				m.MockProductRepo.EXPECT().
+					Get(ctx, domain.Product{ID: f.productID}).
+					Return(&domain.Product{ID: f.productID, ReceptionID: reception.ID}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					GetWithStatus(ctx, reception.ID).
+					Return(reception, nil).
+					Times(2)
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, reception.PvzID).
+					Return(&domain.PVZ{ID: reception.PvzID, Status: domain.PVZStatusActive, Capacity: &capacity}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					DeleteProduct(ctx, f.productID).
+					Return(nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, reception.PvzID, nil).
+					Return(nil, errors.New("stock error")).
+					Times(1)
+			},
+			wantErr: errors.New("products.DeleteProduct: failed to get pvz stock: stock error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			productMocks := newProductMocks(t)
+			tt.mockFn(tt, productMocks)
+
+			useCase := New(
+				productMocks.MockProductRepo,
+				productMocks.MockReceptionRepo,
+				productMocks.MockProductTypeRepo,
+				productMocks.MockPvzRepo,
+				productMocks.MockTxManager,
+				productMocks.MockAuditRecorder,
+				productMocks.MockEventEmitter,
+			)
+
+			product, err := useCase.DeleteProduct(ctx, dto.ProductDelete{ProductID: tt.productID, DeletedBy: deletedBy})
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, product)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.productID, product.ID)
+		})
+	}
+}
+
+func TestProductUseCase_CreateBatch(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+	createdBy := uuid.New()
+	shoes := &domain.ProductType{ID: uuid.New(), Name: "обувь"}
+
+	type fields struct {
+		name         string
+		pvzID        uuid.UUID
+		items        []dto.ProductBatchItem
+		allOrNothing bool
+		mockFn       func(f fields, m *productMocks)
+		wantErr      error
+		wantResults  []error
+	}
+
+	expectOpenReception := func(f fields, m *productMocks) *domain.Reception {
+		reception := &domain.Reception{ID: uuid.New(), PvzID: f.pvzID}
+
+		m.MockPvzRepo.EXPECT().
+			GetForUpdate(ctx, f.pvzID).
+			Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
+			Times(1)
+
+		m.MockReceptionRepo.EXPECT().
+			FindOpen(ctx, domain.Reception{PvzID: f.pvzID}).
+			Return(reception, nil).
+			Times(1)
+
+		return reception
+	}
+
+	testcases := []fields{
+		{
+			name:  "partial failure",
+			pvzID: uuid.New(),
+			items: []dto.ProductBatchItem{{TypeName: "обувь"}, {TypeName: "unknown"}, {TypeName: "обувь"}},
+			mockFn: func(f fields, m *productMocks) {
+				reception := expectOpenReception(f, m)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: "обувь"}).
+					Return(shoes, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: "unknown"}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CreateBatch(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, products []domain.Product) ([]*domain.Product, error) {
+						require.Len(t, products, 2)
+						require.True(t, products[0].DateTime.Before(products[1].DateTime))
+
+						res := make([]*domain.Product, 0, len(products))
+						for _, p := range products {
+							require.Equal(t, reception.ID, p.ReceptionID)
+							require.Equal(t, shoes.ID, p.TypeID)
+							require.Equal(t, createdBy, p.CreatedBy)
+							p.ID = uuid.New()
+							res = append(res, &p)
+						}
+						return res, nil
+					}).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					Return(nil).
+					Times(2)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					Return(nil).
+					Times(2)
+			},
+			wantResults: []error{nil, domain.ErrProductTypeNotFound, nil},
+		},
+		{
+			name:         "all or nothing fails on unknown type",
+			pvzID:        uuid.New(),
+			items:        []dto.ProductBatchItem{{TypeName: "обувь"}, {TypeName: "unknown"}},
+			allOrNothing: true,
+			mockFn: func(f fields, m *productMocks) {
+				expectOpenReception(f, m)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: "обувь"}).
+					Return(shoes, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: "unknown"}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrProductTypeNotFound,
+		},
+		{
+			name:  "duplicate barcodes are reported per item",
+			pvzID: uuid.New(),
+			items: []dto.ProductBatchItem{
+				{TypeName: "обувь", Barcode: "taken"},
+				{TypeName: "обувь", Barcode: "fresh"},
+				{TypeName: "обувь", Barcode: "fresh"},
+			},
+			mockFn: func(f fields, m *productMocks) {
+				expectOpenReception(f, m)
+
+				m.MockProductRepo.EXPECT().
+					ListOpenBarcodes(ctx, []string{"taken", "fresh", "fresh"}).
+					Return([]string{"taken"}, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: "обувь"}).
+					Return(shoes, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CreateBatch(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, products []domain.Product) ([]*domain.Product, error) {
+						require.Len(t, products, 1)
+						require.Equal(t, "fresh", products[0].Barcode)
+						return []*domain.Product{{ID: uuid.New(), Barcode: "fresh"}}, nil
+					}).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantResults: []error{domain.ErrProductBarcodeDuplicate, nil, domain.ErrProductBarcodeDuplicate},
+		},
+		{
+			name:  "items beyond type capacity are reported per item",
+			pvzID: uuid.New(),
+			items: []dto.ProductBatchItem{{TypeName: "обувь"}, {TypeName: "обувь"}, {TypeName: "обувь"}},
+			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{
+						ID:             f.pvzID,
+						Status:         domain.PVZStatusActive,
+						TypeCapacities: map[string]int{"обувь": 2},
+					}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.pvzID}).
+					Return(&domain.Reception{ID: uuid.New(), PvzID: f.pvzID}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.pvzID, nil).
+					Return(map[string]int{"обувь": 1}, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: "обувь"}).
+					Return(shoes, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CreateBatch(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, products []domain.Product) ([]*domain.Product, error) {
+						require.Len(t, products, 1)
+						return []*domain.Product{{ID: uuid.New()}}, nil
+					}).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+			wantResults: []error{nil, domain.ErrPVZCapacityExceeded, domain.ErrPVZCapacityExceeded},
+		},
+		{
+			name:         "all or nothing fails beyond pvz capacity",
+			pvzID:        uuid.New(),
+			items:        []dto.ProductBatchItem{{TypeName: "обувь"}, {TypeName: "обувь"}},
+			allOrNothing: true,
+			mockFn: func(f fields, m *productMocks) {
+				capacity := 5
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive, Capacity: &capacity}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.pvzID}).
+					Return(&domain.Reception{ID: uuid.New(), PvzID: f.pvzID}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.pvzID, nil).
+					Return(map[string]int{"обувь": 2, "одежда": 2}, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: "обувь"}).
+					Return(shoes, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZCapacityExceeded,
+		},
+		{
+			name:    "empty batch",
+			pvzID:   uuid.New(),
+			mockFn:  func(f fields, m *productMocks) {},
+			wantErr: domain.ErrProductBatchSize,
+		},
+		{
+			name:  "no open reception",
+			pvzID: uuid.New(),
+			items: []dto.ProductBatchItem{{TypeName: "обувь"}},
+			mockFn: func(f fields, m *productMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.pvzID}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrNoReceptionIsCurrentlyInProgress,
+		},
+		{
+			name:  "create batch error",
+			pvzID: uuid.New(),
+			items: []dto.ProductBatchItem{{TypeName: "обувь"}},
+			mockFn: func(f fields, m *productMocks) {
+				expectOpenReception(f, m)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: "обувь"}).
+					Return(shoes, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CreateBatch(ctx, gomock.Any()).
+					Return(nil, errors.New("batch error")).
+					Times(1)
+			},
+			wantErr: errors.New("products.CreateBatch: failed to create products: batch error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			productMocks := newProductMocks(t)
+			tt.mockFn(tt, productMocks)
+
+			useCase := New(
+				productMocks.MockProductRepo,
+				productMocks.MockReceptionRepo,
+				productMocks.MockProductTypeRepo,
+				productMocks.MockPvzRepo,
+				productMocks.MockTxManager,
+				productMocks.MockAuditRecorder,
+				productMocks.MockEventEmitter,
+			)
+
+			results, err := useCase.CreateBatch(ctx, dto.ProductBatchCreate{
+				PvzID:        tt.pvzID,
+				Items:        tt.items,
+				CreatedBy:    createdBy,
+				AllOrNothing: tt.allOrNothing,
+			})
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, results)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, results, len(tt.wantResults))
+			for i, wantErr := range tt.wantResults {
+				if wantErr != nil {
+					require.ErrorIs(t, results[i].Err, wantErr)
+					require.Nil(t, results[i].Product)
+					continue
+				}
+				require.NoError(t, results[i].Err)
+				require.Equal(t, shoes, results[i].Product.ProductType)
+			}
+		})
+	}
+}
+
+func TestProductUseCase_GetByBarcode(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	type fields struct {
+		name    string
+		barcode string
+		mockFn  func(f fields, m *productMocks)
+		wantErr error
+	}
+
+	testcases := []fields{
+		{
+			name:    "ok",
+			barcode: "4601234567890",
+			mockFn: func(f fields, m *productMocks) {
+				product := &domain.Product{ID: uuid.New(), ReceptionID: uuid.New(), Barcode: f.barcode}
+				reception := &domain.Reception{ID: product.ReceptionID, PvzID: uuid.New()}
+
+				m.MockProductRepo.EXPECT().
+					GetLastByBarcode(ctx, f.barcode).
+					Return(product, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					GetWithStatus(ctx, product.ReceptionID).
+					Return(reception, nil).
+					Times(1)
+
+				m.MockPvzRepo.EXPECT().
+					Get(ctx, domain.PVZ{ID: reception.PvzID}).
+					Return(&domain.PVZ{ID: reception.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "not found",
+			barcode: "unknown",
+			mockFn: func(f fields, m *productMocks) {
+				m.MockProductRepo.EXPECT().
+					GetLastByBarcode(ctx, f.barcode).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrProductNotFound,
+		},
+		{
+			name:    "reception error",
+			barcode: "4601234567890",
+			mockFn: func(f fields, m *productMocks) {
+				product := &domain.Product{ID: uuid.New(), ReceptionID: uuid.New()}
+
+				m.MockProductRepo.EXPECT().
+					GetLastByBarcode(ctx, f.barcode).
+					Return(product, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					GetWithStatus(ctx, product.ReceptionID).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("products.GetByBarcode: failed to get reception: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			productMocks := newProductMocks(t)
+			tt.mockFn(tt, productMocks)
+
+			useCase := New(
+				productMocks.MockProductRepo,
+				productMocks.MockReceptionRepo,
+				productMocks.MockProductTypeRepo,
+				productMocks.MockPvzRepo,
+				productMocks.MockTxManager,
+				productMocks.MockAuditRecorder,
+				productMocks.MockEventEmitter,
+			)
+
+			location, err := useCase.GetByBarcode(ctx, tt.barcode)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, location)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.barcode, location.Product.Barcode)
+			require.Equal(t, location.Reception.PvzID, location.PVZ.ID)
+		})
+	}
+}
@@ -44,6 +44,21 @@ func (m *MockpvzRepo) EXPECT() *MockpvzRepoMockRecorder {
 	return m.recorder
 }
 
+// CountPvzByAcceptanceDateAndCity mocks base method.
+func (m *MockpvzRepo) CountPvzByAcceptanceDateAndCity(ctx context.Context, filter domain.PVZListFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPvzByAcceptanceDateAndCity", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPvzByAcceptanceDateAndCity indicates an expected call of CountPvzByAcceptanceDateAndCity.
+func (mr *MockpvzRepoMockRecorder) CountPvzByAcceptanceDateAndCity(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPvzByAcceptanceDateAndCity", reflect.TypeOf((*MockpvzRepo)(nil).CountPvzByAcceptanceDateAndCity), ctx, filter)
+}
+
 // Create mocks base method.
 func (m *MockpvzRepo) Create(ctx context.Context, pvz domain.PVZ) (*domain.PVZ, error) {
 	m.ctrl.T.Helper()
@@ -59,6 +74,36 @@ func (mr *MockpvzRepoMockRecorder) Create(ctx, pvz any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockpvzRepo)(nil).Create), ctx, pvz)
 }
 
+// Get mocks base method.
+func (m *MockpvzRepo) Get(ctx context.Context, filter domain.PVZ) (*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, filter)
+	ret0, _ := ret[0].(*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockpvzRepoMockRecorder) Get(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockpvzRepo)(nil).Get), ctx, filter)
+}
+
+// GetForUpdate mocks base method.
+func (m *MockpvzRepo) GetForUpdate(ctx context.Context, pvzID uuid.UUID) (*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, pvzID)
+	ret0, _ := ret[0].(*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockpvzRepoMockRecorder) GetForUpdate(ctx, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockpvzRepo)(nil).GetForUpdate), ctx, pvzID)
+}
+
 // GetList mocks base method.
 func (m *MockpvzRepo) GetList(ctx context.Context, pagination *listparams.Pagination) ([]*domain.PVZ, error) {
 	m.ctrl.T.Helper()
@@ -74,19 +119,64 @@ func (mr *MockpvzRepoMockRecorder) GetList(ctx, pagination any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockpvzRepo)(nil).GetList), ctx, pagination)
 }
 
+// ListNearby mocks base method.
+func (m *MockpvzRepo) ListNearby(ctx context.Context, point domain.GeoPoint, radiusKm float64, limit uint) ([]*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNearby", ctx, point, radiusKm, limit)
+	ret0, _ := ret[0].([]*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNearby indicates an expected call of ListNearby.
+func (mr *MockpvzRepoMockRecorder) ListNearby(ctx, point, radiusKm, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNearby", reflect.TypeOf((*MockpvzRepo)(nil).ListNearby), ctx, point, radiusKm, limit)
+}
+
 // ListPvzByAcceptanceDateAndCity mocks base method.
-func (m *MockpvzRepo) ListPvzByAcceptanceDateAndCity(ctx context.Context, pagination *listparams.Pagination, startDate, endDate *time.Time) ([]*domain.PVZ, error) {
+func (m *MockpvzRepo) ListPvzByAcceptanceDateAndCity(ctx context.Context, filter domain.PVZListFilter, sorts []listparams.Sort, pagination *listparams.Pagination, after *listparams.Cursor) ([]*domain.PVZ, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPvzByAcceptanceDateAndCity", ctx, pagination, startDate, endDate)
+	ret := m.ctrl.Call(m, "ListPvzByAcceptanceDateAndCity", ctx, filter, sorts, pagination, after)
 	ret0, _ := ret[0].([]*domain.PVZ)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPvzByAcceptanceDateAndCity indicates an expected call of ListPvzByAcceptanceDateAndCity.
-func (mr *MockpvzRepoMockRecorder) ListPvzByAcceptanceDateAndCity(ctx, pagination, startDate, endDate any) *gomock.Call {
+func (mr *MockpvzRepoMockRecorder) ListPvzByAcceptanceDateAndCity(ctx, filter, sorts, pagination, after any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzByAcceptanceDateAndCity", reflect.TypeOf((*MockpvzRepo)(nil).ListPvzByAcceptanceDateAndCity), ctx, pagination, startDate, endDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzByAcceptanceDateAndCity", reflect.TypeOf((*MockpvzRepo)(nil).ListPvzByAcceptanceDateAndCity), ctx, filter, sorts, pagination, after)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockpvzRepo) UpdateProfile(ctx context.Context, pvz domain.PVZ) (*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, pvz)
+	ret0, _ := ret[0].(*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockpvzRepoMockRecorder) UpdateProfile(ctx, pvz any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockpvzRepo)(nil).UpdateProfile), ctx, pvz)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockpvzRepo) UpdateStatus(ctx context.Context, pvzID uuid.UUID, status domain.PVZStatus, reason string, effectiveAt time.Time) (*domain.PVZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, pvzID, status, reason, effectiveAt)
+	ret0, _ := ret[0].(*domain.PVZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockpvzRepoMockRecorder) UpdateStatus(ctx, pvzID, status, reason, effectiveAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockpvzRepo)(nil).UpdateStatus), ctx, pvzID, status, reason, effectiveAt)
 }
 
 // MockcityRepo is a mock of cityRepo interface.
@@ -152,6 +242,21 @@ func (m *MockreceptionRepo) EXPECT() *MockreceptionRepoMockRecorder {
 	return m.recorder
 }
 
+// FindOpen mocks base method.
+func (m *MockreceptionRepo) FindOpen(ctx context.Context, filter domain.Reception) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpen", ctx, filter)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpen indicates an expected call of FindOpen.
+func (mr *MockreceptionRepoMockRecorder) FindOpen(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpen", reflect.TypeOf((*MockreceptionRepo)(nil).FindOpen), ctx, filter)
+}
+
 // ListByIDsWithStatus mocks base method.
 func (m *MockreceptionRepo) ListByIDsWithStatus(ctx context.Context, receptionIDs []uuid.UUID) ([]*domain.Reception, error) {
 	m.ctrl.T.Helper()
@@ -167,6 +272,21 @@ func (mr *MockreceptionRepoMockRecorder) ListByIDsWithStatus(ctx, receptionIDs a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDsWithStatus", reflect.TypeOf((*MockreceptionRepo)(nil).ListByIDsWithStatus), ctx, receptionIDs)
 }
 
+// ListByPVZWithStatus mocks base method.
+func (m *MockreceptionRepo) ListByPVZWithStatus(ctx context.Context, pvzID uuid.UUID, pagination *listparams.Pagination, startDate, endDate *time.Time) ([]*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPVZWithStatus", ctx, pvzID, pagination, startDate, endDate)
+	ret0, _ := ret[0].([]*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPVZWithStatus indicates an expected call of ListByPVZWithStatus.
+func (mr *MockreceptionRepoMockRecorder) ListByPVZWithStatus(ctx, pvzID, pagination, startDate, endDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPVZWithStatus", reflect.TypeOf((*MockreceptionRepo)(nil).ListByPVZWithStatus), ctx, pvzID, pagination, startDate, endDate)
+}
+
 // MockproductRepo is a mock of productRepo interface.
 type MockproductRepo struct {
 	ctrl     *gomock.Controller
@@ -191,6 +311,36 @@ func (m *MockproductRepo) EXPECT() *MockproductRepoMockRecorder {
 	return m.recorder
 }
 
+// CountOnHandByPVZ mocks base method.
+func (m *MockproductRepo) CountOnHandByPVZ(ctx context.Context, pvzIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOnHandByPVZ", ctx, pvzIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOnHandByPVZ indicates an expected call of CountOnHandByPVZ.
+func (mr *MockproductRepoMockRecorder) CountOnHandByPVZ(ctx, pvzIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOnHandByPVZ", reflect.TypeOf((*MockproductRepo)(nil).CountOnHandByPVZ), ctx, pvzIDs)
+}
+
+// InventoryByType mocks base method.
+func (m *MockproductRepo) InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InventoryByType", ctx, pvzID, asOf)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InventoryByType indicates an expected call of InventoryByType.
+func (mr *MockproductRepoMockRecorder) InventoryByType(ctx, pvzID, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InventoryByType", reflect.TypeOf((*MockproductRepo)(nil).InventoryByType), ctx, pvzID, asOf)
+}
+
 // ListByReceptionIDsWithTypeName mocks base method.
 func (m *MockproductRepo) ListByReceptionIDsWithTypeName(ctx context.Context, receptionIDs []uuid.UUID) ([]*domain.Product, error) {
 	m.ctrl.T.Helper()
@@ -205,3 +355,156 @@ func (mr *MockproductRepoMockRecorder) ListByReceptionIDsWithTypeName(ctx, recep
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByReceptionIDsWithTypeName", reflect.TypeOf((*MockproductRepo)(nil).ListByReceptionIDsWithTypeName), ctx, receptionIDs)
 }
+
+// MockproductTypeRepo is a mock of productTypeRepo interface.
+type MockproductTypeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockproductTypeRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockproductTypeRepoMockRecorder is the mock recorder for MockproductTypeRepo.
+type MockproductTypeRepoMockRecorder struct {
+	mock *MockproductTypeRepo
+}
+
+// NewMockproductTypeRepo creates a new mock instance.
+func NewMockproductTypeRepo(ctrl *gomock.Controller) *MockproductTypeRepo {
+	mock := &MockproductTypeRepo{ctrl: ctrl}
+	mock.recorder = &MockproductTypeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproductTypeRepo) EXPECT() *MockproductTypeRepoMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockproductTypeRepo) Get(ctx context.Context, filter domain.ProductType) (*domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, filter)
+	ret0, _ := ret[0].(*domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockproductTypeRepoMockRecorder) Get(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockproductTypeRepo)(nil).Get), ctx, filter)
+}
+
+// MocktxManager is a mock of txManager interface.
+type MocktxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktxManagerMockRecorder is the mock recorder for MocktxManager.
+type MocktxManagerMockRecorder struct {
+	mock *MocktxManager
+}
+
+// NewMocktxManager creates a new mock instance.
+func NewMocktxManager(ctrl *gomock.Controller) *MocktxManager {
+	mock := &MocktxManager{ctrl: ctrl}
+	mock.recorder = &MocktxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktxManager) EXPECT() *MocktxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktxManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktxManager)(nil).Do), ctx, fn)
+}
+
+// MockauditRecorder is a mock of auditRecorder interface.
+type MockauditRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockauditRecorderMockRecorder is the mock recorder for MockauditRecorder.
+type MockauditRecorderMockRecorder struct {
+	mock *MockauditRecorder
+}
+
+// NewMockauditRecorder creates a new mock instance.
+func NewMockauditRecorder(ctrl *gomock.Controller) *MockauditRecorder {
+	mock := &MockauditRecorder{ctrl: ctrl}
+	mock.recorder = &MockauditRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditRecorder) EXPECT() *MockauditRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockauditRecorder) Record(ctx context.Context, event domain.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockauditRecorderMockRecorder) Record(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockauditRecorder)(nil).Record), ctx, event)
+}
+
+// MockeventEmitter is a mock of eventEmitter interface.
+type MockeventEmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventEmitterMockRecorder
+	isgomock struct{}
+}
+
+// MockeventEmitterMockRecorder is the mock recorder for MockeventEmitter.
+type MockeventEmitterMockRecorder struct {
+	mock *MockeventEmitter
+}
+
+// NewMockeventEmitter creates a new mock instance.
+func NewMockeventEmitter(ctrl *gomock.Controller) *MockeventEmitter {
+	mock := &MockeventEmitter{ctrl: ctrl}
+	mock.recorder = &MockeventEmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventEmitter) EXPECT() *MockeventEmitterMockRecorder {
+	return m.recorder
+}
+
+// Emit mocks base method.
+func (m *MockeventEmitter) Emit(ctx context.Context, event domain.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Emit", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Emit indicates an expected call of Emit.
+func (mr *MockeventEmitterMockRecorder) Emit(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockeventEmitter)(nil).Emit), ctx, event)
+}
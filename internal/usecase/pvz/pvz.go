@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/capacity"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
 	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 )
@@ -16,7 +18,13 @@ import (
 //go:generate ${LOCAL_BIN}/mockgen -source=pvz.go -destination=./mocks/pvz_mock.go -package=mocks
 type pvzRepo interface {
 	Create(ctx context.Context, pvz domain.PVZ) (*domain.PVZ, error)
-	ListPvzByAcceptanceDateAndCity(ctx context.Context, pagination *listparams.Pagination, startDate *time.Time, endDate *time.Time) ([]*domain.PVZ, error)
+	Get(ctx context.Context, filter domain.PVZ) (*domain.PVZ, error)
+	GetForUpdate(ctx context.Context, pvzID uuid.UUID) (*domain.PVZ, error)
+	UpdateStatus(ctx context.Context, pvzID uuid.UUID, status domain.PVZStatus, reason string, effectiveAt time.Time) (*domain.PVZ, error)
+	UpdateProfile(ctx context.Context, pvz domain.PVZ) (*domain.PVZ, error)
+	ListNearby(ctx context.Context, point domain.GeoPoint, radiusKm float64, limit uint) ([]*domain.PVZ, error)
+	ListPvzByAcceptanceDateAndCity(ctx context.Context, filter domain.PVZListFilter, sorts []listparams.Sort, pagination *listparams.Pagination, after *listparams.Cursor) ([]*domain.PVZ, error)
+	CountPvzByAcceptanceDateAndCity(ctx context.Context, filter domain.PVZListFilter) (int, error)
 	GetList(ctx context.Context, pagination *listparams.Pagination) ([]*domain.PVZ, error)
 }
 
@@ -25,32 +33,104 @@ type cityRepo interface {
 }
 
 type receptionRepo interface {
+	FindOpen(ctx context.Context, filter domain.Reception) (*domain.Reception, error)
 	ListByIDsWithStatus(ctx context.Context, receptionIDs []uuid.UUID) ([]*domain.Reception, error)
+	ListByPVZWithStatus(ctx context.Context, pvzID uuid.UUID, pagination *listparams.Pagination, startDate, endDate *time.Time) ([]*domain.Reception, error)
 }
 
 type productRepo interface {
 	ListByReceptionIDsWithTypeName(ctx context.Context, receptionIDs []uuid.UUID) ([]*domain.Product, error)
+	CountOnHandByPVZ(ctx context.Context, pvzIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error)
+}
+
+type productTypeRepo interface {
+	Get(ctx context.Context, filter domain.ProductType) (*domain.ProductType, error)
+}
+
+type txManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type auditRecorder interface {
+	Record(ctx context.Context, event domain.AuditEvent) error
+}
+
+type eventEmitter interface {
+	Emit(ctx context.Context, event domain.OutboxEvent) error
 }
 
 type PVZUseCase struct {
-	pvzRepo       pvzRepo
-	cityRepo      cityRepo
-	receptionRepo receptionRepo
-	productRepo   productRepo
+	pvzRepo         pvzRepo
+	cityRepo        cityRepo
+	receptionRepo   receptionRepo
+	productRepo     productRepo
+	productTypeRepo productTypeRepo
+	txManager       txManager
+	auditRecorder   auditRecorder
+	eventEmitter    eventEmitter
 }
 
-func New(pvzRepo pvzRepo, cityRepo cityRepo, receptionRepo receptionRepo, productRepo productRepo) *PVZUseCase {
+func New(
+	pvzRepo pvzRepo,
+	cityRepo cityRepo,
+	receptionRepo receptionRepo,
+	productRepo productRepo,
+	productTypeRepo productTypeRepo,
+	txManager txManager,
+	auditRecorder auditRecorder,
+	eventEmitter eventEmitter,
+) *PVZUseCase {
 	return &PVZUseCase{
 		pvzRepo,
 		cityRepo,
 		receptionRepo,
 		productRepo,
+		productTypeRepo,
+		txManager,
+		auditRecorder,
+		eventEmitter,
 	}
 }
 
 func (s *PVZUseCase) Create(ctx context.Context, createIn dto.PVZCreate) (*domain.PVZ, error) {
+	var res *domain.PVZ
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.create(ctx, createIn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *PVZUseCase) create(ctx context.Context, createIn dto.PVZCreate) (*domain.PVZ, error) {
 	const op = "pvz.Create"
 
+	pvzEnt := domain.PVZ{
+		ID:               createIn.ID,
+		RegistrationDate: createIn.RegistrationDate,
+		Address:          createIn.Address,
+		Location:         createIn.Location,
+		WorkingHours:     createIn.WorkingHours,
+		Capacity:         createIn.Capacity,
+		TypeCapacities:   createIn.TypeCapacities,
+	}
+	if err := pvzEnt.ValidateProfile(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkProductTypes(ctx, pvzEnt.TypeCapacities); err != nil {
+		if errors.Is(err, domain.ErrProductTypeNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	city, err := s.cityRepo.Get(ctx, domain.City{
 		Name: createIn.CityName,
 	})
@@ -61,11 +141,9 @@ func (s *PVZUseCase) Create(ctx context.Context, createIn dto.PVZCreate) (*domai
 		return nil, fmt.Errorf("%s: failed to get city: %w", op, err)
 	}
 
-	pvzRes, err := s.pvzRepo.Create(ctx, domain.PVZ{
-		ID:               createIn.ID,
-		RegistrationDate: createIn.RegistrationDate,
-		CityID:           city.ID,
-	})
+	pvzEnt.CityID = city.ID
+
+	pvzRes, err := s.pvzRepo.Create(ctx, pvzEnt)
 	if err != nil {
 		if errors.Is(err, infra.ErrDuplicate) {
 			return nil, domain.ErrDuplicatePvzID
@@ -75,9 +153,261 @@ func (s *PVZUseCase) Create(ctx context.Context, createIn dto.PVZCreate) (*domai
 
 	pvzRes.City = city
 
+	err = s.auditRecorder.Record(ctx, domain.AuditEvent{
+		ActorID:  createIn.CreatedBy,
+		Action:   domain.AuditActionPVZCreated,
+		EntityID: pvzRes.ID,
+		PvzID:    pvzRes.ID,
+		After:    pvzRes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+		Type:        domain.EventPVZCreated,
+		AggregateID: pvzRes.ID,
+		PvzID:       pvzRes.ID,
+		Payload:     pvzRes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return pvzRes, nil
 }
 
+func (s *PVZUseCase) ChangeStatus(ctx context.Context, changeIn dto.PVZStatusChange) (*domain.PVZ, error) {
+	var res *domain.PVZ
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.changeStatus(ctx, changeIn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// changeStatus переводит PVZ в новый статус. Приостановить или закрыть PVZ
+// можно только без открытой приёмки, чтобы товары не остались в незакрытой приёмке.
+func (s *PVZUseCase) changeStatus(ctx context.Context, changeIn dto.PVZStatusChange) (*domain.PVZ, error) {
+	const op = "pvz.ChangeStatus"
+
+	// отложенная и задним числом смена статуса не поддерживается: статус вступает в силу сразу,
+	// а effectiveDate клиента принимается, только если совпадает с текущим временем
+	effectiveAt := time.Now()
+	if changeIn.EffectiveAt != nil {
+		diff := changeIn.EffectiveAt.Sub(effectiveAt)
+		if diff > domain.PVZStatusEffectiveDateTolerance || diff < -domain.PVZStatusEffectiveDateTolerance {
+			return nil, domain.ErrPVZStatusEffectiveDate
+		}
+	}
+
+	// Блокировка PVZ упорядочивает смену статуса с открытием приёмок и добавлением товаров
+	pvzEnt, err := s.pvzRepo.GetForUpdate(ctx, changeIn.PvzID)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, domain.ErrPVZNotFound
+		}
+		return nil, fmt.Errorf("%s: failed to lock pvz: %w", op, err)
+	}
+
+	if !pvzEnt.Status.CanTransitionTo(changeIn.Status) {
+		return nil, fmt.Errorf("%w: %s -> %s", domain.ErrInvalidPVZStatusTransition, pvzEnt.Status, changeIn.Status)
+	}
+
+	if changeIn.Status != domain.PVZStatusActive {
+		_, err = s.receptionRepo.FindOpen(ctx, domain.Reception{PvzID: changeIn.PvzID})
+		if err == nil {
+			return nil, domain.ErrPVZHasOpenReception
+		}
+		if !errors.Is(err, infra.ErrNotFound) {
+			return nil, fmt.Errorf("%s: failed to check open reception: %w", op, err)
+		}
+	}
+
+	updated, err := s.pvzRepo.UpdateStatus(ctx, changeIn.PvzID, changeIn.Status, changeIn.Reason, effectiveAt)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to update pvz status: %w", op, err)
+	}
+
+	err = s.auditRecorder.Record(ctx, domain.AuditEvent{
+		ActorID:  changeIn.ActorID,
+		Action:   domain.AuditActionPVZStatusChanged,
+		EntityID: updated.ID,
+		PvzID:    updated.ID,
+		Before:   pvzEnt,
+		After:    updated,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+		Type:        domain.EventPVZStatusChanged,
+		AggregateID: updated.ID,
+		PvzID:       updated.ID,
+		Payload:     updated,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, nil
+}
+
+func (s *PVZUseCase) UpdateProfile(ctx context.Context, updateIn dto.PVZProfileUpdate) (*domain.PVZ, error) {
+	var (
+		res    *domain.PVZ
+		change *capacity.Change
+	)
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, change, err = s.updateProfile(ctx, updateIn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	change.Report()
+
+	return res, nil
+}
+
+func (s *PVZUseCase) updateProfile(ctx context.Context, updateIn dto.PVZProfileUpdate) (*domain.PVZ, *capacity.Change, error) {
+	const op = "pvz.UpdateProfile"
+
+	pvzEnt, err := s.pvzRepo.GetForUpdate(ctx, updateIn.PvzID)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, domain.ErrPVZNotFound
+		}
+		return nil, nil, fmt.Errorf("%s: failed to lock pvz: %w", op, err)
+	}
+
+	changed := *pvzEnt
+	if updateIn.Address != nil {
+		changed.Address = *updateIn.Address
+	}
+	if updateIn.ClearLocation {
+		changed.Location = nil
+	}
+	if updateIn.Location != nil {
+		changed.Location = updateIn.Location
+	}
+	if updateIn.WorkingHours != nil {
+		changed.WorkingHours = updateIn.WorkingHours
+	}
+	if updateIn.ClearCapacity {
+		changed.Capacity = nil
+	}
+	if updateIn.Capacity != nil {
+		changed.Capacity = updateIn.Capacity
+	}
+	if updateIn.TypeCapacities != nil {
+		changed.TypeCapacities = updateIn.TypeCapacities
+	}
+
+	if err := changed.ValidateProfile(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.checkProductTypes(ctx, updateIn.TypeCapacities); err != nil {
+		if errors.Is(err, domain.ErrProductTypeNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	updated, err := s.pvzRepo.UpdateProfile(ctx, changed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to update pvz profile: %w", op, err)
+	}
+
+	// загрузка пересчитывается только при смене лимитов, остаток от профиля не зависит
+	var change *capacity.Change
+	if updateIn.Capacity != nil || updateIn.ClearCapacity || updateIn.TypeCapacities != nil {
+		change, err = capacity.LoadChange(ctx, s.productRepo, pvzEnt, updated)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: failed to get pvz stock: %w", op, err)
+		}
+	}
+
+	err = s.auditRecorder.Record(ctx, domain.AuditEvent{
+		ActorID:  updateIn.ActorID,
+		Action:   domain.AuditActionPVZUpdated,
+		EntityID: updated.ID,
+		PvzID:    updated.ID,
+		Before:   pvzEnt,
+		After:    updated,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+		Type:        domain.EventPVZUpdated,
+		AggregateID: updated.ID,
+		PvzID:       updated.ID,
+		Payload:     updated,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, change, nil
+}
+
+// checkProductTypes проверяет, что лимиты вместимости заданы для существующих типов товара.
+func (s *PVZUseCase) checkProductTypes(ctx context.Context, typeCapacities map[string]int) error {
+	for typeName := range typeCapacities {
+		_, err := s.productTypeRepo.Get(ctx, domain.ProductType{Name: typeName})
+		if err != nil {
+			if errors.Is(err, infra.ErrNotFound) {
+				return fmt.Errorf("%w: %s", domain.ErrProductTypeNotFound, typeName)
+			}
+			return fmt.Errorf("failed to get product type '%s': %w", typeName, err)
+		}
+	}
+	return nil
+}
+
+// ListNearby возвращает активные PVZ с координатами в радиусе от точки, ближайшие первыми.
+func (s *PVZUseCase) ListNearby(ctx context.Context, params dto.PVZNearbyParams) ([]*domain.PVZ, error) {
+	const op = "pvz.ListNearby"
+
+	if !params.Point.IsValid() {
+		return nil, domain.ErrInvalidPVZLocation
+	}
+
+	radiusKm := params.RadiusKm
+	if radiusKm == 0 {
+		radiusKm = domain.DefaultNearbyRadiusKm
+	}
+	// отрицательная форма условия отсекает и NaN
+	if !(radiusKm > 0 && radiusKm <= domain.MaxNearbyRadiusKm) {
+		return nil, domain.ErrInvalidNearbyRadius
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = listparams.DefaultLimit
+	}
+
+	pvzEnts, err := s.pvzRepo.ListNearby(ctx, params.Point, radiusKm, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list nearby pvz: %w", op, err)
+	}
+
+	return pvzEnts, nil
+}
+
 func (s *PVZUseCase) ListOverview(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, error) {
 	const op = "pvz.ListOverview"
 
@@ -93,27 +423,41 @@ func (s *PVZUseCase) ListOverview(ctx context.Context, pvzListParams *dto.PVZLis
 	return pvzEnts, nil
 }
 
-func (s *PVZUseCase) List(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, error) {
+// List возвращает страницу PVZ с приёмками и товарами. Курсор следующей страницы
+// возвращается, пока страница заполнена целиком и используется сортировка по умолчанию.
+func (s *PVZUseCase) List(ctx context.Context, pvzListParams *dto.PVZListParams) ([]*domain.PVZ, *listparams.Cursor, error) {
 	const op = "pvz.List"
 
 	var pagination *listparams.Pagination
-	var startDate, endDate *time.Time
+	var after *listparams.Cursor
+	var sorts []listparams.Sort
+	var filter domain.PVZListFilter
 
 	if pvzListParams != nil {
 		pagination = pvzListParams.Pagination
-		if pvzListParams.Filter != nil {
-			startDate = pvzListParams.Filter.StartDate
-			endDate = pvzListParams.Filter.EndDate
-		}
+		after = pvzListParams.Cursor
+		sorts = pvzListParams.Sort
+		filter = toListFilter(pvzListParams.Filter)
+	}
+
+	keyset := isDefaultSort(sorts)
+	if after != nil && !keyset {
+		return nil, nil, fmt.Errorf("%s: %w", op, domain.ErrPVZCursorSort)
 	}
 
-	pvzEnts, err := s.pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, pagination, startDate, endDate)
+	pvzEnts, err := s.pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, filter, sorts, pagination, after)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to get list pvz: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: failed to get list pvz: %w", op, err)
 	}
 
 	if len(pvzEnts) == 0 {
-		return []*domain.PVZ{}, nil
+		return []*domain.PVZ{}, nil, nil
+	}
+
+	var next *listparams.Cursor
+	if keyset && pagination != nil && len(pvzEnts) == int(pagination.Limit) {
+		last := pvzEnts[len(pvzEnts)-1]
+		next = &listparams.Cursor{Time: last.RegistrationDate, ID: last.ID}
 	}
 
 	pvzIDs := make([]uuid.UUID, 0, len(pvzEnts))
@@ -123,7 +467,7 @@ func (s *PVZUseCase) List(ctx context.Context, pvzListParams *dto.PVZListParams)
 
 	receptionEnts, err := s.receptionRepo.ListByIDsWithStatus(ctx, pvzIDs)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to get list receptions: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: failed to get list receptions: %w", op, err)
 	}
 
 	receptionIDs := make([]uuid.UUID, 0, len(receptionEnts))
@@ -135,7 +479,12 @@ func (s *PVZUseCase) List(ctx context.Context, pvzListParams *dto.PVZListParams)
 
 	productEnts, err := s.productRepo.ListByReceptionIDsWithTypeName(ctx, receptionIDs)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to get list products: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: failed to get list products: %w", op, err)
+	}
+
+	stock, err := s.productRepo.CountOnHandByPVZ(ctx, pvzIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to count stock on hand: %w", op, err)
 	}
 
 	mapReceptionIDProducts := make(map[uuid.UUID][]*domain.Product, len(productEnts))
@@ -146,40 +495,173 @@ func (s *PVZUseCase) List(ctx context.Context, pvzListParams *dto.PVZListParams)
 	outs := make([]*domain.PVZ, 0, len(pvzEnts))
 
 	for _, pvz := range pvzEnts {
+		// копия сущности из репозитория сохраняет статус и профиль PVZ
+		out := *pvz
+		out.StockOnHand = stock[pvz.ID]
+
 		pvzReceptions, ok := mapPvzIDReceptions[pvz.ID]
 		if !ok {
-			outs = append(outs, &domain.PVZ{
-				ID:               pvz.ID,
-				RegistrationDate: pvz.RegistrationDate,
-				CityID:           pvz.CityID,
-				Receptions:       nil,
-			})
+			outs = append(outs, &out)
 			continue
 		}
 
 		receptionsWithProducts := make([]*domain.Reception, 0, len(pvzReceptions))
 
 		for _, reception := range pvzReceptions {
-			productsWithTypeName := mapReceptionIDProducts[reception.ID]
+			receptionOut := *reception
+			receptionOut.Products = mapReceptionIDProducts[reception.ID]
+
+			receptionsWithProducts = append(receptionsWithProducts, &receptionOut)
+		}
+
+		out.Receptions = receptionsWithProducts
+		outs = append(outs, &out)
+	}
+
+	return outs, next, nil
+}
+
+// Get возвращает PVZ с городом, остатком на складе и страницей его приёмок.
+// Товары приёмок загружаются только при IncludeProducts.
+func (s *PVZUseCase) Get(ctx context.Context, params dto.PVZDetailParams) (*domain.PVZ, error) {
+	const op = "pvz.Get"
+
+	pvzEnt, err := s.pvzRepo.Get(ctx, domain.PVZ{ID: params.PvzID})
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, domain.ErrPVZNotFound
+		}
+		return nil, fmt.Errorf("%s: failed to get pvz: %w", op, err)
+	}
+
+	city, err := s.cityRepo.Get(ctx, domain.City{ID: pvzEnt.CityID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to get city: %w", op, err)
+	}
+	pvzEnt.City = city
+
+	var startDate, endDate *time.Time
+	if params.Filter != nil {
+		startDate = params.Filter.StartDate
+		endDate = params.Filter.EndDate
+	}
+
+	receptionEnts, err := s.receptionRepo.ListByPVZWithStatus(ctx, pvzEnt.ID, params.Pagination, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to get list receptions: %w", op, err)
+	}
+	pvzEnt.Receptions = receptionEnts
+
+	if params.IncludeProducts && len(receptionEnts) > 0 {
+		receptionIDs := make([]uuid.UUID, 0, len(receptionEnts))
+		for _, receptionEnt := range receptionEnts {
+			receptionIDs = append(receptionIDs, receptionEnt.ID)
+		}
+
+		productEnts, err := s.productRepo.ListByReceptionIDsWithTypeName(ctx, receptionIDs)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to get list products: %w", op, err)
+		}
 
-			receptionsWithProducts = append(receptionsWithProducts, &domain.Reception{
-				ID:              reception.ID,
-				PvzID:           reception.PvzID,
-				DateTime:        reception.DateTime,
-				StatusID:        reception.StatusID,
-				Products:        productsWithTypeName,
-				ReceptionStatus: reception.ReceptionStatus,
-			})
+		mapReceptionIDProducts := make(map[uuid.UUID][]*domain.Product, len(receptionEnts))
+		for _, productEnt := range productEnts {
+			mapReceptionIDProducts[productEnt.ReceptionID] = append(mapReceptionIDProducts[productEnt.ReceptionID], productEnt)
+		}
+
+		for _, receptionEnt := range receptionEnts {
+			receptionEnt.Products = mapReceptionIDProducts[receptionEnt.ID]
+		}
+	}
+
+	stock, err := s.productRepo.CountOnHandByPVZ(ctx, []uuid.UUID{pvzEnt.ID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to count stock on hand: %w", op, err)
+	}
+	pvzEnt.StockOnHand = stock[pvzEnt.ID]
+
+	return pvzEnt, nil
+}
+
+// Inventory возвращает остаток товаров на складе PVZ по типам.
+// Без AsOf считается текущий остаток.
+func (s *PVZUseCase) Inventory(ctx context.Context, params dto.PVZInventoryParams) (*domain.PVZInventory, error) {
+	const op = "pvz.Inventory"
+
+	_, err := s.pvzRepo.Get(ctx, domain.PVZ{ID: params.PvzID})
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, domain.ErrPVZNotFound
 		}
+		return nil, fmt.Errorf("%s: failed to get pvz: %w", op, err)
+	}
+
+	asOf := time.Now()
+	if params.AsOf != nil {
+		asOf = *params.AsOf
+	}
+
+	counts, err := s.productRepo.InventoryByType(ctx, params.PvzID, params.AsOf)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to count inventory: %w", op, err)
+	}
+
+	inventory := &domain.PVZInventory{
+		PvzID: params.PvzID,
+		AsOf:  asOf,
+		Items: make([]domain.InventoryItem, 0, len(counts)),
+	}
+	for typeName, count := range counts {
+		inventory.Items = append(inventory.Items, domain.InventoryItem{TypeName: typeName, Count: count})
+		inventory.Total += count
+	}
+
+	sort.Slice(inventory.Items, func(i, j int) bool {
+		return inventory.Items[i].TypeName < inventory.Items[j].TypeName
+	})
+
+	return inventory, nil
+}
 
-		outs = append(outs, &domain.PVZ{
-			ID:               pvz.ID,
-			RegistrationDate: pvz.RegistrationDate,
-			CityID:           pvz.CityID,
-			Receptions:       receptionsWithProducts,
-			City:             pvz.City,
-		})
+// Count возвращает число PVZ под фильтром списка без учёта пагинации и курсора.
+func (s *PVZUseCase) Count(ctx context.Context, pvzListParams *dto.PVZListParams) (int, error) {
+	const op = "pvz.Count"
+
+	var filter domain.PVZListFilter
+	if pvzListParams != nil {
+		filter = toListFilter(pvzListParams.Filter)
 	}
 
-	return outs, nil
+	total, err := s.pvzRepo.CountPvzByAcceptanceDateAndCity(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to count pvz: %w", op, err)
+	}
+
+	return total, nil
+}
+
+func toListFilter(filter *dto.PVZFilter) domain.PVZListFilter {
+	if filter == nil {
+		return domain.PVZListFilter{}
+	}
+
+	return domain.PVZListFilter{
+		Cities:            filter.Cities,
+		StartDate:         filter.StartDate,
+		EndDate:           filter.EndDate,
+		ReceptionStatuses: filter.ReceptionStatuses,
+		ProductTypes:      filter.ProductTypes,
+	}
+}
+
+// isDefaultSort сообщает, совпадает ли сортировка с порядком по умолчанию (registrationDate desc),
+// на котором построена keyset пагинация.
+func isDefaultSort(sorts []listparams.Sort) bool {
+	switch len(sorts) {
+	case 0:
+		return true
+	case 1:
+		return sorts[0].Field == domain.PVZSortRegistrationDate && sorts[0].Order == listparams.SortDesc
+	default:
+		return false
+	}
 }
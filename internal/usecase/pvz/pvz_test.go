@@ -3,6 +3,7 @@ package pvz
 import (
 	"context"
 	"errors"
+	"math"
 	"testing"
 	"time"
 
@@ -18,20 +19,36 @@ import (
 )
 
 type pvzMocks struct {
-	MockPvzRepo       *mocks.MockpvzRepo
-	MockCityRepo      *mocks.MockcityRepo
-	MockReceptionRepo *mocks.MockreceptionRepo
-	MockProductRepo   *mocks.MockproductRepo
+	MockPvzRepo         *mocks.MockpvzRepo
+	MockCityRepo        *mocks.MockcityRepo
+	MockReceptionRepo   *mocks.MockreceptionRepo
+	MockProductRepo     *mocks.MockproductRepo
+	MockProductTypeRepo *mocks.MockproductTypeRepo
+	MockTxManager       *mocks.MocktxManager
+	MockAuditRecorder   *mocks.MockauditRecorder
+	MockEventEmitter    *mocks.MockeventEmitter
 }
 
 func newPvZMocks(t *testing.T) *pvzMocks {
 	ctrl := gomock.NewController(t)
 
+	txManager := mocks.NewMocktxManager(ctrl)
+	txManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
 	return &pvzMocks{
-		MockPvzRepo:       mocks.NewMockpvzRepo(ctrl),
-		MockCityRepo:      mocks.NewMockcityRepo(ctrl),
-		MockReceptionRepo: mocks.NewMockreceptionRepo(ctrl),
-		MockProductRepo:   mocks.NewMockproductRepo(ctrl),
+		MockPvzRepo:         mocks.NewMockpvzRepo(ctrl),
+		MockCityRepo:        mocks.NewMockcityRepo(ctrl),
+		MockReceptionRepo:   mocks.NewMockreceptionRepo(ctrl),
+		MockProductRepo:     mocks.NewMockproductRepo(ctrl),
+		MockProductTypeRepo: mocks.NewMockproductTypeRepo(ctrl),
+		MockTxManager:       txManager,
+		MockAuditRecorder:   mocks.NewMockauditRecorder(ctrl),
+		MockEventEmitter:    mocks.NewMockeventEmitter(ctrl),
 	}
 }
 
@@ -79,9 +96,58 @@ func TestPVZUseCase_Create(t *testing.T) {
 						CityID:           city.ID,
 					}, nil).
 					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionPVZCreated, e.Action)
+						require.Equal(t, f.req.CreatedBy, e.ActorID)
+						require.Equal(t, f.req.ID, e.EntityID)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventPVZCreated, e.Type)
+						require.Equal(t, f.req.ID, e.AggregateID)
+						return nil
+					}).
+					Times(1)
 			},
 			wantErr: nil,
 		},
+		{
+			name: "failed to record audit",
+			req: dto.PVZCreate{
+				ID:               uuid.New(),
+				RegistrationDate: time.Now(),
+				CityName:         "Moscow",
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				city := &domain.City{
+					ID:   uuid.New(),
+					Name: f.req.CityName,
+				}
+
+				m.MockCityRepo.EXPECT().
+					Get(ctx, domain.City{Name: f.req.CityName}).
+					Return(city, nil).
+					Times(1)
+
+				m.MockPvzRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(&domain.PVZ{ID: f.req.ID, CityID: city.ID}, nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					Return(errors.New("audit error")).
+					Times(1)
+			},
+			wantErr: errors.New("pvz.Create: audit error"),
+		},
 		{
 			name: "failed to get city",
 			req: dto.PVZCreate{
@@ -163,6 +229,19 @@ func TestPVZUseCase_Create(t *testing.T) {
 			},
 			wantErr: domain.ErrCityNotFound,
 		},
+		{
+			name: "invalid working hours",
+			req: dto.PVZCreate{
+				ID:               uuid.New(),
+				RegistrationDate: time.Now(),
+				CityName:         "Moscow",
+				WorkingHours: domain.WorkingHours{
+					{Day: domain.Monday, Open: "21:00", Close: "09:00"},
+				},
+			},
+			mockFn:  func(f fields, m *pvzMocks) {},
+			wantErr: domain.ErrInvalidWorkingHours,
+		},
 	}
 
 	for _, tt := range testcases {
@@ -177,6 +256,10 @@ func TestPVZUseCase_Create(t *testing.T) {
 				pvzMocks.MockCityRepo,
 				pvzMocks.MockReceptionRepo,
 				pvzMocks.MockProductRepo,
+				pvzMocks.MockProductTypeRepo,
+				pvzMocks.MockTxManager,
+				pvzMocks.MockAuditRecorder,
+				pvzMocks.MockEventEmitter,
 			)
 
 			pvzRes, err := useCase.Create(ctx, tt.req)
@@ -217,6 +300,11 @@ func TestPVZUseCase_List(t *testing.T) {
 		},
 	}
 
+	statusEffectiveAt := time.Now().Add(-time.Hour)
+	capacity := 100
+	createdBy := uuid.New()
+	closedBy := uuid.New()
+
 	type fields struct {
 		name    string
 		mockFn  func(m *pvzMocks)
@@ -229,7 +317,7 @@ func TestPVZUseCase_List(t *testing.T) {
 			name: "repo error on pvz list",
 			mockFn: func(m *pvzMocks) {
 				m.MockPvzRepo.EXPECT().
-					ListPvzByAcceptanceDateAndCity(ctx, params.Pagination, &startDate, &endDate).
+					ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &startDate, EndDate: &endDate}, nil, params.Pagination, nil).
 					Return(nil, errors.New("db error")).
 					Times(1)
 			},
@@ -239,7 +327,7 @@ func TestPVZUseCase_List(t *testing.T) {
 			name: "empty pvz list",
 			mockFn: func(m *pvzMocks) {
 				m.MockPvzRepo.EXPECT().
-					ListPvzByAcceptanceDateAndCity(ctx, params.Pagination, &startDate, &endDate).
+					ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &startDate, EndDate: &endDate}, nil, params.Pagination, nil).
 					Return([]*domain.PVZ{}, nil).
 					Times(1)
 			},
@@ -254,7 +342,7 @@ func TestPVZUseCase_List(t *testing.T) {
 				pvzID := uuid.New()
 
 				m.MockPvzRepo.EXPECT().
-					ListPvzByAcceptanceDateAndCity(ctx, params.Pagination, &startDate, &endDate).
+					ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &startDate, EndDate: &endDate}, nil, params.Pagination, nil).
 					Return([]*domain.PVZ{
 						{ID: pvzID},
 					}, nil).
@@ -274,7 +362,7 @@ func TestPVZUseCase_List(t *testing.T) {
 				receptionID := uuid.New()
 
 				m.MockPvzRepo.EXPECT().
-					ListPvzByAcceptanceDateAndCity(ctx, params.Pagination, &startDate, &endDate).
+					ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &startDate, EndDate: &endDate}, nil, params.Pagination, nil).
 					Return([]*domain.PVZ{
 						{ID: pvzID},
 					}, nil).
@@ -294,6 +382,33 @@ func TestPVZUseCase_List(t *testing.T) {
 			},
 			wantErr: errors.New("pvz.List: failed to get list products: product error"),
 		},
+		{
+			name: "stock count error",
+			mockFn: func(m *pvzMocks) {
+				pvzID := uuid.New()
+
+				m.MockPvzRepo.EXPECT().
+					ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &startDate, EndDate: &endDate}, nil, params.Pagination, nil).
+					Return([]*domain.PVZ{{ID: pvzID}}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					ListByIDsWithStatus(ctx, []uuid.UUID{pvzID}).
+					Return([]*domain.Reception{}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					ListByReceptionIDsWithTypeName(ctx, []uuid.UUID{}).
+					Return([]*domain.Product{}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CountOnHandByPVZ(ctx, []uuid.UUID{pvzID}).
+					Return(nil, errors.New("stock error")).
+					Times(1)
+			},
+			wantErr: errors.New("pvz.List: failed to count stock on hand: stock error"),
+		},
 		{
 			name: "full success with mapping",
 			mockFn: func(m *pvzMocks) {
@@ -302,16 +417,26 @@ func TestPVZUseCase_List(t *testing.T) {
 				productID := uuid.New()
 
 				pvzEnt := &domain.PVZ{
-					ID:               pvzID,
-					RegistrationDate: time.Now(),
-					CityID:           uuid.New(),
+					ID:                pvzID,
+					RegistrationDate:  time.Now(),
+					CityID:            uuid.New(),
+					Status:            domain.PVZStatusSuspended,
+					StatusReason:      "ремонт",
+					StatusEffectiveAt: &statusEffectiveAt,
+					Address:           "ул. Ленина, 1",
+					Location:          &domain.GeoPoint{Lat: 55.75, Lon: 37.61},
+					Capacity:          &capacity,
+					TypeCapacities:    map[string]int{"электроника": 10},
+					City:              &domain.City{Name: "Москва"},
 				}
 
 				receptionEnt := &domain.Reception{
-					ID:       receptionID,
-					PvzID:    pvzID,
-					DateTime: time.Now(),
-					StatusID: uuid.New(),
+					ID:        receptionID,
+					PvzID:     pvzID,
+					DateTime:  time.Now(),
+					StatusID:  uuid.New(),
+					CreatedBy: createdBy,
+					ClosedBy:  closedBy,
 				}
 
 				productEnt := &domain.Product{
@@ -320,7 +445,7 @@ func TestPVZUseCase_List(t *testing.T) {
 				}
 
 				m.MockPvzRepo.EXPECT().
-					ListPvzByAcceptanceDateAndCity(ctx, params.Pagination, &startDate, &endDate).
+					ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &startDate, EndDate: &endDate}, nil, params.Pagination, nil).
 					Return([]*domain.PVZ{pvzEnt}, nil).
 					Times(1)
 
@@ -333,19 +458,68 @@ func TestPVZUseCase_List(t *testing.T) {
 					ListByReceptionIDsWithTypeName(ctx, []uuid.UUID{receptionID}).
 					Return([]*domain.Product{productEnt}, nil).
 					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CountOnHandByPVZ(ctx, []uuid.UUID{pvzID}).
+					Return(map[uuid.UUID]int{pvzID: 3}, nil).
+					Times(1)
 			},
 			checkFn: func(t *testing.T, result []*domain.PVZ) {
 				require.Len(t, result, 1)
 
 				pvz := result[0]
 				require.Len(t, pvz.Receptions, 1)
+				require.Equal(t, 3, pvz.StockOnHand)
+				require.Equal(t, domain.PVZStatusSuspended, pvz.Status)
+				require.Equal(t, "ремонт", pvz.StatusReason)
+				require.Equal(t, &statusEffectiveAt, pvz.StatusEffectiveAt)
+				require.Equal(t, "ул. Ленина, 1", pvz.Address)
+				require.Equal(t, &domain.GeoPoint{Lat: 55.75, Lon: 37.61}, pvz.Location)
+				require.Equal(t, &capacity, pvz.Capacity)
+				require.Equal(t, map[string]int{"электроника": 10}, pvz.TypeCapacities)
+				require.Equal(t, "Москва", pvz.City.Name)
 
 				reception := pvz.Receptions[0]
 				require.Len(t, reception.Products, 1)
+				require.Equal(t, createdBy, reception.CreatedBy)
+				require.Equal(t, closedBy, reception.ClosedBy)
 
 				require.Equal(t, reception.ID, reception.Products[0].ReceptionID)
 			},
 		},
+		{
+			name: "pvz without receptions keeps status",
+			mockFn: func(m *pvzMocks) {
+				pvzID := uuid.New()
+
+				m.MockPvzRepo.EXPECT().
+					ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &startDate, EndDate: &endDate}, nil, params.Pagination, nil).
+					Return([]*domain.PVZ{{ID: pvzID, Status: domain.PVZStatusClosed, StatusReason: "переезд", City: &domain.City{Name: "Казань"}}}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					ListByIDsWithStatus(ctx, []uuid.UUID{pvzID}).
+					Return([]*domain.Reception{}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					ListByReceptionIDsWithTypeName(ctx, []uuid.UUID{}).
+					Return([]*domain.Product{}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CountOnHandByPVZ(ctx, []uuid.UUID{pvzID}).
+					Return(map[uuid.UUID]int{}, nil).
+					Times(1)
+			},
+			checkFn: func(t *testing.T, result []*domain.PVZ) {
+				require.Len(t, result, 1)
+				require.Empty(t, result[0].Receptions)
+				require.Equal(t, domain.PVZStatusClosed, result[0].Status)
+				require.Equal(t, "переезд", result[0].StatusReason)
+				require.Equal(t, "Казань", result[0].City.Name)
+			},
+		},
 	}
 
 	for _, tt := range testcases {
@@ -360,9 +534,13 @@ func TestPVZUseCase_List(t *testing.T) {
 				pvzMocks.MockCityRepo,
 				pvzMocks.MockReceptionRepo,
 				pvzMocks.MockProductRepo,
+				pvzMocks.MockProductTypeRepo,
+				pvzMocks.MockTxManager,
+				pvzMocks.MockAuditRecorder,
+				pvzMocks.MockEventEmitter,
 			)
 
-			result, err := useCase.List(ctx, params)
+			result, next, err := useCase.List(ctx, params)
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -372,6 +550,8 @@ func TestPVZUseCase_List(t *testing.T) {
 			}
 
 			require.NoError(t, err)
+			// страница из одного PVZ при limit 10 последняя
+			require.Nil(t, next)
 
 			if tt.checkFn != nil {
 				tt.checkFn(t, result)
@@ -379,3 +559,1058 @@ func TestPVZUseCase_List(t *testing.T) {
 		})
 	}
 }
+
+func TestPVZUseCase_List_Cursor(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	after := &listparams.Cursor{Time: time.Now(), ID: uuid.New()}
+	params := &dto.PVZListParams{
+		Pagination: &listparams.Pagination{Limit: 2},
+		Cursor:     after,
+	}
+
+	first := &domain.PVZ{ID: uuid.New(), RegistrationDate: after.Time.Add(-time.Hour)}
+	second := &domain.PVZ{ID: uuid.New(), RegistrationDate: after.Time.Add(-2 * time.Hour)}
+
+	m := newPvZMocks(t)
+	m.MockPvzRepo.EXPECT().
+		ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{}, nil, params.Pagination, after).
+		Return([]*domain.PVZ{first, second}, nil).
+		Times(1)
+	m.MockReceptionRepo.EXPECT().
+		ListByIDsWithStatus(ctx, []uuid.UUID{first.ID, second.ID}).
+		Return(nil, nil).
+		Times(1)
+	m.MockProductRepo.EXPECT().
+		ListByReceptionIDsWithTypeName(ctx, []uuid.UUID{}).
+		Return(nil, nil).
+		Times(1)
+	m.MockProductRepo.EXPECT().
+		CountOnHandByPVZ(ctx, []uuid.UUID{first.ID, second.ID}).
+		Return(map[uuid.UUID]int{}, nil).
+		Times(1)
+
+	useCase := New(m.MockPvzRepo, m.MockCityRepo, m.MockReceptionRepo, m.MockProductRepo, m.MockProductTypeRepo, m.MockTxManager, m.MockAuditRecorder, m.MockEventEmitter)
+
+	result, next, err := useCase.List(ctx, params)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	// страница заполнена целиком, курсор указывает на последний PVZ
+	require.NotNil(t, next)
+	require.Equal(t, second.ID, next.ID)
+	require.True(t, second.RegistrationDate.Equal(next.Time))
+}
+
+func TestPVZUseCase_List_FilterAndSort(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	sorts := []listparams.Sort{{Field: domain.PVZSortCity, Order: listparams.SortAsc}}
+	params := &dto.PVZListParams{
+		Pagination: &listparams.Pagination{Limit: 1, Page: 1},
+		Sort:       sorts,
+		Filter: &dto.PVZFilter{
+			Cities:            []string{"Москва", "Казань"},
+			ReceptionStatuses: []domain.ReceptionStatusCode{domain.ReceptionStatusClose},
+			ProductTypes:      []string{"обувь"},
+		},
+	}
+
+	t.Run("filter and sort passed to repo", func(t *testing.T) {
+		t.Parallel()
+
+		pvzEnt := &domain.PVZ{ID: uuid.New(), RegistrationDate: time.Now()}
+
+		m := newPvZMocks(t)
+		m.MockPvzRepo.EXPECT().
+			ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{
+				Cities:            []string{"Москва", "Казань"},
+				ReceptionStatuses: []domain.ReceptionStatusCode{domain.ReceptionStatusClose},
+				ProductTypes:      []string{"обувь"},
+			}, sorts, params.Pagination, nil).
+			Return([]*domain.PVZ{pvzEnt}, nil).
+			Times(1)
+		m.MockReceptionRepo.EXPECT().
+			ListByIDsWithStatus(ctx, []uuid.UUID{pvzEnt.ID}).
+			Return(nil, nil).
+			Times(1)
+		m.MockProductRepo.EXPECT().
+			ListByReceptionIDsWithTypeName(ctx, []uuid.UUID{}).
+			Return(nil, nil).
+			Times(1)
+		m.MockProductRepo.EXPECT().
+			CountOnHandByPVZ(ctx, []uuid.UUID{pvzEnt.ID}).
+			Return(map[uuid.UUID]int{}, nil).
+			Times(1)
+
+		useCase := New(m.MockPvzRepo, m.MockCityRepo, m.MockReceptionRepo, m.MockProductRepo, m.MockProductTypeRepo, m.MockTxManager, m.MockAuditRecorder, m.MockEventEmitter)
+
+		result, next, err := useCase.List(ctx, params)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		// страница заполнена, но курсор строится только для сортировки по умолчанию
+		require.Nil(t, next)
+	})
+
+	t.Run("cursor with custom sort", func(t *testing.T) {
+		t.Parallel()
+
+		m := newPvZMocks(t)
+		useCase := New(m.MockPvzRepo, m.MockCityRepo, m.MockReceptionRepo, m.MockProductRepo, m.MockProductTypeRepo, m.MockTxManager, m.MockAuditRecorder, m.MockEventEmitter)
+
+		withCursor := *params
+		withCursor.Cursor = &listparams.Cursor{Time: time.Now(), ID: uuid.New()}
+
+		_, _, err := useCase.List(ctx, &withCursor)
+		require.ErrorIs(t, err, domain.ErrPVZCursorSort)
+	})
+}
+
+func TestPVZUseCase_Count(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	params := &dto.PVZListParams{
+		Pagination: &listparams.Pagination{Limit: 10, Page: 3},
+		Filter:     &dto.PVZFilter{Cities: []string{"Москва"}},
+	}
+
+	t.Run("count by list filter", func(t *testing.T) {
+		t.Parallel()
+
+		m := newPvZMocks(t)
+		m.MockPvzRepo.EXPECT().
+			CountPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{Cities: []string{"Москва"}}).
+			Return(42, nil).
+			Times(1)
+
+		useCase := New(m.MockPvzRepo, m.MockCityRepo, m.MockReceptionRepo, m.MockProductRepo, m.MockProductTypeRepo, m.MockTxManager, m.MockAuditRecorder, m.MockEventEmitter)
+
+		total, err := useCase.Count(ctx, params)
+		require.NoError(t, err)
+		require.Equal(t, 42, total)
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		t.Parallel()
+
+		m := newPvZMocks(t)
+		m.MockPvzRepo.EXPECT().
+			CountPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{Cities: []string{"Москва"}}).
+			Return(0, errors.New("db error")).
+			Times(1)
+
+		useCase := New(m.MockPvzRepo, m.MockCityRepo, m.MockReceptionRepo, m.MockProductRepo, m.MockProductTypeRepo, m.MockTxManager, m.MockAuditRecorder, m.MockEventEmitter)
+
+		_, err := useCase.Count(ctx, params)
+		require.ErrorContains(t, err, "failed to count pvz")
+	})
+}
+
+func TestPVZUseCase_Inventory(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	asOf := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	type fields struct {
+		name    string
+		req     dto.PVZInventoryParams
+		mockFn  func(f fields, m *pvzMocks)
+		want    *domain.PVZInventory
+		wantErr error
+	}
+
+	pvzID := uuid.New()
+
+	testcases := []fields{
+		{
+			name: "ok",
+			req:  dto.PVZInventoryParams{PvzID: pvzID, AsOf: &asOf},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					Get(ctx, domain.PVZ{ID: f.req.PvzID}).
+					Return(&domain.PVZ{ID: f.req.PvzID}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.req.PvzID, f.req.AsOf).
+					Return(map[string]int{"обувь": 2, "электроника": 3, "одежда": 1}, nil).
+					Times(1)
+			},
+			want: &domain.PVZInventory{
+				PvzID: pvzID,
+				AsOf:  asOf,
+				Total: 6,
+				Items: []domain.InventoryItem{
+					{TypeName: "обувь", Count: 2},
+					{TypeName: "одежда", Count: 1},
+					{TypeName: "электроника", Count: 3},
+				},
+			},
+		},
+		{
+			name: "pvz not found",
+			req:  dto.PVZInventoryParams{PvzID: pvzID},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					Get(ctx, domain.PVZ{ID: f.req.PvzID}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZNotFound,
+		},
+		{
+			name: "inventory error",
+			req:  dto.PVZInventoryParams{PvzID: pvzID},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					Get(ctx, domain.PVZ{ID: f.req.PvzID}).
+					Return(&domain.PVZ{ID: f.req.PvzID}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.req.PvzID, nil).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("pvz.Inventory: failed to count inventory: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pvzMocks := newPvZMocks(t)
+			tt.mockFn(tt, pvzMocks)
+
+			useCase := New(
+				pvzMocks.MockPvzRepo,
+				pvzMocks.MockCityRepo,
+				pvzMocks.MockReceptionRepo,
+				pvzMocks.MockProductRepo,
+				pvzMocks.MockProductTypeRepo,
+				pvzMocks.MockTxManager,
+				pvzMocks.MockAuditRecorder,
+				pvzMocks.MockEventEmitter,
+			)
+
+			inventory, err := useCase.Inventory(ctx, tt.req)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, inventory)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, inventory)
+		})
+	}
+}
+
+func TestPVZUseCase_Get(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	type fields struct {
+		name    string
+		req     dto.PVZDetailParams
+		mockFn  func(f fields, m *pvzMocks)
+		check   func(t *testing.T, pvz *domain.PVZ)
+		wantErr error
+	}
+
+	pvzID := uuid.New()
+	cityID := uuid.New()
+	receptionID := uuid.New()
+	startDate := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	pagination := &listparams.Pagination{Page: 2, Limit: 5}
+
+	expectPVZ := func(m *pvzMocks) {
+		m.MockPvzRepo.EXPECT().
+			Get(ctx, domain.PVZ{ID: pvzID}).
+			Return(&domain.PVZ{ID: pvzID, CityID: cityID}, nil).
+			Times(1)
+
+		m.MockCityRepo.EXPECT().
+			Get(ctx, domain.City{ID: cityID}).
+			Return(&domain.City{ID: cityID, Name: "Москва"}, nil).
+			Times(1)
+	}
+
+	testcases := []fields{
+		{
+			name: "ok with products",
+			req: dto.PVZDetailParams{
+				PvzID:           pvzID,
+				Filter:          &dto.PVZFilter{StartDate: &startDate},
+				Pagination:      pagination,
+				IncludeProducts: true,
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				expectPVZ(m)
+
+				m.MockReceptionRepo.EXPECT().
+					ListByPVZWithStatus(ctx, pvzID, pagination, &startDate, nil).
+					Return([]*domain.Reception{{ID: receptionID, PvzID: pvzID}}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					ListByReceptionIDsWithTypeName(ctx, []uuid.UUID{receptionID}).
+					Return([]*domain.Product{{ID: uuid.New(), ReceptionID: receptionID}}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CountOnHandByPVZ(ctx, []uuid.UUID{pvzID}).
+					Return(map[uuid.UUID]int{pvzID: 4}, nil).
+					Times(1)
+			},
+			check: func(t *testing.T, pvz *domain.PVZ) {
+				require.Equal(t, "Москва", pvz.City.Name)
+				require.Equal(t, 4, pvz.StockOnHand)
+				require.Len(t, pvz.Receptions, 1)
+				require.Len(t, pvz.Receptions[0].Products, 1)
+			},
+		},
+		{
+			name: "ok without products",
+			req:  dto.PVZDetailParams{PvzID: pvzID},
+			mockFn: func(f fields, m *pvzMocks) {
+				expectPVZ(m)
+
+				m.MockReceptionRepo.EXPECT().
+					ListByPVZWithStatus(ctx, pvzID, nil, nil, nil).
+					Return([]*domain.Reception{{ID: receptionID, PvzID: pvzID}}, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CountOnHandByPVZ(ctx, []uuid.UUID{pvzID}).
+					Return(map[uuid.UUID]int{}, nil).
+					Times(1)
+			},
+			check: func(t *testing.T, pvz *domain.PVZ) {
+				require.Len(t, pvz.Receptions, 1)
+				require.Nil(t, pvz.Receptions[0].Products)
+			},
+		},
+		{
+			name: "pvz not found",
+			req:  dto.PVZDetailParams{PvzID: pvzID},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					Get(ctx, domain.PVZ{ID: pvzID}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZNotFound,
+		},
+		{
+			name: "receptions error",
+			req:  dto.PVZDetailParams{PvzID: pvzID},
+			mockFn: func(f fields, m *pvzMocks) {
+				expectPVZ(m)
+
+				m.MockReceptionRepo.EXPECT().
+					ListByPVZWithStatus(ctx, pvzID, nil, nil, nil).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("pvz.Get: failed to get list receptions: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pvzMocks := newPvZMocks(t)
+			tt.mockFn(tt, pvzMocks)
+
+			useCase := New(
+				pvzMocks.MockPvzRepo,
+				pvzMocks.MockCityRepo,
+				pvzMocks.MockReceptionRepo,
+				pvzMocks.MockProductRepo,
+				pvzMocks.MockProductTypeRepo,
+				pvzMocks.MockTxManager,
+				pvzMocks.MockAuditRecorder,
+				pvzMocks.MockEventEmitter,
+			)
+
+			pvzRes, err := useCase.Get(ctx, tt.req)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, pvzRes)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, pvzID, pvzRes.ID)
+			tt.check(t, pvzRes)
+		})
+	}
+}
+
+func TestPVZUseCase_ChangeStatus(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	past := time.Now().Add(-24 * time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	type fields struct {
+		name    string
+		req     dto.PVZStatusChange
+		mockFn  func(f fields, m *pvzMocks)
+		wantErr error
+	}
+
+	testcases := []fields{
+		{
+			name: "suspend with current effective date",
+			req: dto.PVZStatusChange{
+				PvzID:       uuid.New(),
+				Status:      domain.PVZStatusSuspended,
+				Reason:      "ремонт",
+				EffectiveAt: &now,
+				ActorID:     uuid.New(),
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+
+				m.MockPvzRepo.EXPECT().
+					UpdateStatus(ctx, f.req.PvzID, domain.PVZStatusSuspended, "ремонт", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, pvzID uuid.UUID, status domain.PVZStatus, reason string, effectiveAt time.Time) (*domain.PVZ, error) {
+						require.WithinDuration(t, now, effectiveAt, time.Second)
+						return &domain.PVZ{ID: pvzID, Status: status, StatusReason: reason, StatusEffectiveAt: &effectiveAt}, nil
+					}).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionPVZStatusChanged, e.Action)
+						require.Equal(t, f.req.ActorID, e.ActorID)
+						require.Equal(t, domain.PVZStatusActive, e.Before.(*domain.PVZ).Status)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventPVZStatusChanged, e.Type)
+						require.Equal(t, f.req.PvzID, e.PvzID)
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "reactivate without effective date",
+			req: dto.PVZStatusChange{
+				PvzID:  uuid.New(),
+				Status: domain.PVZStatusActive,
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusSuspended}, nil).
+					Times(1)
+
+				// открытая приёмка не мешает вернуть PVZ в работу
+				m.MockPvzRepo.EXPECT().
+					UpdateStatus(ctx, f.req.PvzID, domain.PVZStatusActive, "", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, pvzID uuid.UUID, status domain.PVZStatus, reason string, effectiveAt time.Time) (*domain.PVZ, error) {
+						require.WithinDuration(t, time.Now(), effectiveAt, time.Second)
+						return &domain.PVZ{ID: pvzID, Status: status, StatusEffectiveAt: &effectiveAt}, nil
+					}).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().Record(ctx, gomock.Any()).Return(nil).Times(1)
+				m.MockEventEmitter.EXPECT().Emit(ctx, gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "effective date in future",
+			req: dto.PVZStatusChange{
+				PvzID:       uuid.New(),
+				Status:      domain.PVZStatusClosed,
+				EffectiveAt: &future,
+			},
+			mockFn:  func(f fields, m *pvzMocks) {},
+			wantErr: domain.ErrPVZStatusEffectiveDate,
+		},
+		{
+			name: "effective date in past",
+			req: dto.PVZStatusChange{
+				PvzID:       uuid.New(),
+				Status:      domain.PVZStatusSuspended,
+				Reason:      "ремонт",
+				EffectiveAt: &past,
+			},
+			mockFn:  func(f fields, m *pvzMocks) {},
+			wantErr: domain.ErrPVZStatusEffectiveDate,
+		},
+		{
+			name: "pvz not found",
+			req: dto.PVZStatusChange{
+				PvzID:  uuid.New(),
+				Status: domain.PVZStatusClosed,
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZNotFound,
+		},
+		{
+			name: "closed pvz can not be reopened",
+			req: dto.PVZStatusChange{
+				PvzID:  uuid.New(),
+				Status: domain.PVZStatusActive,
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusClosed}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrInvalidPVZStatusTransition,
+		},
+		{
+			name: "open reception blocks closing",
+			req: dto.PVZStatusChange{
+				PvzID:  uuid.New(),
+				Status: domain.PVZStatusClosed,
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
+					Return(&domain.Reception{ID: uuid.New()}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZHasOpenReception,
+		},
+		{
+			name: "update error",
+			req: dto.PVZStatusChange{
+				PvzID:  uuid.New(),
+				Status: domain.PVZStatusSuspended,
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{PvzID: f.req.PvzID}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+
+				m.MockPvzRepo.EXPECT().
+					UpdateStatus(ctx, f.req.PvzID, domain.PVZStatusSuspended, "", gomock.Any()).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("pvz.ChangeStatus: failed to update pvz status: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pvzMocks := newPvZMocks(t)
+			tt.mockFn(tt, pvzMocks)
+
+			useCase := New(
+				pvzMocks.MockPvzRepo,
+				pvzMocks.MockCityRepo,
+				pvzMocks.MockReceptionRepo,
+				pvzMocks.MockProductRepo,
+				pvzMocks.MockProductTypeRepo,
+				pvzMocks.MockTxManager,
+				pvzMocks.MockAuditRecorder,
+				pvzMocks.MockEventEmitter,
+			)
+
+			res, err := useCase.ChangeStatus(ctx, tt.req)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, res)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.req.Status, res.Status)
+		})
+	}
+}
+
+func TestPVZUseCase_UpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	address := "ул. Тверская, 1"
+	capacity := 500
+	zero := 0
+	location := &domain.GeoPoint{Lat: 55.7558, Lon: 37.6173}
+	hours := domain.WorkingHours{
+		{Day: domain.Monday, Open: "09:00", Close: "13:00"},
+		{Day: domain.Monday, Open: "14:00", Close: "21:00"},
+		{Day: domain.Sunday, Open: "10:00", Close: "24:00"},
+	}
+
+	type fields struct {
+		name    string
+		req     dto.PVZProfileUpdate
+		mockFn  func(f fields, m *pvzMocks)
+		wantErr error
+	}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			req: dto.PVZProfileUpdate{
+				PvzID:        uuid.New(),
+				Address:      &address,
+				Location:     location,
+				WorkingHours: hours,
+				ActorID:      uuid.New(),
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				current := &domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive, Capacity: &capacity}
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(current, nil).
+					Times(1)
+
+				// не переданная вместимость сохраняется
+				want := domain.PVZ{
+					ID:           f.req.PvzID,
+					Status:       domain.PVZStatusActive,
+					Address:      address,
+					Location:     location,
+					WorkingHours: hours,
+					Capacity:     &capacity,
+				}
+				m.MockPvzRepo.EXPECT().
+					UpdateProfile(ctx, want).
+					Return(&want, nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionPVZUpdated, e.Action)
+						require.Equal(t, f.req.ActorID, e.ActorID)
+						require.Equal(t, current, e.Before)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventPVZUpdated, e.Type)
+						require.Equal(t, f.req.PvzID, e.PvzID)
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "location and capacity cleared",
+			req: dto.PVZProfileUpdate{
+				PvzID:         uuid.New(),
+				Address:       &address,
+				ClearLocation: true,
+				ClearCapacity: true,
+				ActorID:       uuid.New(),
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{
+						ID:       f.req.PvzID,
+						Status:   domain.PVZStatusActive,
+						Address:  address,
+						Location: location,
+						Capacity: &capacity,
+					}, nil).
+					Times(1)
+
+				want := domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive, Address: address}
+				m.MockPvzRepo.EXPECT().
+					UpdateProfile(ctx, want).
+					Return(&want, nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "pvz not found",
+			req: dto.PVZProfileUpdate{
+				PvzID:   uuid.New(),
+				Address: &address,
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZNotFound,
+		},
+		{
+			name: "invalid location",
+			req: dto.PVZProfileUpdate{
+				PvzID:    uuid.New(),
+				Location: &domain.GeoPoint{Lat: 91, Lon: 37.6173},
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrInvalidPVZLocation,
+		},
+		{
+			name: "overlapping working hours",
+			req: dto.PVZProfileUpdate{
+				PvzID: uuid.New(),
+				WorkingHours: domain.WorkingHours{
+					{Day: domain.Friday, Open: "09:00", Close: "18:00"},
+					{Day: domain.Friday, Open: "17:00", Close: "20:00"},
+				},
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrInvalidWorkingHours,
+		},
+		{
+			name: "unknown weekday",
+			req: dto.PVZProfileUpdate{
+				PvzID:        uuid.New(),
+				WorkingHours: domain.WorkingHours{{Day: "holiday", Open: "09:00", Close: "18:00"}},
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrInvalidWorkingHours,
+		},
+		{
+			name: "zero capacity",
+			req: dto.PVZProfileUpdate{
+				PvzID:    uuid.New(),
+				Capacity: &zero,
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrInvalidPVZCapacity,
+		},
+		{
+			name: "type capacities replaced",
+			req: dto.PVZProfileUpdate{
+				PvzID:          uuid.New(),
+				Address:        &address,
+				TypeCapacities: map[string]int{"обувь": 100},
+				ActorID:        uuid.New(),
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, TypeCapacities: map[string]int{"одежда": 10}}, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: "обувь"}).
+					Return(&domain.ProductType{ID: uuid.New(), Name: "обувь"}, nil).
+					Times(1)
+
+				want := domain.PVZ{ID: f.req.PvzID, Address: address, TypeCapacities: map[string]int{"обувь": 100}}
+				m.MockPvzRepo.EXPECT().
+					UpdateProfile(ctx, want).
+					Return(&want, nil).
+					Times(1)
+
+				// новые лимиты сразу отражаются в метрике загрузки
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.req.PvzID, nil).
+					Return(map[string]int{"обувь": 20}, nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "stock error after capacity change",
+			req: dto.PVZProfileUpdate{
+				PvzID:    uuid.New(),
+				Capacity: &capacity,
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID}, nil).
+					Times(1)
+
+				want := domain.PVZ{ID: f.req.PvzID, Capacity: &capacity}
+				m.MockPvzRepo.EXPECT().
+					UpdateProfile(ctx, want).
+					Return(&want, nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					InventoryByType(ctx, f.req.PvzID, nil).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("pvz.UpdateProfile: failed to get pvz stock: db error"),
+		},
+		{
+			name: "type capacity for unknown product type",
+			req: dto.PVZProfileUpdate{
+				PvzID:          uuid.New(),
+				TypeCapacities: map[string]int{"unknown": 5},
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID}, nil).
+					Times(1)
+
+				m.MockProductTypeRepo.EXPECT().
+					Get(ctx, domain.ProductType{Name: "unknown"}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrProductTypeNotFound,
+		},
+		{
+			name: "non positive type capacity",
+			req: dto.PVZProfileUpdate{
+				PvzID:          uuid.New(),
+				TypeCapacities: map[string]int{"обувь": 0},
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrInvalidPVZCapacity,
+		},
+		{
+			name: "update error",
+			req: dto.PVZProfileUpdate{
+				PvzID:   uuid.New(),
+				Address: &address,
+			},
+			mockFn: func(f fields, m *pvzMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID}, nil).
+					Times(1)
+
+				m.MockPvzRepo.EXPECT().
+					UpdateProfile(ctx, gomock.Any()).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("pvz.UpdateProfile: failed to update pvz profile: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pvzMocks := newPvZMocks(t)
+			tt.mockFn(tt, pvzMocks)
+
+			useCase := New(
+				pvzMocks.MockPvzRepo,
+				pvzMocks.MockCityRepo,
+				pvzMocks.MockReceptionRepo,
+				pvzMocks.MockProductRepo,
+				pvzMocks.MockProductTypeRepo,
+				pvzMocks.MockTxManager,
+				pvzMocks.MockAuditRecorder,
+				pvzMocks.MockEventEmitter,
+			)
+
+			res, err := useCase.UpdateProfile(ctx, tt.req)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, res)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, *tt.req.Address, res.Address)
+			require.Equal(t, tt.req.WorkingHours, res.WorkingHours)
+			if tt.req.TypeCapacities != nil {
+				require.Equal(t, tt.req.TypeCapacities, res.TypeCapacities)
+			}
+			if tt.req.ClearLocation {
+				require.Nil(t, res.Location)
+			}
+			if tt.req.ClearCapacity {
+				require.Nil(t, res.Capacity)
+			}
+		})
+	}
+}
+
+func TestPVZUseCase_ListNearby(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	point := domain.GeoPoint{Lat: 55.7558, Lon: 37.6173}
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		pvzs := []*domain.PVZ{{ID: uuid.New(), DistanceKm: 0.4}, {ID: uuid.New(), DistanceKm: 2.1}}
+
+		m := newPvZMocks(t)
+		m.MockPvzRepo.EXPECT().
+			ListNearby(ctx, point, domain.DefaultNearbyRadiusKm, uint(listparams.DefaultLimit)).
+			Return(pvzs, nil).
+			Times(1)
+
+		useCase := New(m.MockPvzRepo, m.MockCityRepo, m.MockReceptionRepo, m.MockProductRepo, m.MockProductTypeRepo, m.MockTxManager, m.MockAuditRecorder, m.MockEventEmitter)
+
+		res, err := useCase.ListNearby(ctx, dto.PVZNearbyParams{Point: point})
+		require.NoError(t, err)
+		require.Equal(t, pvzs, res)
+	})
+
+	t.Run("custom radius and limit", func(t *testing.T) {
+		t.Parallel()
+
+		m := newPvZMocks(t)
+		m.MockPvzRepo.EXPECT().
+			ListNearby(ctx, point, 12.5, uint(3)).
+			Return(nil, nil).
+			Times(1)
+
+		useCase := New(m.MockPvzRepo, m.MockCityRepo, m.MockReceptionRepo, m.MockProductRepo, m.MockProductTypeRepo, m.MockTxManager, m.MockAuditRecorder, m.MockEventEmitter)
+
+		_, err := useCase.ListNearby(ctx, dto.PVZNearbyParams{Point: point, RadiusKm: 12.5, Limit: 3})
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid point", func(t *testing.T) {
+		t.Parallel()
+
+		m := newPvZMocks(t)
+		useCase := New(m.MockPvzRepo, m.MockCityRepo, m.MockReceptionRepo, m.MockProductRepo, m.MockProductTypeRepo, m.MockTxManager, m.MockAuditRecorder, m.MockEventEmitter)
+
+		_, err := useCase.ListNearby(ctx, dto.PVZNearbyParams{Point: domain.GeoPoint{Lat: 55.7, Lon: 181}})
+		require.ErrorIs(t, err, domain.ErrInvalidPVZLocation)
+	})
+
+	t.Run("invalid radius", func(t *testing.T) {
+		t.Parallel()
+
+		m := newPvZMocks(t)
+		useCase := New(m.MockPvzRepo, m.MockCityRepo, m.MockReceptionRepo, m.MockProductRepo, m.MockProductTypeRepo, m.MockTxManager, m.MockAuditRecorder, m.MockEventEmitter)
+
+		for _, radius := range []float64{-1, domain.MaxNearbyRadiusKm + 1, math.NaN()} {
+			_, err := useCase.ListNearby(ctx, dto.PVZNearbyParams{Point: point, RadiusKm: radius})
+			require.ErrorIs(t, err, domain.ErrInvalidNearbyRadius)
+		}
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		t.Parallel()
+
+		m := newPvZMocks(t)
+		m.MockPvzRepo.EXPECT().
+			ListNearby(ctx, point, domain.DefaultNearbyRadiusKm, uint(listparams.DefaultLimit)).
+			Return(nil, errors.New("db error")).
+			Times(1)
+
+		useCase := New(m.MockPvzRepo, m.MockCityRepo, m.MockReceptionRepo, m.MockProductRepo, m.MockProductTypeRepo, m.MockTxManager, m.MockAuditRecorder, m.MockEventEmitter)
+
+		_, err := useCase.ListNearby(ctx, dto.PVZNearbyParams{Point: point})
+		require.ErrorContains(t, err, "failed to list nearby pvz")
+	})
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	domain "github.com/valeragav/avito-pvz-service/internal/domain"
@@ -57,19 +58,48 @@ func (mr *MockreceptionRepoMockRecorder) Create(ctx, reception any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockreceptionRepo)(nil).Create), ctx, reception)
 }
 
-// FindByStatus mocks base method.
-func (m *MockreceptionRepo) FindByStatus(ctx context.Context, statusName domain.ReceptionStatusCode, filter domain.Reception) (*domain.Reception, error) {
+// Export mocks base method.
+func (m *MockreceptionRepo) Export(ctx context.Context, startDate, endDate *time.Time, fn func(domain.ReceptionExportRow) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByStatus", ctx, statusName, filter)
+	ret := m.ctrl.Call(m, "Export", ctx, startDate, endDate, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockreceptionRepoMockRecorder) Export(ctx, startDate, endDate, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockreceptionRepo)(nil).Export), ctx, startDate, endDate, fn)
+}
+
+// FindOpen mocks base method.
+func (m *MockreceptionRepo) FindOpen(ctx context.Context, filter domain.Reception) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpen", ctx, filter)
 	ret0, _ := ret[0].(*domain.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByStatus indicates an expected call of FindByStatus.
-func (mr *MockreceptionRepoMockRecorder) FindByStatus(ctx, statusName, filter any) *gomock.Call {
+// FindOpen indicates an expected call of FindOpen.
+func (mr *MockreceptionRepoMockRecorder) FindOpen(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStatus", reflect.TypeOf((*MockreceptionRepo)(nil).FindByStatus), ctx, statusName, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpen", reflect.TypeOf((*MockreceptionRepo)(nil).FindOpen), ctx, filter)
+}
+
+// GetWithStatus mocks base method.
+func (m *MockreceptionRepo) GetWithStatus(ctx context.Context, receptionID uuid.UUID) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithStatus", ctx, receptionID)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithStatus indicates an expected call of GetWithStatus.
+func (mr *MockreceptionRepoMockRecorder) GetWithStatus(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithStatus", reflect.TypeOf((*MockreceptionRepo)(nil).GetWithStatus), ctx, receptionID)
 }
 
 // Update mocks base method.
@@ -150,17 +180,239 @@ func (m *MockpvzRepo) EXPECT() *MockpvzRepoMockRecorder {
 	return m.recorder
 }
 
-// Get mocks base method.
-func (m *MockpvzRepo) Get(ctx context.Context, filter domain.PVZ) (*domain.PVZ, error) {
+// GetForUpdate mocks base method.
+func (m *MockpvzRepo) GetForUpdate(ctx context.Context, pvzID uuid.UUID) (*domain.PVZ, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, filter)
+	ret := m.ctrl.Call(m, "GetForUpdate", ctx, pvzID)
 	ret0, _ := ret[0].(*domain.PVZ)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Get indicates an expected call of Get.
-func (mr *MockpvzRepoMockRecorder) Get(ctx, filter any) *gomock.Call {
+// GetForUpdate indicates an expected call of GetForUpdate.
+func (mr *MockpvzRepoMockRecorder) GetForUpdate(ctx, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForUpdate", reflect.TypeOf((*MockpvzRepo)(nil).GetForUpdate), ctx, pvzID)
+}
+
+// MockproductRepo is a mock of productRepo interface.
+type MockproductRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockproductRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockproductRepoMockRecorder is the mock recorder for MockproductRepo.
+type MockproductRepoMockRecorder struct {
+	mock *MockproductRepo
+}
+
+// NewMockproductRepo creates a new mock instance.
+func NewMockproductRepo(ctrl *gomock.Controller) *MockproductRepo {
+	mock := &MockproductRepo{ctrl: ctrl}
+	mock.recorder = &MockproductRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproductRepo) EXPECT() *MockproductRepoMockRecorder {
+	return m.recorder
+}
+
+// CountByTypeInReception mocks base method.
+func (m *MockproductRepo) CountByTypeInReception(ctx context.Context, receptionID uuid.UUID) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByTypeInReception", ctx, receptionID)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByTypeInReception indicates an expected call of CountByTypeInReception.
+func (mr *MockproductRepoMockRecorder) CountByTypeInReception(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByTypeInReception", reflect.TypeOf((*MockproductRepo)(nil).CountByTypeInReception), ctx, receptionID)
+}
+
+// InventoryByType mocks base method.
+func (m *MockproductRepo) InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InventoryByType", ctx, pvzID, asOf)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InventoryByType indicates an expected call of InventoryByType.
+func (mr *MockproductRepoMockRecorder) InventoryByType(ctx, pvzID, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InventoryByType", reflect.TypeOf((*MockproductRepo)(nil).InventoryByType), ctx, pvzID, asOf)
+}
+
+// MocktransitionRepo is a mock of transitionRepo interface.
+type MocktransitionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransitionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MocktransitionRepoMockRecorder is the mock recorder for MocktransitionRepo.
+type MocktransitionRepoMockRecorder struct {
+	mock *MocktransitionRepo
+}
+
+// NewMocktransitionRepo creates a new mock instance.
+func NewMocktransitionRepo(ctrl *gomock.Controller) *MocktransitionRepo {
+	mock := &MocktransitionRepo{ctrl: ctrl}
+	mock.recorder = &MocktransitionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktransitionRepo) EXPECT() *MocktransitionRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MocktransitionRepo) Create(ctx context.Context, transition domain.ReceptionTransition) (*domain.ReceptionTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, transition)
+	ret0, _ := ret[0].(*domain.ReceptionTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MocktransitionRepoMockRecorder) Create(ctx, transition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocktransitionRepo)(nil).Create), ctx, transition)
+}
+
+// ListByReception mocks base method.
+func (m *MocktransitionRepo) ListByReception(ctx context.Context, receptionID uuid.UUID) ([]*domain.ReceptionTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByReception", ctx, receptionID)
+	ret0, _ := ret[0].([]*domain.ReceptionTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByReception indicates an expected call of ListByReception.
+func (mr *MocktransitionRepoMockRecorder) ListByReception(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByReception", reflect.TypeOf((*MocktransitionRepo)(nil).ListByReception), ctx, receptionID)
+}
+
+// MocktxManager is a mock of txManager interface.
+type MocktxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktxManagerMockRecorder is the mock recorder for MocktxManager.
+type MocktxManagerMockRecorder struct {
+	mock *MocktxManager
+}
+
+// NewMocktxManager creates a new mock instance.
+func NewMocktxManager(ctrl *gomock.Controller) *MocktxManager {
+	mock := &MocktxManager{ctrl: ctrl}
+	mock.recorder = &MocktxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktxManager) EXPECT() *MocktxManagerMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MocktxManager) Do(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MocktxManagerMockRecorder) Do(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MocktxManager)(nil).Do), ctx, fn)
+}
+
+// MockauditRecorder is a mock of auditRecorder interface.
+type MockauditRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditRecorderMockRecorder
+	isgomock struct{}
+}
+
+// MockauditRecorderMockRecorder is the mock recorder for MockauditRecorder.
+type MockauditRecorderMockRecorder struct {
+	mock *MockauditRecorder
+}
+
+// NewMockauditRecorder creates a new mock instance.
+func NewMockauditRecorder(ctrl *gomock.Controller) *MockauditRecorder {
+	mock := &MockauditRecorder{ctrl: ctrl}
+	mock.recorder = &MockauditRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditRecorder) EXPECT() *MockauditRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockauditRecorder) Record(ctx context.Context, event domain.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockauditRecorderMockRecorder) Record(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockauditRecorder)(nil).Record), ctx, event)
+}
+
+// MockeventEmitter is a mock of eventEmitter interface.
+type MockeventEmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockeventEmitterMockRecorder
+	isgomock struct{}
+}
+
+// MockeventEmitterMockRecorder is the mock recorder for MockeventEmitter.
+type MockeventEmitterMockRecorder struct {
+	mock *MockeventEmitter
+}
+
+// NewMockeventEmitter creates a new mock instance.
+func NewMockeventEmitter(ctrl *gomock.Controller) *MockeventEmitter {
+	mock := &MockeventEmitter{ctrl: ctrl}
+	mock.recorder = &MockeventEmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockeventEmitter) EXPECT() *MockeventEmitterMockRecorder {
+	return m.recorder
+}
+
+// Emit mocks base method.
+func (m *MockeventEmitter) Emit(ctx context.Context, event domain.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Emit", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Emit indicates an expected call of Emit.
+func (mr *MockeventEmitterMockRecorder) Emit(ctx, event any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockpvzRepo)(nil).Get), ctx, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Emit", reflect.TypeOf((*MockeventEmitter)(nil).Emit), ctx, event)
 }
@@ -9,14 +9,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/capacity"
 	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
 )
 
 //go:generate ${LOCAL_BIN}/mockgen -source=reception.go -destination=./mocks/reception_mock.go -package=mocks
 type receptionRepo interface {
-	FindByStatus(ctx context.Context, statusName domain.ReceptionStatusCode, filter domain.Reception) (*domain.Reception, error)
+	FindOpen(ctx context.Context, filter domain.Reception) (*domain.Reception, error)
+	GetWithStatus(ctx context.Context, receptionID uuid.UUID) (*domain.Reception, error)
 	Create(ctx context.Context, reception domain.Reception) (*domain.Reception, error)
 	Update(ctx context.Context, receptionID uuid.UUID, update domain.Reception) (*domain.Reception, error)
+	Export(ctx context.Context, startDate, endDate *time.Time, fn func(domain.ReceptionExportRow) error) error
 }
 
 type receptionStatusRepo interface {
@@ -24,28 +27,100 @@ type receptionStatusRepo interface {
 }
 
 type pvzRepo interface {
-	Get(ctx context.Context, filter domain.PVZ) (*domain.PVZ, error)
+	GetForUpdate(ctx context.Context, pvzID uuid.UUID) (*domain.PVZ, error)
 }
 
+type productRepo interface {
+	CountByTypeInReception(ctx context.Context, receptionID uuid.UUID) (map[string]int, error)
+	InventoryByType(ctx context.Context, pvzID uuid.UUID, asOf *time.Time) (map[string]int, error)
+}
+
+type transitionRepo interface {
+	Create(ctx context.Context, transition domain.ReceptionTransition) (*domain.ReceptionTransition, error)
+	ListByReception(ctx context.Context, receptionID uuid.UUID) ([]*domain.ReceptionTransition, error)
+}
+
+type txManager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type auditRecorder interface {
+	Record(ctx context.Context, event domain.AuditEvent) error
+}
+
+type eventEmitter interface {
+	Emit(ctx context.Context, event domain.OutboxEvent) error
+}
+
+// openBarcodeIndex уникальный индекс штрихкодов в открытых приёмках (миграция 000017)
+const openBarcodeIndex = "idx_products_open_barcode"
+
 type ReceptionUseCase struct {
-	receptionRepo receptionRepo
-	statusRepo    receptionStatusRepo
-	pvzRepo       pvzRepo
+	receptionRepo  receptionRepo
+	statusRepo     receptionStatusRepo
+	pvzRepo        pvzRepo
+	productRepo    productRepo
+	transitionRepo transitionRepo
+	txManager      txManager
+	auditRecorder  auditRecorder
+	eventEmitter   eventEmitter
 }
 
-func New(receptionRepo receptionRepo, statusRepo receptionStatusRepo, pvzRepo pvzRepo) *ReceptionUseCase {
+func New(
+	receptionRepo receptionRepo,
+	statusRepo receptionStatusRepo,
+	pvzRepo pvzRepo,
+	productRepo productRepo,
+	transitionRepo transitionRepo,
+	txManager txManager,
+	auditRecorder auditRecorder,
+	eventEmitter eventEmitter,
+) *ReceptionUseCase {
 	return &ReceptionUseCase{
 		receptionRepo,
 		statusRepo,
 		pvzRepo,
+		productRepo,
+		transitionRepo,
+		txManager,
+		auditRecorder,
+		eventEmitter,
 	}
 }
 
 func (s *ReceptionUseCase) Create(ctx context.Context, createIn dto.ReceptionCreate) (*domain.Reception, error) {
+	var res *domain.Reception
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.create(ctx, createIn)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *ReceptionUseCase) create(ctx context.Context, createIn dto.ReceptionCreate) (*domain.Reception, error) {
 	const op = "receptions.Create"
 
+	// Блокируем PVZ, чтобы параллельные запросы не открыли две приёмки одновременно
+	pvzEnt, err := s.pvzRepo.GetForUpdate(ctx, createIn.PvzID)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, domain.ErrPVZNotFound
+		}
+		return nil, fmt.Errorf("%s: failed to lock pvz: %w", op, err)
+	}
+
+	if !pvzEnt.IsActive() {
+		return nil, domain.ErrPVZNotActive
+	}
+
 	// Если же предыдущая приёмка товара не была закрыта, то операция по созданию нового приёма товаров невозможна.
-	_, err := s.receptionRepo.FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+	_, err = s.receptionRepo.FindOpen(ctx, domain.Reception{
 		PvzID: createIn.PvzID,
 	})
 	if err == nil {
@@ -63,25 +138,69 @@ func (s *ReceptionUseCase) Create(ctx context.Context, createIn dto.ReceptionCre
 	}
 
 	pvzRes, err := s.receptionRepo.Create(ctx, domain.Reception{
-		DateTime: time.Now(),
-		PvzID:    createIn.PvzID,
-		StatusID: status.ID,
+		DateTime:  time.Now(),
+		PvzID:     createIn.PvzID,
+		StatusID:  status.ID,
+		CreatedBy: createIn.CreatedBy,
 	})
 	if err != nil {
+		// Частичный уникальный индекс не даёт открыть вторую приёмку в PVZ
+		if errors.Is(err, infra.ErrDuplicate) {
+			return nil, domain.ErrNoReceptionIsCurrentlyInProgress
+		}
 		return nil, fmt.Errorf("%s: failed to create reception: %w", op, err)
 	}
 
 	pvzRes.ReceptionStatus = status
 
+	err = s.recordTransition(ctx, pvzRes.ID, "", status.Name, createIn.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.auditRecorder.Record(ctx, domain.AuditEvent{
+		ActorID:  createIn.CreatedBy,
+		Action:   domain.AuditActionReceptionOpened,
+		EntityID: pvzRes.ID,
+		PvzID:    pvzRes.PvzID,
+		After:    pvzRes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+		Type:        domain.EventReceptionOpened,
+		AggregateID: pvzRes.ID,
+		PvzID:       pvzRes.PvzID,
+		Payload:     pvzRes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return pvzRes, nil
 }
 
-func (s *ReceptionUseCase) CloseLastReception(ctx context.Context, pvzID uuid.UUID) (*domain.Reception, error) {
-	const op = "receptions.CloseLastReception"
+func (s *ReceptionUseCase) CloseLastReception(ctx context.Context, closeIn dto.ReceptionClose) (*domain.Reception, error) {
+	var res *domain.Reception
 
-	_, err := s.pvzRepo.Get(ctx, domain.PVZ{
-		ID: pvzID,
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = s.closeLastReception(ctx, closeIn)
+		return err
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *ReceptionUseCase) closeLastReception(ctx context.Context, closeIn dto.ReceptionClose) (*domain.Reception, error) {
+	const op = "receptions.CloseLastReception"
+
+	_, err := s.pvzRepo.GetForUpdate(ctx, closeIn.PvzID)
 	if err != nil {
 		if errors.Is(err, infra.ErrNotFound) {
 			return nil, domain.ErrPVZNotFound
@@ -89,8 +208,8 @@ func (s *ReceptionUseCase) CloseLastReception(ctx context.Context, pvzID uuid.UU
 		return nil, fmt.Errorf("%s: failed to find pvz: %w", op, err)
 	}
 
-	lastReception, err := s.receptionRepo.FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
-		PvzID: pvzID,
+	lastReception, err := s.receptionRepo.FindOpen(ctx, domain.Reception{
+		PvzID: closeIn.PvzID,
 	})
 	if err != nil {
 		if errors.Is(err, infra.ErrNotFound) {
@@ -108,6 +227,7 @@ func (s *ReceptionUseCase) CloseLastReception(ctx context.Context, pvzID uuid.UU
 
 	closedReception, err := s.receptionRepo.Update(ctx, lastReception.ID, domain.Reception{
 		StatusID: status.ID,
+		ClosedBy: closeIn.ClosedBy,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to close reception: %w", err)
@@ -115,5 +235,243 @@ func (s *ReceptionUseCase) CloseLastReception(ctx context.Context, pvzID uuid.UU
 
 	closedReception.ReceptionStatus = status
 
+	err = s.recordTransition(ctx, closedReception.ID, currentStatus(lastReception), status.Name, closeIn.ClosedBy)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.auditRecorder.Record(ctx, domain.AuditEvent{
+		ActorID:  closeIn.ClosedBy,
+		Action:   domain.AuditActionReceptionClosed,
+		EntityID: closedReception.ID,
+		PvzID:    closedReception.PvzID,
+		Before:   lastReception,
+		After:    closedReception,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	productCounts, err := s.productRepo.CountByTypeInReception(ctx, closedReception.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to count products: %w", op, err)
+	}
+
+	err = s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+		Type:        domain.EventReceptionClosed,
+		AggregateID: closedReception.ID,
+		PvzID:       closedReception.PvzID,
+		Payload: domain.ReceptionClosedPayload{
+			Reception:     closedReception,
+			ProductCounts: productCounts,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return closedReception, nil
 }
+
+// Cancel отменяет открытую по ошибке приёмку. Отменённую приёмку нельзя закрыть или переоткрыть.
+func (s *ReceptionUseCase) Cancel(ctx context.Context, in dto.ReceptionTransition) (*domain.Reception, error) {
+	var (
+		res   *domain.Reception
+		usage *capacity.Usage
+	)
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, usage, err = s.transition(ctx, "receptions.Cancel", in, domain.ReceptionStatusCancelled)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Report()
+
+	return res, nil
+}
+
+// Reopen переоткрывает закрытую приёмку, чтобы исправить сканирование.
+// В PVZ при этом не должно быть другой открытой приёмки.
+func (s *ReceptionUseCase) Reopen(ctx context.Context, in dto.ReceptionTransition) (*domain.Reception, error) {
+	var res *domain.Reception
+
+	err := s.txManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, _, err = s.transition(ctx, "receptions.Reopen", in, domain.ReceptionStatusReopened)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *ReceptionUseCase) ListTransitions(ctx context.Context, receptionID uuid.UUID) ([]*domain.ReceptionTransition, error) {
+	const op = "receptions.ListTransitions"
+
+	_, err := s.receptionRepo.GetWithStatus(ctx, receptionID)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, domain.ErrReceptionNotFound
+		}
+		return nil, fmt.Errorf("%s: failed to get reception: %w", op, err)
+	}
+
+	transitions, err := s.transitionRepo.ListByReception(ctx, receptionID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list transitions: %w", op, err)
+	}
+
+	return transitions, nil
+}
+
+// Export передаёт строки выгрузки в fn по одной, без транзакции: выгрузка может читаться долго,
+// и держать транзакцию всё это время не нужно. Ошибка fn прерывает выгрузку.
+func (s *ReceptionUseCase) Export(ctx context.Context, exportIn dto.ReceptionExport, fn func(domain.ReceptionExportRow) error) error {
+	const op = "receptions.Export"
+
+	if exportIn.StartDate != nil && exportIn.EndDate != nil && exportIn.StartDate.After(*exportIn.EndDate) {
+		return domain.ErrInvalidExportPeriod
+	}
+
+	if err := s.receptionRepo.Export(ctx, exportIn.StartDate, exportIn.EndDate, fn); err != nil {
+		return fmt.Errorf("%s: failed to export receptions: %w", op, err)
+	}
+
+	return nil
+}
+
+// transition переводит приёмку в статус to. Остаток PVZ для метрики загрузки
+// возвращается только при отмене: переоткрытие закрытой приёмки остаток не меняет.
+func (s *ReceptionUseCase) transition(ctx context.Context, op string, in dto.ReceptionTransition, to domain.ReceptionStatusCode) (*domain.Reception, *capacity.Usage, error) {
+	reception, err := s.receptionRepo.GetWithStatus(ctx, in.ReceptionID)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, domain.ErrReceptionNotFound
+		}
+		return nil, nil, fmt.Errorf("%s: failed to get reception: %w", op, err)
+	}
+
+	// Все изменения приёмок PVZ идут под блокировкой PVZ, поэтому после неё статус перечитываем
+	pvzEnt, err := s.pvzRepo.GetForUpdate(ctx, reception.PvzID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to lock pvz: %w", op, err)
+	}
+
+	reception, err = s.receptionRepo.GetWithStatus(ctx, in.ReceptionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get reception: %w", op, err)
+	}
+
+	from := currentStatus(reception)
+	if !from.CanTransitionTo(to) {
+		return nil, nil, fmt.Errorf("%w: %s -> %s", domain.ErrInvalidReceptionTransition, from, to)
+	}
+
+	if to.IsOpen() {
+		if !pvzEnt.IsActive() {
+			return nil, nil, domain.ErrPVZNotActive
+		}
+
+		_, err = s.receptionRepo.FindOpen(ctx, domain.Reception{PvzID: reception.PvzID})
+		if err == nil {
+			return nil, nil, domain.ErrPVZHasOpenReception
+		}
+		if !errors.Is(err, infra.ErrNotFound) {
+			return nil, nil, fmt.Errorf("%s: failed to check open reception: %w", op, err)
+		}
+	}
+
+	status, err := s.statusRepo.Get(ctx, domain.ReceptionStatus{
+		Name: to,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: failed to get status: %w", op, err)
+	}
+
+	update := domain.Reception{StatusID: status.ID}
+	if to == domain.ReceptionStatusCancelled {
+		update.ClosedBy = in.ActorID
+	}
+
+	updated, err := s.receptionRepo.Update(ctx, reception.ID, update)
+	if err != nil {
+		if errors.Is(err, infra.ErrDuplicate) {
+			// товары переоткрытой приёмки снова проверяются на уникальность штрихкода
+			if infra.ConstraintName(err) == openBarcodeIndex {
+				return nil, nil, domain.ErrReceptionBarcodeConflict
+			}
+			return nil, nil, domain.ErrPVZHasOpenReception
+		}
+		return nil, nil, fmt.Errorf("%s: failed to update reception: %w", op, err)
+	}
+
+	updated.ReceptionStatus = status
+
+	var usage *capacity.Usage
+	if to == domain.ReceptionStatusCancelled {
+		usage, err = capacity.Load(ctx, s.productRepo, pvzEnt)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: failed to get pvz stock: %w", op, err)
+		}
+	}
+
+	err = s.recordTransition(ctx, updated.ID, from, to, in.ActorID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	action, eventType := domain.AuditActionReceptionReopened, domain.EventReceptionReopened
+	if to == domain.ReceptionStatusCancelled {
+		action, eventType = domain.AuditActionReceptionCancelled, domain.EventReceptionCancelled
+	}
+
+	err = s.auditRecorder.Record(ctx, domain.AuditEvent{
+		ActorID:  in.ActorID,
+		Action:   action,
+		EntityID: updated.ID,
+		PvzID:    updated.PvzID,
+		Before:   reception,
+		After:    updated,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.eventEmitter.Emit(ctx, domain.OutboxEvent{
+		Type:        eventType,
+		AggregateID: updated.ID,
+		PvzID:       updated.PvzID,
+		Payload:     updated,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return updated, usage, nil
+}
+
+func (s *ReceptionUseCase) recordTransition(ctx context.Context, receptionID uuid.UUID, from, to domain.ReceptionStatusCode, actorID uuid.UUID) error {
+	_, err := s.transitionRepo.Create(ctx, domain.ReceptionTransition{
+		ReceptionID: receptionID,
+		FromStatus:  from,
+		ToStatus:    to,
+		ActorID:     actorID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record transition: %w", err)
+	}
+	return nil
+}
+
+func currentStatus(reception *domain.Reception) domain.ReceptionStatusCode {
+	if reception.ReceptionStatus == nil {
+		return ""
+	}
+	return reception.ReceptionStatus.Name
+}
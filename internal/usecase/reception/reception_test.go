@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -19,15 +20,33 @@ type receptionMocks struct {
 	MockReceptionRepo       *mocks.MockreceptionRepo
 	MockReceptionStatusRepo *mocks.MockreceptionStatusRepo
 	MockPvzRepo             *mocks.MockpvzRepo
+	MockProductRepo         *mocks.MockproductRepo
+	MockTransitionRepo      *mocks.MocktransitionRepo
+	MockTxManager           *mocks.MocktxManager
+	MockAuditRecorder       *mocks.MockauditRecorder
+	MockEventEmitter        *mocks.MockeventEmitter
 }
 
 func newReceptionMocks(t *testing.T) *receptionMocks {
 	ctrl := gomock.NewController(t)
 
+	txManager := mocks.NewMocktxManager(ctrl)
+	txManager.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		AnyTimes()
+
 	return &receptionMocks{
 		MockReceptionRepo:       mocks.NewMockreceptionRepo(ctrl),
 		MockReceptionStatusRepo: mocks.NewMockreceptionStatusRepo(ctrl),
 		MockPvzRepo:             mocks.NewMockpvzRepo(ctrl),
+		MockProductRepo:         mocks.NewMockproductRepo(ctrl),
+		MockTransitionRepo:      mocks.NewMocktransitionRepo(ctrl),
+		MockTxManager:           txManager,
+		MockAuditRecorder:       mocks.NewMockauditRecorder(ctrl),
+		MockEventEmitter:        mocks.NewMockeventEmitter(ctrl),
 	}
 }
 
@@ -48,13 +67,19 @@ func TestReceptionUseCase_Create(t *testing.T) {
 		{
 			name: "ok",
 			req: dto.ReceptionCreate{
-				PvzID: uuid.New(),
+				PvzID:     uuid.New(),
+				CreatedBy: uuid.New(),
 			},
 			mockFn: func(f fields, m *receptionMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
 				statusID := uuid.New()
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+					FindOpen(ctx, domain.Reception{
 						PvzID: f.req.PvzID,
 					}).
 					Return(nil, infra.ErrNotFound).
@@ -73,21 +98,69 @@ func TestReceptionUseCase_Create(t *testing.T) {
 				m.MockReceptionRepo.EXPECT().
 					Create(ctx, gomock.Any()).
 					DoAndReturn(func(ctx context.Context, r domain.Reception) (*domain.Reception, error) {
+						require.Equal(t, f.req.CreatedBy, r.CreatedBy)
 						r.ID = uuid.New()
 						return &r, nil
 					}).
 					Times(1)
+
+				m.MockTransitionRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, tr domain.ReceptionTransition) (*domain.ReceptionTransition, error) {
+						require.Empty(t, tr.FromStatus)
+						require.Equal(t, domain.ReceptionStatusInProgress, tr.ToStatus)
+						require.Equal(t, f.req.CreatedBy, tr.ActorID)
+						return &tr, nil
+					}).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionReceptionOpened, e.Action)
+						require.Equal(t, f.req.CreatedBy, e.ActorID)
+						require.Equal(t, f.req.PvzID, e.PvzID)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventReceptionOpened, e.Type)
+						require.Equal(t, f.req.PvzID, e.PvzID)
+						return nil
+					}).
+					Times(1)
 			},
 			wantErr: nil,
 		},
+		{
+			name: "pvz suspended",
+			req: dto.ReceptionCreate{
+				PvzID: uuid.New(),
+			},
+			mockFn: func(f fields, m *receptionMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusSuspended}, nil).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZNotActive,
+		},
 		{
 			name: "previous reception not found (business error)",
 			req: dto.ReceptionCreate{
 				PvzID: uuid.New(),
 			},
 			mockFn: func(f fields, m *receptionMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+					FindOpen(ctx, domain.Reception{
 						PvzID: f.req.PvzID,
 					}).
 					Return(nil, nil).
@@ -101,8 +174,13 @@ func TestReceptionUseCase_Create(t *testing.T) {
 				PvzID: uuid.New(),
 			},
 			mockFn: func(f fields, m *receptionMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+					FindOpen(ctx, domain.Reception{
 						PvzID: f.req.PvzID,
 					}).
 					Return(nil, errors.New("db error")).
@@ -116,8 +194,13 @@ func TestReceptionUseCase_Create(t *testing.T) {
 				PvzID: uuid.New(),
 			},
 			mockFn: func(f fields, m *receptionMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+					FindOpen(ctx, domain.Reception{
 						PvzID: f.req.PvzID,
 					}).
 					Return(nil, infra.ErrNotFound).
@@ -135,13 +218,19 @@ func TestReceptionUseCase_Create(t *testing.T) {
 		{
 			name: "failed to create reception",
 			req: dto.ReceptionCreate{
-				PvzID: uuid.New(),
+				PvzID:     uuid.New(),
+				CreatedBy: uuid.New(),
 			},
 			mockFn: func(f fields, m *receptionMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
 				statusID := uuid.New()
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+					FindOpen(ctx, domain.Reception{
 						PvzID: f.req.PvzID,
 					}).
 					Return(nil, infra.ErrNotFound).
@@ -164,6 +253,51 @@ func TestReceptionUseCase_Create(t *testing.T) {
 			},
 			wantErr: errors.New("receptions.Create: failed to create reception: create error"),
 		},
+		{
+			name: "pvz not found",
+			req: dto.ReceptionCreate{
+				PvzID: uuid.New(),
+			},
+			mockFn: func(f fields, m *receptionMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+			},
+			wantErr: domain.ErrPVZNotFound,
+		},
+		{
+			name: "reception opened concurrently (unique index)",
+			req: dto.ReceptionCreate{
+				PvzID: uuid.New(),
+			},
+			mockFn: func(f fields, m *receptionMocks) {
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.req.PvzID).
+					Return(&domain.PVZ{ID: f.req.PvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{
+						PvzID: f.req.PvzID,
+					}).
+					Return(nil, infra.ErrNotFound).
+					Times(1)
+
+				m.MockReceptionStatusRepo.EXPECT().
+					Get(ctx, domain.ReceptionStatus{
+						Name: domain.ReceptionStatusInProgress,
+					}).
+					Return(&domain.ReceptionStatus{ID: uuid.New()}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(nil, infra.ErrDuplicate).
+					Times(1)
+			},
+			wantErr: domain.ErrNoReceptionIsCurrentlyInProgress,
+		},
 	}
 
 	for _, tt := range testcases {
@@ -177,6 +311,11 @@ func TestReceptionUseCase_Create(t *testing.T) {
 				receptionMocks.MockReceptionRepo,
 				receptionMocks.MockReceptionStatusRepo,
 				receptionMocks.MockPvzRepo,
+				receptionMocks.MockProductRepo,
+				receptionMocks.MockTransitionRepo,
+				receptionMocks.MockTxManager,
+				receptionMocks.MockAuditRecorder,
+				receptionMocks.MockEventEmitter,
 			)
 
 			res, err := useCase.Create(ctx, tt.req)
@@ -201,6 +340,7 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 
 	testutils.InitTestLogger()
 	ctx := context.Background()
+	closedBy := uuid.New()
 
 	type fields struct {
 		name    string
@@ -218,17 +358,18 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 				statusID := uuid.New()
 
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
-					Return(&domain.PVZ{ID: f.pvzID}, nil).
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
 					Times(1)
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+					FindOpen(ctx, domain.Reception{
 						PvzID: f.pvzID,
 					}).
 					Return(&domain.Reception{
-						ID:    receptionID,
-						PvzID: f.pvzID,
+						ID:              receptionID,
+						PvzID:           f.pvzID,
+						ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusInProgress},
 					}, nil).
 					Times(1)
 
@@ -245,6 +386,7 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 				m.MockReceptionRepo.EXPECT().
 					Update(ctx, receptionID, domain.Reception{
 						StatusID: statusID,
+						ClosedBy: closedBy,
 					}).
 					Return(&domain.Reception{
 						ID:       receptionID,
@@ -252,15 +394,155 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 						StatusID: statusID,
 					}, nil).
 					Times(1)
+
+				m.MockTransitionRepo.EXPECT().
+					Create(ctx, domain.ReceptionTransition{
+						ReceptionID: receptionID,
+						FromStatus:  domain.ReceptionStatusInProgress,
+						ToStatus:    domain.ReceptionStatusClose,
+						ActorID:     closedBy,
+					}).
+					Return(&domain.ReceptionTransition{}, nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionReceptionClosed, e.Action)
+						require.Equal(t, closedBy, e.ActorID)
+						require.Equal(t, receptionID, e.EntityID)
+						require.NotNil(t, e.Before)
+						return nil
+					}).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CountByTypeInReception(ctx, receptionID).
+					Return(map[string]int{"обувь": 2, "электроника": 1}, nil).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventReceptionClosed, e.Type)
+						require.Equal(t, receptionID, e.AggregateID)
+						payload, ok := e.Payload.(domain.ReceptionClosedPayload)
+						require.True(t, ok)
+						require.Equal(t, map[string]int{"обувь": 2, "электроника": 1}, payload.ProductCounts)
+						return nil
+					}).
+					Times(1)
 			},
 			wantErr: nil,
 		},
+		{
+			name:  "failed to record audit",
+			pvzID: uuid.New(),
+			mockFn: func(f fields, m *receptionMocks) {
+				receptionID := uuid.New()
+				statusID := uuid.New()
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{
+						PvzID: f.pvzID,
+					}).
+					Return(&domain.Reception{ID: receptionID, PvzID: f.pvzID}, nil).
+					Times(1)
+
+				m.MockReceptionStatusRepo.EXPECT().
+					Get(ctx, domain.ReceptionStatus{
+						Name: domain.ReceptionStatusClose,
+					}).
+					Return(&domain.ReceptionStatus{ID: statusID}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					Update(ctx, receptionID, domain.Reception{
+						StatusID: statusID,
+						ClosedBy: closedBy,
+					}).
+					Return(&domain.Reception{ID: receptionID, PvzID: f.pvzID, StatusID: statusID}, nil).
+					Times(1)
+
+				m.MockTransitionRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(&domain.ReceptionTransition{}, nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					Return(errors.New("audit error")).
+					Times(1)
+			},
+			wantErr: errors.New("receptions.CloseLastReception: audit error"),
+		},
+		{
+			name:  "failed to emit event",
+			pvzID: uuid.New(),
+			mockFn: func(f fields, m *receptionMocks) {
+				receptionID := uuid.New()
+				statusID := uuid.New()
+
+				m.MockPvzRepo.EXPECT().
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					FindOpen(ctx, domain.Reception{
+						PvzID: f.pvzID,
+					}).
+					Return(&domain.Reception{ID: receptionID, PvzID: f.pvzID}, nil).
+					Times(1)
+
+				m.MockReceptionStatusRepo.EXPECT().
+					Get(ctx, domain.ReceptionStatus{
+						Name: domain.ReceptionStatusClose,
+					}).
+					Return(&domain.ReceptionStatus{ID: statusID}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					Update(ctx, receptionID, domain.Reception{
+						StatusID: statusID,
+						ClosedBy: closedBy,
+					}).
+					Return(&domain.Reception{ID: receptionID, PvzID: f.pvzID, StatusID: statusID}, nil).
+					Times(1)
+
+				m.MockTransitionRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(&domain.ReceptionTransition{}, nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					Return(nil).
+					Times(1)
+
+				m.MockProductRepo.EXPECT().
+					CountByTypeInReception(ctx, receptionID).
+					Return(map[string]int{}, nil).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					Return(errors.New("outbox error")).
+					Times(1)
+			},
+			wantErr: errors.New("receptions.CloseLastReception: outbox error"),
+		},
 		{
 			name:  "pvz not found",
 			pvzID: uuid.New(),
 			mockFn: func(f fields, m *receptionMocks) {
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
+					GetForUpdate(ctx, f.pvzID).
 					Return(nil, infra.ErrNotFound).
 					Times(1)
 			},
@@ -271,7 +553,7 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 			pvzID: uuid.New(),
 			mockFn: func(f fields, m *receptionMocks) {
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
+					GetForUpdate(ctx, f.pvzID).
 					Return(nil, errors.New("db error")).
 					Times(1)
 			},
@@ -282,12 +564,12 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 			pvzID: uuid.New(),
 			mockFn: func(f fields, m *receptionMocks) {
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
-					Return(&domain.PVZ{ID: f.pvzID}, nil).
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
 					Times(1)
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+					FindOpen(ctx, domain.Reception{
 						PvzID: f.pvzID,
 					}).
 					Return(nil, infra.ErrNotFound).
@@ -300,12 +582,12 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 			pvzID: uuid.New(),
 			mockFn: func(f fields, m *receptionMocks) {
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
-					Return(&domain.PVZ{ID: f.pvzID}, nil).
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
 					Times(1)
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+					FindOpen(ctx, domain.Reception{
 						PvzID: f.pvzID,
 					}).
 					Return(nil, errors.New("reception error")).
@@ -320,12 +602,12 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 				receptionID := uuid.New()
 
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
-					Return(&domain.PVZ{ID: f.pvzID}, nil).
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
 					Times(1)
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+					FindOpen(ctx, domain.Reception{
 						PvzID: f.pvzID,
 					}).
 					Return(&domain.Reception{ID: receptionID}, nil).
@@ -348,12 +630,12 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 				statusID := uuid.New()
 
 				m.MockPvzRepo.EXPECT().
-					Get(ctx, domain.PVZ{ID: f.pvzID}).
-					Return(&domain.PVZ{ID: f.pvzID}, nil).
+					GetForUpdate(ctx, f.pvzID).
+					Return(&domain.PVZ{ID: f.pvzID, Status: domain.PVZStatusActive}, nil).
 					Times(1)
 
 				m.MockReceptionRepo.EXPECT().
-					FindByStatus(ctx, domain.ReceptionStatusInProgress, domain.Reception{
+					FindOpen(ctx, domain.Reception{
 						PvzID: f.pvzID,
 					}).
 					Return(&domain.Reception{ID: receptionID}, nil).
@@ -369,6 +651,7 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 				m.MockReceptionRepo.EXPECT().
 					Update(ctx, receptionID, domain.Reception{
 						StatusID: statusID,
+						ClosedBy: closedBy,
 					}).
 					Return(nil, errors.New("update error")).
 					Times(1)
@@ -388,9 +671,14 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 				receptionMocks.MockReceptionRepo,
 				receptionMocks.MockReceptionStatusRepo,
 				receptionMocks.MockPvzRepo,
+				receptionMocks.MockProductRepo,
+				receptionMocks.MockTransitionRepo,
+				receptionMocks.MockTxManager,
+				receptionMocks.MockAuditRecorder,
+				receptionMocks.MockEventEmitter,
 			)
 
-			res, err := useCase.CloseLastReception(ctx, tt.pvzID)
+			res, err := useCase.CloseLastReception(ctx, dto.ReceptionClose{PvzID: tt.pvzID, ClosedBy: closedBy})
 
 			if tt.wantErr != nil {
 				require.Error(t, err)
@@ -405,3 +693,448 @@ func TestReceptionUseCase_CloseLastReception(t *testing.T) {
 		})
 	}
 }
+
+func TestReceptionUseCase_Cancel(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+	actorID := uuid.New()
+
+	type fields struct {
+		name    string
+		mockFn  func(receptionID uuid.UUID, m *receptionMocks)
+		wantErr error
+	}
+
+	withStatus := func(receptionID, pvzID uuid.UUID, name domain.ReceptionStatusCode) *domain.Reception {
+		return &domain.Reception{
+			ID:              receptionID,
+			PvzID:           pvzID,
+			ReceptionStatus: &domain.ReceptionStatus{Name: name},
+		}
+	}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				pvzID := uuid.New()
+				statusID := uuid.New()
+				current := withStatus(receptionID, pvzID, domain.ReceptionStatusInProgress)
+
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(current, nil).Times(2)
+				m.MockPvzRepo.EXPECT().GetForUpdate(ctx, pvzID).Return(&domain.PVZ{ID: pvzID, Status: domain.PVZStatusActive}, nil).Times(1)
+
+				m.MockReceptionStatusRepo.EXPECT().
+					Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusCancelled}).
+					Return(&domain.ReceptionStatus{ID: statusID, Name: domain.ReceptionStatusCancelled}, nil).
+					Times(1)
+
+				m.MockReceptionRepo.EXPECT().
+					Update(ctx, receptionID, domain.Reception{StatusID: statusID, ClosedBy: actorID}).
+					Return(&domain.Reception{ID: receptionID, PvzID: pvzID, StatusID: statusID}, nil).
+					Times(1)
+
+				m.MockTransitionRepo.EXPECT().
+					Create(ctx, domain.ReceptionTransition{
+						ReceptionID: receptionID,
+						FromStatus:  domain.ReceptionStatusInProgress,
+						ToStatus:    domain.ReceptionStatusCancelled,
+						ActorID:     actorID,
+					}).
+					Return(&domain.ReceptionTransition{}, nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionReceptionCancelled, e.Action)
+						require.Equal(t, actorID, e.ActorID)
+						require.Equal(t, receptionID, e.EntityID)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventReceptionCancelled, e.Type)
+						require.Equal(t, receptionID, e.AggregateID)
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "reception not found",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(nil, infra.ErrNotFound).Times(1)
+			},
+			wantErr: domain.ErrReceptionNotFound,
+		},
+		{
+			name: "closed reception can not be cancelled",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				pvzID := uuid.New()
+				current := withStatus(receptionID, pvzID, domain.ReceptionStatusClose)
+
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(current, nil).Times(2)
+				m.MockPvzRepo.EXPECT().GetForUpdate(ctx, pvzID).Return(&domain.PVZ{ID: pvzID, Status: domain.PVZStatusActive}, nil).Times(1)
+			},
+			wantErr: domain.ErrInvalidReceptionTransition,
+		},
+		{
+			name: "failed to record transition",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				pvzID := uuid.New()
+				current := withStatus(receptionID, pvzID, domain.ReceptionStatusInProgress)
+
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(current, nil).Times(2)
+				m.MockPvzRepo.EXPECT().GetForUpdate(ctx, pvzID).Return(&domain.PVZ{ID: pvzID, Status: domain.PVZStatusActive}, nil).Times(1)
+				m.MockReceptionStatusRepo.EXPECT().Get(ctx, gomock.Any()).Return(&domain.ReceptionStatus{ID: uuid.New()}, nil).Times(1)
+				m.MockReceptionRepo.EXPECT().Update(ctx, receptionID, gomock.Any()).Return(&domain.Reception{ID: receptionID}, nil).Times(1)
+				m.MockTransitionRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+			},
+			wantErr: errors.New("receptions.Cancel: failed to record transition: db error"),
+		},
+		{
+			name: "stock error after cancel",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				pvzID := uuid.New()
+				capacity := 10
+				current := withStatus(receptionID, pvzID, domain.ReceptionStatusInProgress)
+
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(current, nil).Times(2)
+				m.MockPvzRepo.EXPECT().GetForUpdate(ctx, pvzID).Return(&domain.PVZ{ID: pvzID, Status: domain.PVZStatusActive, Capacity: &capacity}, nil).Times(1)
+				m.MockReceptionStatusRepo.EXPECT().Get(ctx, gomock.Any()).Return(&domain.ReceptionStatus{ID: uuid.New()}, nil).Times(1)
+				m.MockReceptionRepo.EXPECT().Update(ctx, receptionID, gomock.Any()).Return(&domain.Reception{ID: receptionID, PvzID: pvzID}, nil).Times(1)
+				m.MockProductRepo.EXPECT().InventoryByType(ctx, pvzID, nil).Return(nil, errors.New("db error")).Times(1)
+			},
+			wantErr: errors.New("receptions.Cancel: failed to get pvz stock: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			receptionID := uuid.New()
+
+			receptionMocks := newReceptionMocks(t)
+			tt.mockFn(receptionID, receptionMocks)
+
+			useCase := New(
+				receptionMocks.MockReceptionRepo,
+				receptionMocks.MockReceptionStatusRepo,
+				receptionMocks.MockPvzRepo,
+				receptionMocks.MockProductRepo,
+				receptionMocks.MockTransitionRepo,
+				receptionMocks.MockTxManager,
+				receptionMocks.MockAuditRecorder,
+				receptionMocks.MockEventEmitter,
+			)
+
+			res, err := useCase.Cancel(ctx, dto.ReceptionTransition{ReceptionID: receptionID, ActorID: actorID})
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, res)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, domain.ReceptionStatusCancelled, res.ReceptionStatus.Name)
+		})
+	}
+}
+
+func TestReceptionUseCase_Reopen(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+	actorID := uuid.New()
+
+	type fields struct {
+		name    string
+		mockFn  func(receptionID uuid.UUID, m *receptionMocks)
+		wantErr error
+	}
+
+	closed := func(receptionID, pvzID uuid.UUID) *domain.Reception {
+		return &domain.Reception{
+			ID:              receptionID,
+			PvzID:           pvzID,
+			ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusClose},
+		}
+	}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				pvzID := uuid.New()
+				statusID := uuid.New()
+
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(closed(receptionID, pvzID), nil).Times(2)
+				m.MockPvzRepo.EXPECT().GetForUpdate(ctx, pvzID).Return(&domain.PVZ{ID: pvzID, Status: domain.PVZStatusActive}, nil).Times(1)
+				m.MockReceptionRepo.EXPECT().FindOpen(ctx, domain.Reception{PvzID: pvzID}).Return(nil, infra.ErrNotFound).Times(1)
+
+				m.MockReceptionStatusRepo.EXPECT().
+					Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusReopened}).
+					Return(&domain.ReceptionStatus{ID: statusID, Name: domain.ReceptionStatusReopened}, nil).
+					Times(1)
+
+				// при переоткрытии closed_by не трогаем, кто переоткрыл видно в истории
+				m.MockReceptionRepo.EXPECT().
+					Update(ctx, receptionID, domain.Reception{StatusID: statusID}).
+					Return(&domain.Reception{ID: receptionID, PvzID: pvzID, StatusID: statusID}, nil).
+					Times(1)
+
+				m.MockTransitionRepo.EXPECT().
+					Create(ctx, domain.ReceptionTransition{
+						ReceptionID: receptionID,
+						FromStatus:  domain.ReceptionStatusClose,
+						ToStatus:    domain.ReceptionStatusReopened,
+						ActorID:     actorID,
+					}).
+					Return(&domain.ReceptionTransition{}, nil).
+					Times(1)
+
+				m.MockAuditRecorder.EXPECT().
+					Record(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.AuditEvent) error {
+						require.Equal(t, domain.AuditActionReceptionReopened, e.Action)
+						return nil
+					}).
+					Times(1)
+
+				m.MockEventEmitter.EXPECT().
+					Emit(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e domain.OutboxEvent) error {
+						require.Equal(t, domain.EventReceptionReopened, e.Type)
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "pvz already has open reception",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				pvzID := uuid.New()
+
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(closed(receptionID, pvzID), nil).Times(2)
+				m.MockPvzRepo.EXPECT().GetForUpdate(ctx, pvzID).Return(&domain.PVZ{ID: pvzID, Status: domain.PVZStatusActive}, nil).Times(1)
+				m.MockReceptionRepo.EXPECT().FindOpen(ctx, domain.Reception{PvzID: pvzID}).Return(&domain.Reception{ID: uuid.New()}, nil).Times(1)
+			},
+			wantErr: domain.ErrPVZHasOpenReception,
+		},
+		{
+			name: "reception opened concurrently (unique index)",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				pvzID := uuid.New()
+
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(closed(receptionID, pvzID), nil).Times(2)
+				m.MockPvzRepo.EXPECT().GetForUpdate(ctx, pvzID).Return(&domain.PVZ{ID: pvzID, Status: domain.PVZStatusActive}, nil).Times(1)
+				m.MockReceptionRepo.EXPECT().FindOpen(ctx, domain.Reception{PvzID: pvzID}).Return(nil, infra.ErrNotFound).Times(1)
+				m.MockReceptionStatusRepo.EXPECT().Get(ctx, gomock.Any()).Return(&domain.ReceptionStatus{ID: uuid.New()}, nil).Times(1)
+				m.MockReceptionRepo.EXPECT().Update(ctx, receptionID, gomock.Any()).Return(nil, infra.ErrDuplicate).Times(1)
+			},
+			wantErr: domain.ErrPVZHasOpenReception,
+		},
+		{
+			name: "barcode rescanned in another open reception",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				pvzID := uuid.New()
+				barcodeErr := &infra.ConstraintError{Err: infra.ErrDuplicate, Constraint: "idx_products_open_barcode"}
+
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(closed(receptionID, pvzID), nil).Times(2)
+				m.MockPvzRepo.EXPECT().GetForUpdate(ctx, pvzID).Return(&domain.PVZ{ID: pvzID, Status: domain.PVZStatusActive}, nil).Times(1)
+				m.MockReceptionRepo.EXPECT().FindOpen(ctx, domain.Reception{PvzID: pvzID}).Return(nil, infra.ErrNotFound).Times(1)
+				m.MockReceptionStatusRepo.EXPECT().Get(ctx, gomock.Any()).Return(&domain.ReceptionStatus{ID: uuid.New()}, nil).Times(1)
+				m.MockReceptionRepo.EXPECT().Update(ctx, receptionID, gomock.Any()).Return(nil, barcodeErr).Times(1)
+			},
+			wantErr: domain.ErrReceptionBarcodeConflict,
+		},
+		{
+			name: "reception in closed pvz can not be reopened",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				pvzID := uuid.New()
+
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(closed(receptionID, pvzID), nil).Times(2)
+				m.MockPvzRepo.EXPECT().GetForUpdate(ctx, pvzID).Return(&domain.PVZ{ID: pvzID, Status: domain.PVZStatusClosed}, nil).Times(1)
+			},
+			wantErr: domain.ErrPVZNotActive,
+		},
+		{
+			name: "cancelled reception can not be reopened",
+			mockFn: func(receptionID uuid.UUID, m *receptionMocks) {
+				pvzID := uuid.New()
+				cancelled := &domain.Reception{
+					ID:              receptionID,
+					PvzID:           pvzID,
+					ReceptionStatus: &domain.ReceptionStatus{Name: domain.ReceptionStatusCancelled},
+				}
+
+				m.MockReceptionRepo.EXPECT().GetWithStatus(ctx, receptionID).Return(cancelled, nil).Times(2)
+				m.MockPvzRepo.EXPECT().GetForUpdate(ctx, pvzID).Return(&domain.PVZ{ID: pvzID, Status: domain.PVZStatusActive}, nil).Times(1)
+			},
+			wantErr: domain.ErrInvalidReceptionTransition,
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			receptionID := uuid.New()
+
+			receptionMocks := newReceptionMocks(t)
+			tt.mockFn(receptionID, receptionMocks)
+
+			useCase := New(
+				receptionMocks.MockReceptionRepo,
+				receptionMocks.MockReceptionStatusRepo,
+				receptionMocks.MockPvzRepo,
+				receptionMocks.MockProductRepo,
+				receptionMocks.MockTransitionRepo,
+				receptionMocks.MockTxManager,
+				receptionMocks.MockAuditRecorder,
+				receptionMocks.MockEventEmitter,
+			)
+
+			res, err := useCase.Reopen(ctx, dto.ReceptionTransition{ReceptionID: receptionID, ActorID: actorID})
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Nil(t, res)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, domain.ReceptionStatusReopened, res.ReceptionStatus.Name)
+		})
+	}
+}
+
+func TestReceptionUseCase_Export(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	start := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	errStop := errors.New("client gone")
+
+	type fields struct {
+		name     string
+		req      dto.ReceptionExport
+		mockFn   func(f fields, m *receptionMocks)
+		fnErr    error
+		wantRows int
+		wantErr  error
+	}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			req:  dto.ReceptionExport{StartDate: &start, EndDate: &end},
+			mockFn: func(f fields, m *receptionMocks) {
+				m.MockReceptionRepo.EXPECT().
+					Export(ctx, f.req.StartDate, f.req.EndDate, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, startDate, endDate *time.Time, fn func(domain.ReceptionExportRow) error) error {
+						for range 2 {
+							if err := fn(domain.ReceptionExportRow{ReceptionID: uuid.New()}); err != nil {
+								return err
+							}
+						}
+						return nil
+					}).
+					Times(1)
+			},
+			wantRows: 2,
+		},
+		{
+			name: "open period",
+			req:  dto.ReceptionExport{},
+			mockFn: func(f fields, m *receptionMocks) {
+				m.MockReceptionRepo.EXPECT().
+					Export(ctx, nil, nil, gomock.Any()).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name:    "start after end",
+			req:     dto.ReceptionExport{StartDate: &end, EndDate: &start},
+			mockFn:  func(f fields, m *receptionMocks) {},
+			wantErr: domain.ErrInvalidExportPeriod,
+		},
+		{
+			name: "fn error stops export",
+			req:  dto.ReceptionExport{},
+			mockFn: func(f fields, m *receptionMocks) {
+				m.MockReceptionRepo.EXPECT().
+					Export(ctx, nil, nil, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, startDate, endDate *time.Time, fn func(domain.ReceptionExportRow) error) error {
+						return fn(domain.ReceptionExportRow{ReceptionID: uuid.New()})
+					}).
+					Times(1)
+			},
+			fnErr:    errStop,
+			wantRows: 1,
+			wantErr:  errStop,
+		},
+		{
+			name: "repo error",
+			req:  dto.ReceptionExport{},
+			mockFn: func(f fields, m *receptionMocks) {
+				m.MockReceptionRepo.EXPECT().
+					Export(ctx, nil, nil, gomock.Any()).
+					Return(errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			receptionMocks := newReceptionMocks(t)
+			tt.mockFn(tt, receptionMocks)
+
+			useCase := New(
+				receptionMocks.MockReceptionRepo,
+				receptionMocks.MockReceptionStatusRepo,
+				receptionMocks.MockPvzRepo,
+				receptionMocks.MockProductRepo,
+				receptionMocks.MockTransitionRepo,
+				receptionMocks.MockTxManager,
+				receptionMocks.MockAuditRecorder,
+				receptionMocks.MockEventEmitter,
+			)
+
+			rows := 0
+			err := useCase.Export(ctx, tt.req, func(domain.ReceptionExportRow) error {
+				rows++
+				return tt.fnErr
+			})
+
+			require.Equal(t, tt.wantRows, rows)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package watch
+
+import (
+	"sync"
+
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+// Bus рассылает события PVZ подписчикам внутри процесса.
+// Publish никогда не блокируется: подписчик, у которого переполнился буфер,
+// отключается с domain.ErrSlowConsumer и должен переподключиться.
+type Bus struct {
+	bufferSize int
+
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+func NewBus(bufferSize int) *Bus {
+	return &Bus{
+		bufferSize: bufferSize,
+		subs:       make(map[*Subscription]struct{}),
+	}
+}
+
+type Subscription struct {
+	filter domain.PVZEventFilter
+	events chan domain.PVZEvent
+	done   chan struct{}
+	err    error
+}
+
+// Events канал событий подписки. Читать его нужно вместе с Done.
+func (s *Subscription) Events() <-chan domain.PVZEvent {
+	return s.events
+}
+
+// Done закрывается, когда подписка отключена шиной или через Unsubscribe.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err причина отключения после закрытия Done, nil при Unsubscribe и Close.
+func (s *Subscription) Err() error {
+	<-s.done
+	return s.err
+}
+
+func (b *Bus) Subscribe(filter domain.PVZEventFilter) *Subscription {
+	sub := &Subscription{
+		filter: filter,
+		events: make(chan domain.PVZEvent, b.bufferSize),
+		done:   make(chan struct{}),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subs[sub] = struct{}{}
+	// после Close подписка сразу закрыта, чтобы стрим не висел до остановки сервера
+	if b.closed {
+		b.remove(sub, nil)
+	}
+
+	return sub
+}
+
+func (b *Bus) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.remove(sub, nil)
+}
+
+// Publish отправляет событие всем подходящим подписчикам.
+func (b *Bus) Publish(event domain.PVZEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs {
+		if !sub.filter.Match(event) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			b.remove(sub, domain.ErrSlowConsumer)
+		}
+	}
+}
+
+// Close отключает всех подписчиков без ошибки, используется при остановке сервиса.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subs {
+		b.remove(sub, nil)
+	}
+}
+
+// Subscribers число активных подписок.
+func (b *Bus) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subs)
+}
+
+func (b *Bus) remove(sub *Subscription, err error) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+
+	sub.err = err
+	close(sub.done)
+}
@@ -0,0 +1,95 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+)
+
+func TestBus_PublishFilter(t *testing.T) {
+	t.Parallel()
+
+	bus := NewBus(4)
+
+	pvzID := uuid.New()
+	byPvz := bus.Subscribe(domain.PVZEventFilter{PvzIDs: []uuid.UUID{pvzID}})
+	byCity := bus.Subscribe(domain.PVZEventFilter{City: "Казань"})
+	all := bus.Subscribe(domain.PVZEventFilter{})
+
+	first := domain.PVZEvent{ID: uuid.New(), PvzID: pvzID, City: "Москва"}
+	second := domain.PVZEvent{ID: uuid.New(), PvzID: uuid.New(), City: "Казань"}
+	bus.Publish(first)
+	bus.Publish(second)
+
+	assert.Equal(t, []domain.PVZEvent{first}, drain(byPvz))
+	assert.Equal(t, []domain.PVZEvent{second}, drain(byCity))
+	assert.Equal(t, []domain.PVZEvent{first, second}, drain(all))
+}
+
+func TestBus_SlowConsumer(t *testing.T) {
+	t.Parallel()
+
+	bus := NewBus(1)
+
+	slow := bus.Subscribe(domain.PVZEventFilter{})
+	fast := bus.Subscribe(domain.PVZEventFilter{})
+
+	bus.Publish(domain.PVZEvent{ID: uuid.New()})
+	require.Len(t, drain(fast), 1)
+
+	// буфер slow заполнен, второе событие его отключает, но не задерживает fast
+	bus.Publish(domain.PVZEvent{ID: uuid.New()})
+
+	require.ErrorIs(t, slow.Err(), domain.ErrSlowConsumer)
+	assert.Len(t, drain(fast), 1)
+	assert.Equal(t, 1, bus.Subscribers())
+}
+
+func TestBus_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	bus := NewBus(1)
+
+	sub := bus.Subscribe(domain.PVZEventFilter{})
+	bus.Unsubscribe(sub)
+	// повторная отписка безопасна
+	bus.Unsubscribe(sub)
+
+	require.NoError(t, sub.Err())
+	assert.Equal(t, 0, bus.Subscribers())
+
+	bus.Publish(domain.PVZEvent{ID: uuid.New()})
+	assert.Empty(t, drain(sub))
+}
+
+func drain(sub *Subscription) []domain.PVZEvent {
+	var events []domain.PVZEvent
+	for {
+		select {
+		case event := <-sub.Events():
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestBus_Close(t *testing.T) {
+	t.Parallel()
+
+	bus := NewBus(1)
+
+	sub := bus.Subscribe(domain.PVZEventFilter{})
+	bus.Close()
+
+	require.NoError(t, sub.Err())
+	assert.Equal(t, 0, bus.Subscribers())
+
+	// подписка после Close сразу закрыта
+	late := bus.Subscribe(domain.PVZEventFilter{})
+	require.NoError(t, late.Err())
+	assert.Equal(t, 0, bus.Subscribers())
+}
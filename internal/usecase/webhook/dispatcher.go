@@ -0,0 +1,157 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/pkg/logger"
+)
+
+type DispatcherConfig struct {
+	Interval    time.Duration
+	BatchSize   uint64
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// LeaseTimeout на сколько доставка закрепляется за экземпляром dispatcher,
+	// должен покрывать отправку всей пачки
+	LeaseTimeout time.Duration
+}
+
+// Dispatcher отправляет поставленные в очередь доставки подписчикам.
+// Неудачная попытка откладывается с экспоненциальной задержкой,
+// после MaxAttempts доставка помечается failed.
+type Dispatcher struct {
+	deliveryRepo deliveryRepo
+	sender       sender
+	cfg          DispatcherConfig
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewDispatcher(deliveryRepo deliveryRepo, sender sender, cfg DispatcherConfig) *Dispatcher {
+	return &Dispatcher{
+		deliveryRepo: deliveryRepo,
+		sender:       sender,
+		cfg:          cfg,
+	}
+}
+
+// Start запускает фоновую горутину. Остановка через Stop или отмену ctx.
+func (d *Dispatcher) Start(ctx context.Context) {
+	ctx, d.cancel = context.WithCancel(ctx)
+	d.done = make(chan struct{})
+
+	go d.run(ctx)
+}
+
+// Stop останавливает dispatcher и ждёт завершения текущей пачки.
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+	d.cancel()
+
+	select {
+	case <-d.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	defer close(d.done)
+
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.ProcessBatch(ctx); err != nil && ctx.Err() == nil {
+			logger.ErrorCtx(ctx, "webhook dispatcher: failed to process batch", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch делает по одной попытке для пачки доставок и возвращает число успешных.
+// Доставки берутся в аренду, отправляются вне транзакции, а результат каждой попытки
+// сохраняется отдельным запросом: ошибка сохранения одной доставки не откатывает остальные.
+func (d *Dispatcher) ProcessBatch(ctx context.Context) (int, error) {
+	const op = "webhook.ProcessBatch"
+
+	deliveries, err := d.deliveryRepo.ClaimDue(ctx, d.cfg.BatchSize, d.cfg.LeaseTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to claim deliveries: %w", op, err)
+	}
+
+	var delivered int
+	var errs []error
+
+	for _, delivery := range deliveries {
+		update := d.attempt(ctx, delivery)
+
+		if err := d.deliveryRepo.UpdateAttempt(ctx, delivery.ID, update); err != nil {
+			errs = append(errs, fmt.Errorf("%s: failed to save attempt: %w", op, err))
+			continue
+		}
+
+		if update.Status == domain.WebhookDeliveryDelivered {
+			delivered++
+		}
+	}
+
+	return delivered, errors.Join(errs...)
+}
+
+func (d *Dispatcher) attempt(ctx context.Context, delivery *domain.WebhookDelivery) domain.WebhookDelivery {
+	now := time.Now()
+
+	update := domain.WebhookDelivery{
+		Status:        domain.WebhookDeliveryPending,
+		Attempts:      delivery.Attempts + 1,
+		NextAttemptAt: now,
+	}
+
+	statusCode, err := d.sender.Send(ctx, *delivery.Subscription, delivery.Event)
+	update.LastStatusCode = statusCode
+
+	if err == nil {
+		update.Status = domain.WebhookDeliveryDelivered
+		update.DeliveredAt = &now
+		return update
+	}
+
+	update.LastError = err.Error()
+	logger.WarnCtx(ctx, "webhook dispatcher: delivery failed",
+		"deliveryId", delivery.ID, "subscriptionId", delivery.SubscriptionID, "attempt", update.Attempts, "error", err)
+
+	if update.Attempts >= d.cfg.MaxAttempts {
+		update.Status = domain.WebhookDeliveryFailed
+		return update
+	}
+
+	update.NextAttemptAt = now.Add(d.backoff(update.Attempts))
+	return update
+}
+
+// backoff возвращает задержку перед следующей попыткой: base, 2*base, 4*base... но не больше max.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.cfg.BackoffBase
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= d.cfg.BackoffMax {
+			return d.cfg.BackoffMax
+		}
+	}
+	return delay
+}
@@ -0,0 +1,210 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/webhook/mocks"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+type dispatcherMocks struct {
+	MockDeliveryRepo *mocks.MockdeliveryRepo
+	MockSender       *mocks.Mocksender
+}
+
+func newDispatcherMocks(t *testing.T) *dispatcherMocks {
+	ctrl := gomock.NewController(t)
+
+	return &dispatcherMocks{
+		MockDeliveryRepo: mocks.NewMockdeliveryRepo(ctrl),
+		MockSender:       mocks.NewMocksender(ctrl),
+	}
+}
+
+var testDispatcherConfig = DispatcherConfig{
+	Interval:     time.Second,
+	BatchSize:    10,
+	MaxAttempts:  3,
+	BackoffBase:  time.Second,
+	BackoffMax:   3 * time.Second,
+	LeaseTimeout: time.Minute,
+}
+
+func TestDispatcher_ProcessBatch(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	subscription := &domain.WebhookSubscription{ID: uuid.New(), URL: "https://partner.example/hook", Secret: "secret"}
+	newDelivery := func(attempts int) *domain.WebhookDelivery {
+		return &domain.WebhookDelivery{
+			ID:             uuid.New(),
+			SubscriptionID: subscription.ID,
+			Event:          domain.OutboxEvent{ID: uuid.New(), Type: domain.EventReceptionClosed},
+			Status:         domain.WebhookDeliveryPending,
+			Attempts:       attempts,
+			Subscription:   subscription,
+		}
+	}
+
+	type fields struct {
+		name          string
+		mockFn        func(m *dispatcherMocks)
+		wantDelivered int
+		wantErr       error
+	}
+
+	testcases := []fields{
+		{
+			name: "delivered",
+			mockFn: func(m *dispatcherMocks) {
+				delivery := newDelivery(0)
+
+				m.MockDeliveryRepo.EXPECT().
+					ClaimDue(ctx, testDispatcherConfig.BatchSize, testDispatcherConfig.LeaseTimeout).
+					Return([]*domain.WebhookDelivery{delivery}, nil).
+					Times(1)
+				m.MockSender.EXPECT().Send(ctx, *subscription, delivery.Event).Return(200, nil).Times(1)
+				m.MockDeliveryRepo.EXPECT().
+					UpdateAttempt(ctx, delivery.ID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, update domain.WebhookDelivery) error {
+						require.Equal(t, domain.WebhookDeliveryDelivered, update.Status)
+						require.Equal(t, 1, update.Attempts)
+						require.Equal(t, 200, update.LastStatusCode)
+						require.NotNil(t, update.DeliveredAt)
+						return nil
+					}).
+					Times(1)
+			},
+			wantDelivered: 1,
+		},
+		{
+			name: "failed attempt is retried with backoff",
+			mockFn: func(m *dispatcherMocks) {
+				delivery := newDelivery(1)
+
+				m.MockDeliveryRepo.EXPECT().
+					ClaimDue(ctx, testDispatcherConfig.BatchSize, testDispatcherConfig.LeaseTimeout).
+					Return([]*domain.WebhookDelivery{delivery}, nil).
+					Times(1)
+				m.MockSender.EXPECT().Send(ctx, *subscription, delivery.Event).Return(503, errors.New("webhook responded with status 503")).Times(1)
+				m.MockDeliveryRepo.EXPECT().
+					UpdateAttempt(ctx, delivery.ID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, update domain.WebhookDelivery) error {
+						require.Equal(t, domain.WebhookDeliveryPending, update.Status)
+						require.Equal(t, 2, update.Attempts)
+						require.Equal(t, 503, update.LastStatusCode)
+						require.Equal(t, "webhook responded with status 503", update.LastError)
+						require.WithinDuration(t, time.Now().Add(2*time.Second), update.NextAttemptAt, time.Second)
+						require.Nil(t, update.DeliveredAt)
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "last attempt marks failed",
+			mockFn: func(m *dispatcherMocks) {
+				delivery := newDelivery(testDispatcherConfig.MaxAttempts - 1)
+
+				m.MockDeliveryRepo.EXPECT().
+					ClaimDue(ctx, testDispatcherConfig.BatchSize, testDispatcherConfig.LeaseTimeout).
+					Return([]*domain.WebhookDelivery{delivery}, nil).
+					Times(1)
+				m.MockSender.EXPECT().Send(ctx, *subscription, delivery.Event).Return(0, errors.New("connection refused")).Times(1)
+				m.MockDeliveryRepo.EXPECT().
+					UpdateAttempt(ctx, delivery.ID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, update domain.WebhookDelivery) error {
+						require.Equal(t, domain.WebhookDeliveryFailed, update.Status)
+						require.Equal(t, testDispatcherConfig.MaxAttempts, update.Attempts)
+						return nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "claim error",
+			mockFn: func(m *dispatcherMocks) {
+				m.MockDeliveryRepo.EXPECT().
+					ClaimDue(ctx, testDispatcherConfig.BatchSize, testDispatcherConfig.LeaseTimeout).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("webhook.ProcessBatch: failed to claim deliveries: db error"),
+		},
+		{
+			name: "save attempt error",
+			mockFn: func(m *dispatcherMocks) {
+				delivery := newDelivery(0)
+
+				m.MockDeliveryRepo.EXPECT().
+					ClaimDue(ctx, testDispatcherConfig.BatchSize, testDispatcherConfig.LeaseTimeout).
+					Return([]*domain.WebhookDelivery{delivery}, nil).
+					Times(1)
+				m.MockSender.EXPECT().Send(ctx, *subscription, delivery.Event).Return(200, nil).Times(1)
+				m.MockDeliveryRepo.EXPECT().UpdateAttempt(ctx, delivery.ID, gomock.Any()).Return(errors.New("db error")).Times(1)
+			},
+			wantErr: errors.New("webhook.ProcessBatch: failed to save attempt: db error"),
+		},
+		{
+			name: "save attempt error does not stop other deliveries",
+			mockFn: func(m *dispatcherMocks) {
+				first := newDelivery(0)
+				second := newDelivery(0)
+
+				m.MockDeliveryRepo.EXPECT().
+					ClaimDue(ctx, testDispatcherConfig.BatchSize, testDispatcherConfig.LeaseTimeout).
+					Return([]*domain.WebhookDelivery{first, second}, nil).
+					Times(1)
+				m.MockSender.EXPECT().Send(ctx, *subscription, first.Event).Return(200, nil).Times(1)
+				m.MockDeliveryRepo.EXPECT().UpdateAttempt(ctx, first.ID, gomock.Any()).Return(errors.New("db error")).Times(1)
+				m.MockSender.EXPECT().Send(ctx, *subscription, second.Event).Return(200, nil).Times(1)
+				m.MockDeliveryRepo.EXPECT().UpdateAttempt(ctx, second.ID, gomock.Any()).Return(nil).Times(1)
+			},
+			wantDelivered: 1,
+			wantErr:       errors.New("webhook.ProcessBatch: failed to save attempt: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dispatcherMocks := newDispatcherMocks(t)
+			tt.mockFn(dispatcherMocks)
+
+			dispatcher := NewDispatcher(dispatcherMocks.MockDeliveryRepo, dispatcherMocks.MockSender, testDispatcherConfig)
+
+			delivered, err := dispatcher.ProcessBatch(ctx)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				require.Equal(t, tt.wantDelivered, delivered)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantDelivered, delivered)
+		})
+	}
+}
+
+func TestDispatcher_backoff(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := NewDispatcher(nil, nil, testDispatcherConfig)
+
+	require.Equal(t, time.Second, dispatcher.backoff(1))
+	require.Equal(t, 2*time.Second, dispatcher.backoff(2))
+	require.Equal(t, 3*time.Second, dispatcher.backoff(3))
+	require.Equal(t, 3*time.Second, dispatcher.backoff(10))
+}
@@ -0,0 +1,240 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook.go
+//
+// Generated by this command:
+//
+//	mockgen -source=webhook.go -destination=./mocks/webhook_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	domain "github.com/valeragav/avito-pvz-service/internal/domain"
+	listparams "github.com/valeragav/avito-pvz-service/pkg/listparams"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocksubscriptionRepo is a mock of subscriptionRepo interface.
+type MocksubscriptionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MocksubscriptionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MocksubscriptionRepoMockRecorder is the mock recorder for MocksubscriptionRepo.
+type MocksubscriptionRepoMockRecorder struct {
+	mock *MocksubscriptionRepo
+}
+
+// NewMocksubscriptionRepo creates a new mock instance.
+func NewMocksubscriptionRepo(ctrl *gomock.Controller) *MocksubscriptionRepo {
+	mock := &MocksubscriptionRepo{ctrl: ctrl}
+	mock.recorder = &MocksubscriptionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksubscriptionRepo) EXPECT() *MocksubscriptionRepoMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MocksubscriptionRepo) Create(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, subscription)
+	ret0, _ := ret[0].(*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MocksubscriptionRepoMockRecorder) Create(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MocksubscriptionRepo)(nil).Create), ctx, subscription)
+}
+
+// Delete mocks base method.
+func (m *MocksubscriptionRepo) Delete(ctx context.Context, subscriptionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MocksubscriptionRepoMockRecorder) Delete(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocksubscriptionRepo)(nil).Delete), ctx, subscriptionID)
+}
+
+// Get mocks base method.
+func (m *MocksubscriptionRepo) Get(ctx context.Context, subscriptionID uuid.UUID) (*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, subscriptionID)
+	ret0, _ := ret[0].(*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MocksubscriptionRepoMockRecorder) Get(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocksubscriptionRepo)(nil).Get), ctx, subscriptionID)
+}
+
+// List mocks base method.
+func (m *MocksubscriptionRepo) List(ctx context.Context, pagination *listparams.Pagination) ([]*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, pagination)
+	ret0, _ := ret[0].([]*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MocksubscriptionRepoMockRecorder) List(ctx, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MocksubscriptionRepo)(nil).List), ctx, pagination)
+}
+
+// ListByEventType mocks base method.
+func (m *MocksubscriptionRepo) ListByEventType(ctx context.Context, eventType domain.EventType) ([]*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByEventType", ctx, eventType)
+	ret0, _ := ret[0].([]*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByEventType indicates an expected call of ListByEventType.
+func (mr *MocksubscriptionRepoMockRecorder) ListByEventType(ctx, eventType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByEventType", reflect.TypeOf((*MocksubscriptionRepo)(nil).ListByEventType), ctx, eventType)
+}
+
+// MockdeliveryRepo is a mock of deliveryRepo interface.
+type MockdeliveryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeliveryRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockdeliveryRepoMockRecorder is the mock recorder for MockdeliveryRepo.
+type MockdeliveryRepoMockRecorder struct {
+	mock *MockdeliveryRepo
+}
+
+// NewMockdeliveryRepo creates a new mock instance.
+func NewMockdeliveryRepo(ctrl *gomock.Controller) *MockdeliveryRepo {
+	mock := &MockdeliveryRepo{ctrl: ctrl}
+	mock.recorder = &MockdeliveryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeliveryRepo) EXPECT() *MockdeliveryRepoMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockdeliveryRepo) ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, limit, lease)
+	ret0, _ := ret[0].([]*domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockdeliveryRepoMockRecorder) ClaimDue(ctx, limit, lease any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockdeliveryRepo)(nil).ClaimDue), ctx, limit, lease)
+}
+
+// Create mocks base method.
+func (m *MockdeliveryRepo) Create(ctx context.Context, delivery domain.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockdeliveryRepoMockRecorder) Create(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockdeliveryRepo)(nil).Create), ctx, delivery)
+}
+
+// ListBySubscription mocks base method.
+func (m *MockdeliveryRepo) ListBySubscription(ctx context.Context, subscriptionID uuid.UUID, pagination *listparams.Pagination) ([]*domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySubscription", ctx, subscriptionID, pagination)
+	ret0, _ := ret[0].([]*domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySubscription indicates an expected call of ListBySubscription.
+func (mr *MockdeliveryRepoMockRecorder) ListBySubscription(ctx, subscriptionID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySubscription", reflect.TypeOf((*MockdeliveryRepo)(nil).ListBySubscription), ctx, subscriptionID, pagination)
+}
+
+// UpdateAttempt mocks base method.
+func (m *MockdeliveryRepo) UpdateAttempt(ctx context.Context, deliveryID uuid.UUID, update domain.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttempt", ctx, deliveryID, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttempt indicates an expected call of UpdateAttempt.
+func (mr *MockdeliveryRepoMockRecorder) UpdateAttempt(ctx, deliveryID, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttempt", reflect.TypeOf((*MockdeliveryRepo)(nil).UpdateAttempt), ctx, deliveryID, update)
+}
+
+// Mocksender is a mock of sender interface.
+type Mocksender struct {
+	ctrl     *gomock.Controller
+	recorder *MocksenderMockRecorder
+	isgomock struct{}
+}
+
+// MocksenderMockRecorder is the mock recorder for Mocksender.
+type MocksenderMockRecorder struct {
+	mock *Mocksender
+}
+
+// NewMocksender creates a new mock instance.
+func NewMocksender(ctrl *gomock.Controller) *Mocksender {
+	mock := &Mocksender{ctrl: ctrl}
+	mock.recorder = &MocksenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocksender) EXPECT() *MocksenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *Mocksender) Send(ctx context.Context, subscription domain.WebhookSubscription, event domain.OutboxEvent) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, subscription, event)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MocksenderMockRecorder) Send(ctx, subscription, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*Mocksender)(nil).Send), ctx, subscription, event)
+}
@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+)
+
+//go:generate ${LOCAL_BIN}/mockgen -source=webhook.go -destination=./mocks/webhook_mock.go -package=mocks
+type subscriptionRepo interface {
+	Create(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	Get(ctx context.Context, subscriptionID uuid.UUID) (*domain.WebhookSubscription, error)
+	List(ctx context.Context, pagination *listparams.Pagination) ([]*domain.WebhookSubscription, error)
+	ListByEventType(ctx context.Context, eventType domain.EventType) ([]*domain.WebhookSubscription, error)
+	Delete(ctx context.Context, subscriptionID uuid.UUID) error
+}
+
+type deliveryRepo interface {
+	Create(ctx context.Context, delivery domain.WebhookDelivery) error
+	ClaimDue(ctx context.Context, limit uint64, lease time.Duration) ([]*domain.WebhookDelivery, error)
+	UpdateAttempt(ctx context.Context, deliveryID uuid.UUID, update domain.WebhookDelivery) error
+	ListBySubscription(ctx context.Context, subscriptionID uuid.UUID, pagination *listparams.Pagination) ([]*domain.WebhookDelivery, error)
+}
+
+type sender interface {
+	Send(ctx context.Context, subscription domain.WebhookSubscription, event domain.OutboxEvent) (int, error)
+}
+
+const secretBytes = 32
+
+type WebhookUseCase struct {
+	subscriptionRepo subscriptionRepo
+	deliveryRepo     deliveryRepo
+}
+
+func New(subscriptionRepo subscriptionRepo, deliveryRepo deliveryRepo) *WebhookUseCase {
+	return &WebhookUseCase{
+		subscriptionRepo,
+		deliveryRepo,
+	}
+}
+
+// CreateSubscription регистрирует подписку. Если секрет не передан, он генерируется
+// и возвращается в ответе, позже его получить нельзя.
+func (s *WebhookUseCase) CreateSubscription(ctx context.Context, createIn dto.WebhookSubscriptionCreate) (*domain.WebhookSubscription, error) {
+	const op = "webhook.CreateSubscription"
+
+	for _, t := range createIn.EventTypes {
+		if !t.IsValid() {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidEventType, t)
+		}
+	}
+
+	secret := createIn.Secret
+	if secret == "" {
+		b := make([]byte, secretBytes)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("%s: failed to generate secret: %w", op, err)
+		}
+		secret = hex.EncodeToString(b)
+	}
+
+	subscription, err := s.subscriptionRepo.Create(ctx, domain.WebhookSubscription{
+		URL:        createIn.URL,
+		Secret:     secret,
+		EventTypes: createIn.EventTypes,
+		CreatedBy:  createIn.CreatedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to create subscription: %w", op, err)
+	}
+
+	return subscription, nil
+}
+
+func (s *WebhookUseCase) ListSubscriptions(ctx context.Context, pagination *listparams.Pagination) ([]*domain.WebhookSubscription, error) {
+	const op = "webhook.ListSubscriptions"
+
+	subscriptions, err := s.subscriptionRepo.List(ctx, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list subscriptions: %w", op, err)
+	}
+
+	return subscriptions, nil
+}
+
+func (s *WebhookUseCase) DeleteSubscription(ctx context.Context, subscriptionID uuid.UUID) error {
+	const op = "webhook.DeleteSubscription"
+
+	err := s.subscriptionRepo.Delete(ctx, subscriptionID)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return domain.ErrWebhookSubscriptionNotFound
+		}
+		return fmt.Errorf("%s: failed to delete subscription: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *WebhookUseCase) ListDeliveries(ctx context.Context, params dto.WebhookDeliveryListParams) ([]*domain.WebhookDelivery, error) {
+	const op = "webhook.ListDeliveries"
+
+	_, err := s.subscriptionRepo.Get(ctx, params.SubscriptionID)
+	if err != nil {
+		if errors.Is(err, infra.ErrNotFound) {
+			return nil, domain.ErrWebhookSubscriptionNotFound
+		}
+		return nil, fmt.Errorf("%s: failed to get subscription: %w", op, err)
+	}
+
+	deliveries, err := s.deliveryRepo.ListBySubscription(ctx, params.SubscriptionID, params.Pagination)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list deliveries: %w", op, err)
+	}
+
+	return deliveries, nil
+}
+
+// Publish ставит событие в очередь доставки каждой подписке на его тип.
+// Вызывается outbox relay, сама отправка выполняется Dispatcher.
+func (s *WebhookUseCase) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	const op = "webhook.Publish"
+
+	subscriptions, err := s.subscriptionRepo.ListByEventType(ctx, event.Type)
+	if err != nil {
+		return fmt.Errorf("%s: failed to list subscriptions: %w", op, err)
+	}
+
+	for _, subscription := range subscriptions {
+		err = s.deliveryRepo.Create(ctx, domain.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			Event:          event,
+			Status:         domain.WebhookDeliveryPending,
+		})
+		if err != nil {
+			return fmt.Errorf("%s: failed to enqueue delivery: %w", op, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,347 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/dto"
+	"github.com/valeragav/avito-pvz-service/internal/usecase/webhook/mocks"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+	"github.com/valeragav/avito-pvz-service/pkg/testutils"
+	"go.uber.org/mock/gomock"
+)
+
+type webhookMocks struct {
+	MockSubscriptionRepo *mocks.MocksubscriptionRepo
+	MockDeliveryRepo     *mocks.MockdeliveryRepo
+}
+
+func newWebhookMocks(t *testing.T) *webhookMocks {
+	ctrl := gomock.NewController(t)
+
+	return &webhookMocks{
+		MockSubscriptionRepo: mocks.NewMocksubscriptionRepo(ctrl),
+		MockDeliveryRepo:     mocks.NewMockdeliveryRepo(ctrl),
+	}
+}
+
+func TestWebhookUseCase_CreateSubscription(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	createdBy := uuid.New()
+
+	type fields struct {
+		name     string
+		createIn dto.WebhookSubscriptionCreate
+		mockFn   func(m *webhookMocks)
+		wantErr  error
+	}
+
+	testcases := []fields{
+		{
+			name: "explicit secret",
+			createIn: dto.WebhookSubscriptionCreate{
+				URL:        "https://partner.example/hook",
+				Secret:     "0123456789abcdef",
+				EventTypes: []domain.EventType{domain.EventReceptionClosed},
+				CreatedBy:  createdBy,
+			},
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().
+					Create(ctx, domain.WebhookSubscription{
+						URL:        "https://partner.example/hook",
+						Secret:     "0123456789abcdef",
+						EventTypes: []domain.EventType{domain.EventReceptionClosed},
+						CreatedBy:  createdBy,
+					}).
+					DoAndReturn(func(_ context.Context, s domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+						return &s, nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "generated secret",
+			createIn: dto.WebhookSubscriptionCreate{
+				URL:        "https://partner.example/hook",
+				EventTypes: []domain.EventType{domain.EventReceptionClosed},
+				CreatedBy:  createdBy,
+			},
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, s domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+						require.Len(t, s.Secret, 2*secretBytes)
+						return &s, nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "invalid event type",
+			createIn: dto.WebhookSubscriptionCreate{
+				URL:        "https://partner.example/hook",
+				EventTypes: []domain.EventType{"Unknown"},
+			},
+			mockFn:  func(m *webhookMocks) {},
+			wantErr: domain.ErrInvalidEventType,
+		},
+		{
+			name: "repo error",
+			createIn: dto.WebhookSubscriptionCreate{
+				URL:        "https://partner.example/hook",
+				EventTypes: []domain.EventType{domain.EventReceptionClosed},
+			},
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(nil, errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("webhook.CreateSubscription: failed to create subscription: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhookMocks := newWebhookMocks(t)
+			tt.mockFn(webhookMocks)
+
+			webhookUseCase := New(webhookMocks.MockSubscriptionRepo, webhookMocks.MockDeliveryRepo)
+
+			got, err := webhookUseCase.CreateSubscription(ctx, tt.createIn)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.createIn.URL, got.URL)
+			require.NotEmpty(t, got.Secret)
+		})
+	}
+}
+
+func TestWebhookUseCase_DeleteSubscription(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	subscriptionID := uuid.New()
+
+	type fields struct {
+		name    string
+		mockFn  func(m *webhookMocks)
+		wantErr error
+	}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().Delete(ctx, subscriptionID).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "not found",
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().Delete(ctx, subscriptionID).Return(infra.ErrNotFound).Times(1)
+			},
+			wantErr: domain.ErrWebhookSubscriptionNotFound,
+		},
+		{
+			name: "repo error",
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().Delete(ctx, subscriptionID).Return(errors.New("db error")).Times(1)
+			},
+			wantErr: errors.New("webhook.DeleteSubscription: failed to delete subscription: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhookMocks := newWebhookMocks(t)
+			tt.mockFn(webhookMocks)
+
+			webhookUseCase := New(webhookMocks.MockSubscriptionRepo, webhookMocks.MockDeliveryRepo)
+
+			err := webhookUseCase.DeleteSubscription(ctx, subscriptionID)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWebhookUseCase_ListDeliveries(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	subscriptionID := uuid.New()
+	params := dto.WebhookDeliveryListParams{
+		SubscriptionID: subscriptionID,
+		Pagination:     &listparams.Pagination{Page: 1, Limit: 10},
+	}
+
+	type fields struct {
+		name    string
+		mockFn  func(m *webhookMocks)
+		want    []*domain.WebhookDelivery
+		wantErr error
+	}
+
+	deliveries := []*domain.WebhookDelivery{{ID: uuid.New(), SubscriptionID: subscriptionID}}
+
+	testcases := []fields{
+		{
+			name: "ok",
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().Get(ctx, subscriptionID).Return(&domain.WebhookSubscription{ID: subscriptionID}, nil).Times(1)
+				m.MockDeliveryRepo.EXPECT().ListBySubscription(ctx, subscriptionID, params.Pagination).Return(deliveries, nil).Times(1)
+			},
+			want: deliveries,
+		},
+		{
+			name: "subscription not found",
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().Get(ctx, subscriptionID).Return(nil, infra.ErrNotFound).Times(1)
+			},
+			wantErr: domain.ErrWebhookSubscriptionNotFound,
+		},
+		{
+			name: "list error",
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().Get(ctx, subscriptionID).Return(&domain.WebhookSubscription{ID: subscriptionID}, nil).Times(1)
+				m.MockDeliveryRepo.EXPECT().ListBySubscription(ctx, subscriptionID, params.Pagination).Return(nil, errors.New("db error")).Times(1)
+			},
+			wantErr: errors.New("webhook.ListDeliveries: failed to list deliveries: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhookMocks := newWebhookMocks(t)
+			tt.mockFn(webhookMocks)
+
+			webhookUseCase := New(webhookMocks.MockSubscriptionRepo, webhookMocks.MockDeliveryRepo)
+
+			got, err := webhookUseCase.ListDeliveries(ctx, params)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWebhookUseCase_Publish(t *testing.T) {
+	t.Parallel()
+
+	testutils.InitTestLogger()
+	ctx := context.Background()
+
+	event := domain.OutboxEvent{ID: uuid.New(), Type: domain.EventReceptionClosed, PvzID: uuid.New()}
+	first := &domain.WebhookSubscription{ID: uuid.New()}
+	second := &domain.WebhookSubscription{ID: uuid.New()}
+
+	type fields struct {
+		name    string
+		mockFn  func(m *webhookMocks)
+		wantErr error
+	}
+
+	testcases := []fields{
+		{
+			name: "delivery per subscription",
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().
+					ListByEventType(ctx, domain.EventReceptionClosed).
+					Return([]*domain.WebhookSubscription{first, second}, nil).
+					Times(1)
+
+				m.MockDeliveryRepo.EXPECT().
+					Create(ctx, domain.WebhookDelivery{SubscriptionID: first.ID, Event: event, Status: domain.WebhookDeliveryPending}).
+					Return(nil).
+					Times(1)
+				m.MockDeliveryRepo.EXPECT().
+					Create(ctx, domain.WebhookDelivery{SubscriptionID: second.ID, Event: event, Status: domain.WebhookDeliveryPending}).
+					Return(nil).
+					Times(1)
+			},
+		},
+		{
+			name: "no subscriptions",
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().
+					ListByEventType(ctx, domain.EventReceptionClosed).
+					Return(nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "enqueue error",
+			mockFn: func(m *webhookMocks) {
+				m.MockSubscriptionRepo.EXPECT().
+					ListByEventType(ctx, domain.EventReceptionClosed).
+					Return([]*domain.WebhookSubscription{first}, nil).
+					Times(1)
+
+				m.MockDeliveryRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(errors.New("db error")).
+					Times(1)
+			},
+			wantErr: errors.New("webhook.Publish: failed to enqueue delivery: db error"),
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhookMocks := newWebhookMocks(t)
+			tt.mockFn(webhookMocks)
+
+			webhookUseCase := New(webhookMocks.MockSubscriptionRepo, webhookMocks.MockDeliveryRepo)
+
+			err := webhookUseCase.Publish(ctx, event)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_receptions_pvz_in_progress;
+DROP TRIGGER IF EXISTS trg_receptions_set_in_progress ON receptions;
+DROP FUNCTION IF EXISTS receptions_set_in_progress;
+ALTER TABLE receptions DROP COLUMN IF EXISTS in_progress;
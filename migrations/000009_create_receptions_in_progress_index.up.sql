@@ -0,0 +1,19 @@
+ALTER TABLE receptions ADD COLUMN in_progress BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE OR REPLACE FUNCTION receptions_set_in_progress() RETURNS TRIGGER AS $$
+BEGIN
+  NEW.in_progress := EXISTS (
+    SELECT 1 FROM reception_statuses
+    WHERE reception_statuses.id = NEW.status_id AND reception_statuses.name = 'in_progress'
+  );
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_receptions_set_in_progress
+BEFORE INSERT OR UPDATE OF status_id ON receptions
+FOR EACH ROW EXECUTE FUNCTION receptions_set_in_progress();
+
+UPDATE receptions SET status_id = status_id;
+
+CREATE UNIQUE INDEX idx_receptions_pvz_in_progress ON receptions (pvz_id) WHERE in_progress;
@@ -0,0 +1,6 @@
+-- denylist jti отозванных access токенов, записи нужны только до истечения токена
+CREATE TABLE revoked_tokens (
+  jti UUID PRIMARY KEY,
+  expires_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE products DROP COLUMN IF EXISTS created_by;
+ALTER TABLE receptions DROP COLUMN IF EXISTS closed_by;
+ALTER TABLE receptions DROP COLUMN IF EXISTS created_by;
\ No newline at end of file
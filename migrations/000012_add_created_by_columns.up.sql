@@ -0,0 +1,4 @@
+-- без FK на users: dummyLogin выдаёт синтетические id, которых нет в таблице
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS created_by UUID;
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS closed_by UUID;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS created_by UUID;
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_audit_events_append_only ON audit_events;
+DROP FUNCTION IF EXISTS audit_events_deny_change;
+DROP TABLE IF EXISTS audit_events;
\ No newline at end of file
@@ -0,0 +1,26 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE TABLE audit_events (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4 (),
+  actor_id UUID,
+  action VARCHAR(64) NOT NULL,
+  entity_id UUID NOT NULL,
+  pvz_id UUID,
+  request_id VARCHAR(255),
+  before_state JSONB,
+  after_state JSONB,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_audit_events_pvz_id_created_at ON audit_events (pvz_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id ON audit_events (actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events (created_at DESC);
+
+-- журнал только дописывается
+CREATE OR REPLACE FUNCTION audit_events_deny_change() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_events_append_only
+BEFORE UPDATE OR DELETE ON audit_events
+FOR EACH ROW EXECUTE FUNCTION audit_events_deny_change();
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+CREATE TABLE outbox_events (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4 (),
+  event_type VARCHAR(64) NOT NULL,
+  aggregate_id UUID NOT NULL,
+  pvz_id UUID,
+  payload JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  published_at TIMESTAMPTZ,
+  attempts INT NOT NULL DEFAULT 0,
+  last_error TEXT
+);
+
+-- relay выбирает только неопубликованные события в порядке создания
+CREATE INDEX IF NOT EXISTS idx_outbox_events_unpublished ON outbox_events (created_at) WHERE published_at IS NULL;
\ No newline at end of file
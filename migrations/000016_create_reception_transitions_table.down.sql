@@ -0,0 +1,20 @@
+DROP TABLE IF EXISTS reception_transitions;
+
+CREATE OR REPLACE FUNCTION receptions_set_in_progress() RETURNS TRIGGER AS $$
+BEGIN
+  NEW.in_progress := EXISTS (
+    SELECT 1 FROM reception_statuses
+    WHERE reception_statuses.id = NEW.status_id AND reception_statuses.name = 'in_progress'
+  );
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- до этой миграции приёмка могла быть только открытой или закрытой: отменённые и переоткрытые
+-- приёмки закрываются, иначе статусы не удалить из-за fk_receptions_status_id. Откат необратим,
+-- исходный статус этих приёмок теряется вместе с reception_transitions
+INSERT INTO reception_statuses (name) VALUES ('close') ON CONFLICT (name) DO NOTHING;
+UPDATE receptions SET status_id = (SELECT id FROM reception_statuses WHERE name = 'close')
+WHERE status_id IN (SELECT id FROM reception_statuses WHERE name IN ('cancelled', 'reopened'));
+
+DELETE FROM reception_statuses WHERE name IN ('cancelled', 'reopened');
\ No newline at end of file
@@ -0,0 +1,24 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+INSERT INTO reception_statuses (name) VALUES ('cancelled'), ('reopened') ON CONFLICT (name) DO NOTHING;
+
+-- переоткрытая приёмка тоже открыта, поэтому попадает под idx_receptions_pvz_in_progress
+CREATE OR REPLACE FUNCTION receptions_set_in_progress() RETURNS TRIGGER AS $$
+BEGIN
+  NEW.in_progress := EXISTS (
+    SELECT 1 FROM reception_statuses
+    WHERE reception_statuses.id = NEW.status_id AND reception_statuses.name IN ('in_progress', 'reopened')
+  );
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TABLE reception_transitions (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4 (),
+  reception_id UUID NOT NULL,
+  from_status VARCHAR(255),
+  to_status VARCHAR(255) NOT NULL,
+  actor_id UUID,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT fk_reception_transitions_reception_id FOREIGN KEY (reception_id) REFERENCES receptions (id)
+);
+CREATE INDEX IF NOT EXISTS idx_reception_transitions_reception_id_created_at ON reception_transitions (reception_id, created_at);
\ No newline at end of file
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_products_barcode_date_time;
+DROP INDEX IF EXISTS idx_products_open_barcode;
+DROP TRIGGER IF EXISTS trg_receptions_sync_products_open ON receptions;
+DROP FUNCTION IF EXISTS receptions_sync_products_open;
+DROP TRIGGER IF EXISTS trg_products_set_in_open_reception ON products;
+DROP FUNCTION IF EXISTS products_set_in_open_reception;
+ALTER TABLE products DROP COLUMN IF EXISTS in_open_reception;
+ALTER TABLE products DROP COLUMN IF EXISTS barcode;
\ No newline at end of file
@@ -0,0 +1,36 @@
+-- штрихкод уникален среди товаров открытых приёмок; признак открытости денормализован из receptions.in_progress
+ALTER TABLE products ADD COLUMN IF NOT EXISTS barcode VARCHAR(64);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS in_open_reception BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE OR REPLACE FUNCTION products_set_in_open_reception() RETURNS TRIGGER AS $$
+BEGIN
+  NEW.in_open_reception := EXISTS (
+    SELECT 1 FROM receptions
+    WHERE receptions.id = NEW.reception_id AND receptions.in_progress
+  );
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_products_set_in_open_reception
+BEFORE INSERT OR UPDATE OF reception_id ON products
+FOR EACH ROW EXECUTE FUNCTION products_set_in_open_reception();
+
+-- in_progress выставляется BEFORE-триггером, поэтому слушаем изменение status_id
+CREATE OR REPLACE FUNCTION receptions_sync_products_open() RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.in_progress IS DISTINCT FROM OLD.in_progress THEN
+    UPDATE products SET in_open_reception = NEW.in_progress WHERE reception_id = NEW.id;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_receptions_sync_products_open
+AFTER UPDATE OF status_id ON receptions
+FOR EACH ROW EXECUTE FUNCTION receptions_sync_products_open();
+
+UPDATE products SET reception_id = reception_id;
+
+CREATE UNIQUE INDEX idx_products_open_barcode ON products (barcode) WHERE in_open_reception AND barcode IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_products_barcode_date_time ON products (barcode, date_time);
\ No newline at end of file
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS issuances;
+ALTER TABLE products DROP COLUMN IF EXISTS issued;
\ No newline at end of file
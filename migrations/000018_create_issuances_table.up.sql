@@ -0,0 +1,21 @@
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+-- issued денормализован из последней записи issuances, чтобы остаток PVZ считался без подзапросов
+ALTER TABLE products ADD COLUMN IF NOT EXISTS issued BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE issuances (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4 (),
+  product_id UUID NOT NULL,
+  pvz_id UUID NOT NULL,
+  kind VARCHAR(16) NOT NULL CHECK (kind IN ('issue', 'return')),
+  issue_id UUID,
+  customer VARCHAR(255),
+  reason TEXT,
+  actor_id UUID,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT fk_issuances_product_id FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE,
+  CONSTRAINT fk_issuances_pvz_id FOREIGN KEY (pvz_id) REFERENCES pvz (id),
+  CONSTRAINT fk_issuances_issue_id FOREIGN KEY (issue_id) REFERENCES issuances (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_issuances_product_id_created_at ON issuances (product_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_issuances_pvz_id_created_at ON issuances (pvz_id, created_at);
\ No newline at end of file
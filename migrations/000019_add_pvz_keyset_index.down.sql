@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_pvz_registration_date_id;
+CREATE INDEX IF NOT EXISTS idx_pvz_registration_date ON pvz (registration_date DESC);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_pvz_registration_date;
+-- ключ keyset пагинации списка PVZ
+CREATE INDEX IF NOT EXISTS idx_pvz_registration_date_id ON pvz (registration_date DESC, id DESC);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_receptions_date_time_id;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- выгрузка приёмок за период по всем PVZ
+CREATE INDEX IF NOT EXISTS idx_receptions_date_time_id ON receptions (date_time, id);
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS chk_pvz_status;
+ALTER TABLE pvz DROP COLUMN IF EXISTS status_effective_at;
+ALTER TABLE pvz DROP COLUMN IF EXISTS status_reason;
+ALTER TABLE pvz DROP COLUMN IF EXISTS status;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- приёмки и товары принимаются только в active, closed конечный статус
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active';
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS status_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS status_effective_at TIMESTAMPTZ;
+ALTER TABLE pvz ADD CONSTRAINT chk_pvz_status CHECK (status IN ('active', 'suspended', 'closed'));
\ No newline at end of file
@@ -0,0 +1,9 @@
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS chk_pvz_capacity;
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS chk_pvz_longitude;
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS chk_pvz_latitude;
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS chk_pvz_location;
+ALTER TABLE pvz DROP COLUMN IF EXISTS capacity;
+ALTER TABLE pvz DROP COLUMN IF EXISTS working_hours;
+ALTER TABLE pvz DROP COLUMN IF EXISTS longitude;
+ALTER TABLE pvz DROP COLUMN IF EXISTS latitude;
+ALTER TABLE pvz DROP COLUMN IF EXISTS address;
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- working_hours хранит недельное расписание, NULL если не задано
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS address TEXT NOT NULL DEFAULT '';
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION;
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS working_hours JSONB;
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS capacity INTEGER;
+ALTER TABLE pvz ADD CONSTRAINT chk_pvz_location CHECK ((latitude IS NULL) = (longitude IS NULL));
+ALTER TABLE pvz ADD CONSTRAINT chk_pvz_latitude CHECK (latitude BETWEEN -90 AND 90);
+ALTER TABLE pvz ADD CONSTRAINT chk_pvz_longitude CHECK (longitude BETWEEN -180 AND 180);
+ALTER TABLE pvz ADD CONSTRAINT chk_pvz_capacity CHECK (capacity > 0);
\ No newline at end of file
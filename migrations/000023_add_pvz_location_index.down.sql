@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_pvz_active_location;
\ No newline at end of file
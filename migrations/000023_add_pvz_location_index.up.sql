@@ -0,0 +1,2 @@
+-- поиск ближайших PVZ отбирает активные точки по диапазону координат
+CREATE INDEX IF NOT EXISTS idx_pvz_active_location ON pvz (latitude, longitude) WHERE status = 'active' AND latitude IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE pvz DROP COLUMN IF EXISTS type_capacities;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- type_capacities лимиты остатка по названию типа товара, NULL если не заданы
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS type_capacities JSONB;
\ No newline at end of file
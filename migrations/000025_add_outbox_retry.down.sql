@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_outbox_events_pending_pvz;
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_unpublished ON outbox_events (created_at) WHERE published_at IS NULL;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS failed_at;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS locked_until;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS next_attempt_at;
\ No newline at end of file
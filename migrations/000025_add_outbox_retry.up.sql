@@ -0,0 +1,9 @@
+-- next_attempt_at откладывает повтор неудачной публикации, locked_until аренда события экземпляром relay,
+-- failed_at выставляется после исчерпания попыток: такое событие больше не публикуется
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
+ALTER TABLE outbox_events ADD COLUMN IF NOT EXISTS failed_at TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS idx_outbox_events_unpublished;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (created_at) WHERE published_at IS NULL AND failed_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending_pvz ON outbox_events (pvz_id, created_at) WHERE published_at IS NULL AND failed_at IS NULL;
\ No newline at end of file
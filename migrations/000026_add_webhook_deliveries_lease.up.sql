@@ -0,0 +1,2 @@
+-- locked_until аренда доставки экземпляром dispatcher на время отправки
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
\ No newline at end of file
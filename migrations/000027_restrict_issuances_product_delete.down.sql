@@ -0,0 +1,2 @@
+ALTER TABLE issuances DROP CONSTRAINT IF EXISTS fk_issuances_product_id;
+ALTER TABLE issuances ADD CONSTRAINT fk_issuances_product_id FOREIGN KEY (product_id) REFERENCES products (id) ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,91 @@
+package listparams
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor позиция keyset пагинации: ключ сортировки последней отданной строки.
+type Cursor struct {
+	Time time.Time `json:"t"`
+	ID   uuid.UUID `json:"id"`
+}
+
+// CursorCodec превращает курсор в непрозрачную строку, подписанную HMAC-SHA256,
+// чтобы клиент не мог подставить произвольную позицию.
+type CursorCodec struct {
+	secret []byte
+}
+
+func NewCursorCodec(secret []byte) *CursorCodec {
+	return &CursorCodec{
+		secret: secret,
+	}
+}
+
+func (c *CursorCodec) Encode(cursor Cursor) string {
+	payload, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(c.sign(payload))
+}
+
+func (c *CursorCodec) Decode(raw string) (Cursor, error) {
+	var cursor Cursor
+
+	payloadPart, signPart, ok := strings.Cut(raw, ".")
+	if !ok {
+		return cursor, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(payloadPart)
+	if err != nil {
+		return cursor, ErrInvalidCursor
+	}
+
+	sign, err := base64.RawURLEncoding.DecodeString(signPart)
+	if err != nil || !hmac.Equal(sign, c.sign(payload)) {
+		return cursor, ErrInvalidCursor
+	}
+
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return cursor, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+func (c *CursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// ParseCursor читает курсор из параметра cursor. Без параметра возвращает nil.
+// Курсор заменяет номер страницы, поэтому вместе с page не допускается.
+func ParseCursor(q url.Values, codec *CursorCodec) (*Cursor, error) {
+	v := q.Get("cursor")
+	if v == "" {
+		return nil, nil
+	}
+
+	if q.Get("page") != "" {
+		return nil, errors.New("cursor and page cannot be used together")
+	}
+
+	cursor, err := codec.Decode(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cursor, nil
+}
@@ -0,0 +1,61 @@
+package listparams
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := NewCursorCodec([]byte("secret"))
+	cursor := Cursor{
+		Time: time.Date(2026, time.February, 11, 10, 30, 0, 123456000, time.UTC),
+		ID:   uuid.New(),
+	}
+
+	raw := codec.Encode(cursor)
+
+	got, err := codec.Decode(raw)
+	require.NoError(t, err)
+	require.True(t, cursor.Time.Equal(got.Time))
+	require.Equal(t, cursor.ID, got.ID)
+
+	// курсор, подписанный другим ключом, отклоняется
+	_, err = NewCursorCodec([]byte("other")).Decode(raw)
+	require.ErrorIs(t, err, ErrInvalidCursor)
+
+	payload, sign, _ := strings.Cut(raw, ".")
+	_, err = codec.Decode(payload + "x." + sign)
+	require.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = codec.Decode("garbage")
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestParseCursor(t *testing.T) {
+	t.Parallel()
+
+	codec := NewCursorCodec([]byte("secret"))
+	cursor := Cursor{Time: time.Date(2026, time.February, 11, 10, 30, 0, 0, time.UTC), ID: uuid.New()}
+
+	got, err := ParseCursor(url.Values{}, codec)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	got, err = ParseCursor(url.Values{"cursor": []string{codec.Encode(cursor)}}, codec)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	require.Equal(t, cursor.ID, got.ID)
+
+	_, err = ParseCursor(url.Values{"cursor": []string{codec.Encode(cursor)}, "page": []string{"2"}}, codec)
+	require.EqualError(t, err, "cursor and page cannot be used together")
+
+	_, err = ParseCursor(url.Values{"cursor": []string{"bad"}}, codec)
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
@@ -0,0 +1,40 @@
+package listparams
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Links собирает значение заголовка Link (RFC 5988) со ссылками на страницы списка.
+// Ссылки строятся от текущего запроса, меняются только переданные параметры.
+type Links struct {
+	u     url.URL
+	links []string
+}
+
+func NewLinks(u *url.URL) *Links {
+	return &Links{
+		u: *u,
+	}
+}
+
+// Add добавляет ссылку с отношением rel. Параметры с пустым значением удаляются из запроса.
+func (l *Links) Add(rel string, params map[string]string) {
+	u := l.u
+	q := u.Query()
+	for k, v := range params {
+		if v == "" {
+			q.Del(k)
+			continue
+		}
+		q.Set(k, v)
+	}
+	u.RawQuery = q.Encode()
+
+	l.links = append(l.links, fmt.Sprintf(`<%s>; rel="%s"`, u.RequestURI(), rel))
+}
+
+func (l *Links) String() string {
+	return strings.Join(l.links, ", ")
+}
@@ -0,0 +1,27 @@
+package listparams
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinks(t *testing.T) {
+	t.Parallel()
+
+	u, err := url.Parse("/pvz?city=Москва&page=2&limit=10")
+	require.NoError(t, err)
+
+	links := NewLinks(u)
+	require.Empty(t, links.String())
+
+	links.Add("prev", map[string]string{"page": "1"})
+	links.Add("next", map[string]string{"page": "", "cursor": "abc"})
+
+	require.Equal(t,
+		`</pvz?city=%D0%9C%D0%BE%D1%81%D0%BA%D0%B2%D0%B0&limit=10&page=1>; rel="prev", `+
+			`</pvz?city=%D0%9C%D0%BE%D1%81%D0%BA%D0%B2%D0%B0&cursor=abc&limit=10>; rel="next"`,
+		links.String(),
+	)
+}
@@ -3,6 +3,7 @@ package listparams
 import (
 	"errors"
 	"net/url"
+	"slices"
 	"strings"
 )
 
@@ -45,3 +46,59 @@ func ParseSort(q url.Values, defaults Sort) (Sort, error) {
 
 	return s, nil
 }
+
+// ParseSorts разбирает сортировку по нескольким полям из параметра sort
+// вида "city,-registrationDate", где минус означает desc.
+// Без sort поддерживается пара field/order из ParseSort, без обоих возвращается defaults.
+// Поля вне allowed отклоняются, чтобы в запрос не попало произвольное выражение.
+func ParseSorts(q url.Values, allowed []string, defaults []Sort) ([]Sort, error) {
+	v := q.Get("sort")
+
+	if v == "" {
+		if q.Get("field") == "" {
+			if q.Get("order") != "" {
+				return nil, errors.New("order requires field")
+			}
+			return defaults, nil
+		}
+
+		s, err := ParseSort(q, Sort{})
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(allowed, s.Field) {
+			return nil, errors.New("invalid sort field")
+		}
+
+		return []Sort{s}, nil
+	}
+
+	if q.Get("field") != "" || q.Get("order") != "" {
+		return nil, errors.New("sort cannot be used with field and order")
+	}
+
+	return ParseSortList(v, allowed)
+}
+
+// ParseSortList разбирает значение вида "city,-registrationDate" без параметров field/order.
+func ParseSortList(v string, allowed []string) ([]Sort, error) {
+	parts := strings.Split(v, ",")
+	sorts := make([]Sort, 0, len(parts))
+	for _, part := range parts {
+		s := Sort{Field: strings.TrimSpace(part), Order: SortAsc}
+		if field, ok := strings.CutPrefix(s.Field, "-"); ok {
+			s = Sort{Field: field, Order: SortDesc}
+		}
+
+		if !slices.Contains(allowed, s.Field) {
+			return nil, errors.New("invalid sort field")
+		}
+		if slices.ContainsFunc(sorts, func(prev Sort) bool { return prev.Field == s.Field }) {
+			return nil, errors.New("duplicate sort field")
+		}
+
+		sorts = append(sorts, s)
+	}
+
+	return sorts, nil
+}
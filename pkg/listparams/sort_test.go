@@ -103,3 +103,81 @@ func TestParseSort(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSorts(t *testing.T) {
+	t.Parallel()
+
+	allowed := []string{"registrationDate", "city"}
+	defaults := []Sort{{Field: "registrationDate", Order: SortDesc}}
+
+	testcases := []struct {
+		name       string
+		query      url.Values
+		want       []Sort
+		errMessage string
+	}{
+		{
+			name:  "no query, use defaults",
+			query: url.Values{},
+			want:  defaults,
+		},
+		{
+			name:  "multiple fields",
+			query: url.Values{"sort": []string{"city, -registrationDate"}},
+			want: []Sort{
+				{Field: "city", Order: SortAsc},
+				{Field: "registrationDate", Order: SortDesc},
+			},
+		},
+		{
+			name:  "field and order",
+			query: url.Values{"field": []string{"city"}, "order": []string{"desc"}},
+			want:  []Sort{{Field: "city", Order: SortDesc}},
+		},
+		{
+			name:       "field not allowed",
+			query:      url.Values{"sort": []string{"city,id"}},
+			errMessage: "invalid sort field",
+		},
+		{
+			name:       "field param not allowed",
+			query:      url.Values{"field": []string{"pvz.id; drop table pvz"}},
+			errMessage: "invalid sort field",
+		},
+		{
+			name:       "duplicate field",
+			query:      url.Values{"sort": []string{"city,-city"}},
+			errMessage: "duplicate sort field",
+		},
+		{
+			name:       "sort with field",
+			query:      url.Values{"sort": []string{"city"}, "field": []string{"city"}},
+			errMessage: "sort cannot be used with field and order",
+		},
+		{
+			name:       "order without field",
+			query:      url.Values{"order": []string{"asc"}},
+			errMessage: "order requires field",
+		},
+		{
+			name:       "invalid order",
+			query:      url.Values{"field": []string{"city"}, "order": []string{"up"}},
+			errMessage: "invalid sort order",
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseSorts(tt.query, allowed, defaults)
+			if tt.errMessage != "" {
+				require.EqualError(t, err, tt.errMessage)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+)
+
+func TestAuditEventRepository(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		auditRepo := postgres.NewAuditEventRepository(tx)
+
+		pvzID := uuid.New()
+		actorID := uuid.New()
+		receptionID := uuid.New()
+
+		opened, err := auditRepo.Create(ctx, domain.AuditEvent{
+			ActorID:   actorID,
+			Action:    domain.AuditActionReceptionOpened,
+			EntityID:  receptionID,
+			PvzID:     pvzID,
+			RequestID: "req-1",
+			After:     domain.Reception{ID: receptionID, PvzID: pvzID},
+			CreatedAt: time.Now().Add(-time.Minute),
+		})
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, opened.ID)
+
+		_, err = auditRepo.Create(ctx, domain.AuditEvent{
+			ActorID:  actorID,
+			Action:   domain.AuditActionReceptionClosed,
+			EntityID: receptionID,
+			PvzID:    pvzID,
+			Before:   domain.Reception{ID: receptionID, PvzID: pvzID},
+			After:    domain.Reception{ID: receptionID, PvzID: pvzID},
+		})
+		require.NoError(t, err)
+
+		events, err := auditRepo.List(ctx, domain.AuditFilter{PvzID: &pvzID}, &listparams.Pagination{Page: 1, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, domain.AuditActionReceptionClosed, events[0].Action)
+		assert.Equal(t, domain.AuditActionReceptionOpened, events[1].Action)
+		assert.Equal(t, "req-1", events[1].RequestID)
+		assert.Nil(t, events[1].Before)
+
+		var after domain.Reception
+		require.NoError(t, json.Unmarshal(events[1].After.(json.RawMessage), &after))
+		assert.Equal(t, receptionID, after.ID)
+
+		action := domain.AuditActionReceptionOpened
+		events, err = auditRepo.List(ctx, domain.AuditFilter{ActorID: &actorID, Action: &action}, nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, opened.ID, events[0].ID)
+
+		// журнал только на добавление
+		_, err = tx.Exec(ctx, "UPDATE audit_events SET action = 'pvz.created' WHERE id = $1", opened.ID)
+		require.Error(t, err)
+	})
+}
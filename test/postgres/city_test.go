@@ -3,12 +3,15 @@ package postgres_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
 	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 )
 
 func TestCityRepository_Create(t *testing.T) {
@@ -124,3 +127,79 @@ func TestCityRepository_CreateBatch(t *testing.T) {
 		}
 	})
 }
+
+func TestCityRepository_UpdateAndList(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+
+		first, err := cityRepo.Create(ctx, domain.City{Name: "ZZ City One"})
+		require.NoError(t, err)
+		second, err := cityRepo.Create(ctx, domain.City{Name: "ZZ City Two"})
+		require.NoError(t, err)
+
+		updated, err := cityRepo.Update(ctx, domain.City{ID: first.ID, Name: "ZZ City Three"})
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, updated.ID)
+		assert.Equal(t, "ZZ City Three", updated.Name)
+
+		_, err = cityRepo.Update(ctx, domain.City{ID: first.ID, Name: second.Name})
+		assert.ErrorIs(t, err, infra.ErrDuplicate)
+
+		_, err = cityRepo.Update(ctx, domain.City{ID: uuid.New(), Name: "ZZ City Four"})
+		assert.ErrorIs(t, err, infra.ErrNotFound)
+
+		cities, err := cityRepo.List(ctx, nil)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(cities), 2)
+
+		// города отсортированы по названию
+		tail := cities[len(cities)-2:]
+		assert.Equal(t, []*domain.City{
+			{ID: updated.ID, Name: "ZZ City Three"},
+			{ID: second.ID, Name: second.Name},
+		}, tail)
+
+		page, err := cityRepo.List(ctx, &listparams.Pagination{Page: 1, Limit: 1})
+		require.NoError(t, err)
+		assert.Len(t, page, 1)
+	})
+}
+
+func TestCityRepository_Delete(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+		pvzRepo := postgres.NewPVZRepository(tx)
+
+		empty, err := cityRepo.Create(ctx, domain.City{Name: "EmptyCity"})
+		require.NoError(t, err)
+
+		withPVZ, err := cityRepo.Create(ctx, domain.City{Name: "CityWithPVZ"})
+		require.NoError(t, err)
+
+		_, err = pvzRepo.Create(ctx, domain.PVZ{
+			ID:               uuid.New(),
+			RegistrationDate: time.Now(),
+			CityID:           withPVZ.ID,
+		})
+		require.NoError(t, err)
+
+		hasPVZ, err := cityRepo.HasPVZ(ctx, empty.ID)
+		require.NoError(t, err)
+		assert.False(t, hasPVZ)
+
+		hasPVZ, err = cityRepo.HasPVZ(ctx, withPVZ.ID)
+		require.NoError(t, err)
+		assert.True(t, hasPVZ)
+
+		require.NoError(t, cityRepo.Delete(ctx, empty.ID))
+
+		_, err = cityRepo.Get(ctx, domain.City{ID: empty.ID})
+		assert.ErrorIs(t, err, infra.ErrNotFound)
+
+		err = cityRepo.Delete(ctx, empty.ID)
+		assert.ErrorIs(t, err, infra.ErrNotFound)
+
+		err = cityRepo.Delete(ctx, withPVZ.ID)
+		assert.ErrorIs(t, err, infra.ErrReferenced)
+	})
+}
@@ -0,0 +1,104 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+)
+
+func TestIssuanceRepository(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		f := newProductFixture(t, ctx, tx)
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		issuanceRepo := postgres.NewIssuanceRepository(tx)
+
+		product, err := f.productRepo.Create(ctx, newProduct(f.productType.ID, f.reception.ID, now))
+		require.NoError(t, err)
+
+		_, err = issuanceRepo.GetLastByProduct(ctx, product.ID, domain.IssuanceKindIssue)
+		require.ErrorIs(t, err, infra.ErrNotFound)
+
+		issue, err := issuanceRepo.Create(ctx, domain.Issuance{
+			ProductID: product.ID,
+			PvzID:     f.reception.PvzID,
+			Kind:      domain.IssuanceKindIssue,
+			Customer:  "Ivanov",
+			ActorID:   uuid.New(),
+			CreatedAt: now,
+		})
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, issue.ID)
+		assert.Equal(t, uuid.Nil, issue.IssueID)
+
+		returned, err := issuanceRepo.Create(ctx, domain.Issuance{
+			ProductID: product.ID,
+			PvzID:     f.reception.PvzID,
+			Kind:      domain.IssuanceKindReturn,
+			IssueID:   issue.ID,
+			Customer:  issue.Customer,
+			Reason:    "damaged",
+			CreatedAt: now.Add(time.Minute),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, issue.ID, returned.IssueID)
+
+		last, err := issuanceRepo.GetLastByProduct(ctx, product.ID, domain.IssuanceKindIssue)
+		require.NoError(t, err)
+		assert.Equal(t, issue.ID, last.ID)
+
+		list, err := issuanceRepo.ListByProduct(ctx, product.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, issue.ID, list[0].ID)
+		assert.Equal(t, returned.ID, list[1].ID)
+		assert.Equal(t, "damaged", list[1].Reason)
+
+		// история выдач не удаляется вместе с товаром
+		err = f.productRepo.DeleteProduct(ctx, product.ID)
+		require.ErrorIs(t, err, infra.ErrReferenced)
+	})
+}
+
+func TestProductRepository_SetIssuedAndCountOnHand(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		f := newProductFixture(t, ctx, tx)
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		first, err := f.productRepo.Create(ctx, newProduct(f.productType.ID, f.reception.ID, now))
+		require.NoError(t, err)
+		_, err = f.productRepo.Create(ctx, newProduct(f.productType.ID, f.reception.ID, now.Add(time.Second)))
+		require.NoError(t, err)
+
+		counts, err := f.productRepo.CountOnHandByPVZ(ctx, []uuid.UUID{f.reception.PvzID})
+		require.NoError(t, err)
+		assert.Equal(t, 2, counts[f.reception.PvzID])
+
+		issued, err := f.productRepo.SetIssued(ctx, first.ID, true)
+		require.NoError(t, err)
+		assert.True(t, issued.Issued)
+
+		// повторная выдача не меняет строку
+		_, err = f.productRepo.SetIssued(ctx, first.ID, true)
+		require.ErrorIs(t, err, infra.ErrNotFound)
+
+		counts, err = f.productRepo.CountOnHandByPVZ(ctx, []uuid.UUID{f.reception.PvzID})
+		require.NoError(t, err)
+		assert.Equal(t, 1, counts[f.reception.PvzID])
+
+		returned, err := f.productRepo.SetIssued(ctx, first.ID, false)
+		require.NoError(t, err)
+		assert.False(t, returned.Issued)
+
+		counts, err = f.productRepo.CountOnHandByPVZ(ctx, []uuid.UUID{f.reception.PvzID, uuid.New()})
+		require.NoError(t, err)
+		assert.Equal(t, 2, counts[f.reception.PvzID])
+		assert.Len(t, counts, 1)
+	})
+}
@@ -0,0 +1,74 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+)
+
+func TestEventNotifier_Listener(t *testing.T) {
+	ctx := context.Background()
+
+	// NOTIFY доставляется только после коммита, поэтому данные пишутся без WithTx
+	city, err := postgres.NewCityRepository(testApp.DB).Create(ctx, domain.City{ID: uuid.New(), Name: "NotifyCity"})
+	require.NoError(t, err)
+	pvz, err := postgres.NewPVZRepository(testApp.DB).Create(ctx, domain.PVZ{
+		ID:               uuid.New(),
+		RegistrationDate: time.Now(),
+		CityID:           city.ID,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := testApp.DB.Exec(ctx, "DELETE FROM pvz WHERE id = $1", pvz.ID)
+		assert.NoError(t, err)
+		_, err = testApp.DB.Exec(ctx, "DELETE FROM cities WHERE id = $1", city.ID)
+		assert.NoError(t, err)
+	})
+
+	events := make(chan domain.PVZEvent, 10)
+	listener := postgres.NewEventListener(testApp.DB, func(event domain.PVZEvent) {
+		events <- event
+	}, 10*time.Millisecond)
+	listener.Start(ctx)
+	t.Cleanup(func() {
+		assert.NoError(t, listener.Stop(ctx))
+	})
+
+	notifier := postgres.NewEventNotifier(testApp.DB)
+	outboxEvent := domain.OutboxEvent{
+		ID:          uuid.New(),
+		Type:        domain.EventReceptionOpened,
+		AggregateID: uuid.New(),
+		PvzID:       pvz.ID,
+		Payload:     json.RawMessage(`{"status":"in_progress"}`),
+		CreatedAt:   time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	// listener подписывается асинхронно, поэтому событие отправляется до первого получения
+	var got domain.PVZEvent
+	require.Eventually(t, func() bool {
+		if !assert.NoError(t, notifier.Publish(ctx, outboxEvent)) {
+			return false
+		}
+		select {
+		case got = <-events:
+			return true
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, outboxEvent.ID, got.ID)
+	assert.Equal(t, domain.EventReceptionOpened, got.Type)
+	assert.Equal(t, pvz.ID, got.PvzID)
+	assert.Equal(t, "NotifyCity", got.City)
+	assert.True(t, outboxEvent.CreatedAt.Equal(got.OccurredAt))
+	assert.JSONEq(t, `{"status":"in_progress"}`, string(got.Payload))
+}
@@ -0,0 +1,127 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+)
+
+func TestOutboxEventRepository(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		outboxRepo := postgres.NewOutboxEventRepository(tx)
+
+		pvzID := uuid.New()
+		receptionID := uuid.New()
+
+		opened, err := outboxRepo.Create(ctx, domain.OutboxEvent{
+			Type:        domain.EventReceptionOpened,
+			AggregateID: receptionID,
+			PvzID:       pvzID,
+			Payload:     domain.Reception{ID: receptionID, PvzID: pvzID},
+			CreatedAt:   time.Now().Add(-time.Minute),
+		})
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, opened.ID)
+		assert.Nil(t, opened.PublishedAt)
+
+		closed, err := outboxRepo.Create(ctx, domain.OutboxEvent{
+			Type:        domain.EventReceptionClosed,
+			AggregateID: receptionID,
+			PvzID:       pvzID,
+			Payload:     domain.Reception{ID: receptionID, PvzID: pvzID},
+		})
+		require.NoError(t, err)
+
+		events, err := outboxRepo.ClaimDue(ctx, 100, time.Minute)
+		require.NoError(t, err)
+
+		ids := outboxEventIDs(events)
+		require.Contains(t, ids, opened.ID)
+		require.Contains(t, ids, closed.ID)
+		// внутри PVZ события отдаются в порядке создания
+		assert.Less(t, slices.Index(ids, opened.ID), slices.Index(ids, closed.ID))
+
+		var payload domain.Reception
+		for _, e := range events {
+			if e.ID == opened.ID {
+				require.NoError(t, json.Unmarshal(e.Payload.(json.RawMessage), &payload))
+			}
+		}
+		assert.Equal(t, receptionID, payload.ID)
+
+		// арендованные события повторно не выдаются
+		events, err = outboxRepo.ClaimDue(ctx, 100, time.Minute)
+		require.NoError(t, err)
+		assert.NotContains(t, outboxEventIDs(events), closed.ID)
+
+		require.NoError(t, outboxRepo.MarkFailed(ctx, closed.ID, "timeout", time.Now().Add(time.Hour)))
+		require.NoError(t, outboxRepo.MarkPublished(ctx, opened.ID))
+
+		added, err := outboxRepo.Create(ctx, domain.OutboxEvent{
+			Type:        domain.EventProductAdded,
+			AggregateID: uuid.New(),
+			PvzID:       pvzID,
+			Payload:     domain.Product{ReceptionID: receptionID},
+		})
+		require.NoError(t, err)
+
+		otherPvz, err := outboxRepo.Create(ctx, domain.OutboxEvent{
+			Type:        domain.EventPVZCreated,
+			AggregateID: uuid.New(),
+			PvzID:       uuid.New(),
+			Payload:     domain.PVZ{},
+		})
+		require.NoError(t, err)
+
+		// событие в ожидании повтора задерживает следующие события своего PVZ, но не чужие
+		events, err = outboxRepo.ClaimDue(ctx, 100, time.Minute)
+		require.NoError(t, err)
+		ids = outboxEventIDs(events)
+		assert.NotContains(t, ids, opened.ID)
+		assert.NotContains(t, ids, closed.ID)
+		assert.NotContains(t, ids, added.ID)
+		assert.Contains(t, ids, otherPvz.ID)
+
+		// после исчерпания попыток событие больше не публикуется и не задерживает PVZ
+		require.NoError(t, outboxRepo.MarkDead(ctx, closed.ID, "bad gateway"))
+
+		events, err = outboxRepo.ClaimDue(ctx, 100, time.Minute)
+		require.NoError(t, err)
+		ids = outboxEventIDs(events)
+		assert.NotContains(t, ids, closed.ID)
+		assert.Contains(t, ids, added.ID)
+
+		var attempts int
+		var lastError string
+		var failedAt *time.Time
+		err = tx.QueryRow(ctx, "SELECT attempts, last_error, failed_at FROM outbox_events WHERE id = $1", closed.ID).
+			Scan(&attempts, &lastError, &failedAt)
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+		assert.Equal(t, "bad gateway", lastError)
+		assert.NotNil(t, failedAt)
+
+		// снятое с аренды событие выдаётся снова
+		require.NoError(t, outboxRepo.Release(ctx, []uuid.UUID{added.ID}))
+
+		events, err = outboxRepo.ClaimDue(ctx, 100, time.Minute)
+		require.NoError(t, err)
+		assert.Contains(t, outboxEventIDs(events), added.ID)
+	})
+}
+
+func outboxEventIDs(events []*domain.OutboxEvent) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
@@ -89,11 +89,13 @@ func TestProductRepository_CreateAndGet(t *testing.T) {
 		now := time.Now().UTC().Truncate(time.Millisecond)
 		f := newProductFixture(t, ctx, tx)
 		product := newProduct(f.productType.ID, f.reception.ID, now)
+		product.CreatedBy = uuid.New()
 
 		created, err := f.productRepo.Create(ctx, product)
 
 		require.NoError(t, err)
 		require.NotNil(t, created)
+		require.Equal(t, product.CreatedBy, created.CreatedBy)
 
 		require.Equal(t, product.ID, created.ID)
 		require.Equal(t, product.TypeID, created.TypeID)
@@ -240,3 +242,210 @@ func TestProductRepository_DeleteProduct(t *testing.T) {
 		})
 	})
 }
+
+func TestProductRepository_CreateBatch(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		f := newProductFixture(t, ctx, tx)
+
+		products := []domain.Product{
+			newProduct(f.productType.ID, f.reception.ID, now),
+			newProduct(f.productType.ID, f.reception.ID, now.Add(time.Microsecond)),
+			{DateTime: now.Add(2 * time.Microsecond), TypeID: f.productType.ID, ReceptionID: f.reception.ID},
+		}
+
+		created, err := f.productRepo.CreateBatch(ctx, products)
+		require.NoError(t, err)
+		require.Len(t, created, len(products))
+
+		require.Equal(t, products[0].ID, created[0].ID)
+		require.Equal(t, products[1].ID, created[1].ID)
+		require.NotEqual(t, uuid.Nil, created[2].ID)
+
+		last, err := f.productRepo.GetLastProductInReception(ctx, f.reception.ID)
+		require.NoError(t, err)
+		require.Equal(t, created[2].ID, last.ID)
+	})
+}
+
+func TestProductRepository_BarcodeUniqueInOpenReceptions(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		f := newProductFixture(t, ctx, tx)
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		cityRepo := postgres.NewCityRepository(tx)
+		pvzRepo := postgres.NewPVZRepository(tx)
+		statusRepo := postgres.NewReceptionStatusRepository(tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+
+		inProgress, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusInProgress})
+		require.NoError(t, err)
+		closeStatus, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusClose})
+		require.NoError(t, err)
+
+		city, err := cityRepo.Create(ctx, domain.City{ID: uuid.New(), Name: "BarcodeCity"})
+		require.NoError(t, err)
+
+		openReceptions := make([]*domain.Reception, 0, 2)
+		for range 2 {
+			pvz, err := pvzRepo.Create(ctx, domain.PVZ{ID: uuid.New(), RegistrationDate: now, CityID: city.ID})
+			require.NoError(t, err)
+
+			reception, err := receptionRepo.Create(ctx, domain.Reception{PvzID: pvz.ID, DateTime: now, StatusID: inProgress.ID})
+			require.NoError(t, err)
+
+			openReceptions = append(openReceptions, reception)
+		}
+
+		first := newProduct(f.productType.ID, openReceptions[0].ID, now)
+		first.Barcode = "4601234567890"
+		_, err = f.productRepo.Create(ctx, first)
+		require.NoError(t, err)
+
+		// штрихкод из закрытой приёмки не мешает
+		closed := newProduct(f.productType.ID, f.reception.ID, now.Add(-time.Hour))
+		closed.Barcode = "4601234567890"
+		_, err = f.productRepo.Create(ctx, closed)
+		require.NoError(t, err)
+
+		barcodes, err := f.productRepo.ListOpenBarcodes(ctx, []string{"4601234567890", "other"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"4601234567890"}, barcodes)
+
+		// после закрытия первой приёмки штрихкод освобождается
+		_, err = receptionRepo.Update(ctx, openReceptions[0].ID, domain.Reception{StatusID: closeStatus.ID})
+		require.NoError(t, err)
+
+		second := newProduct(f.productType.ID, openReceptions[1].ID, now.Add(time.Minute))
+		second.Barcode = "4601234567890"
+		_, err = f.productRepo.Create(ctx, second)
+		require.NoError(t, err)
+
+		last, err := f.productRepo.GetLastByBarcode(ctx, "4601234567890")
+		require.NoError(t, err)
+		assert.Equal(t, second.ID, last.ID)
+		assert.Equal(t, "4601234567890", last.Barcode)
+		assert.Equal(t, f.productType.Name, last.ProductType.Name)
+
+		_, err = f.productRepo.GetLastByBarcode(ctx, "unknown")
+		require.ErrorIs(t, err, infra.ErrNotFound)
+
+		// повторный скан в открытой приёмке отсекается уникальным индексом
+		duplicate := newProduct(f.productType.ID, openReceptions[1].ID, now.Add(2*time.Minute))
+		duplicate.Barcode = "4601234567890"
+		_, err = f.productRepo.Create(ctx, duplicate)
+		require.ErrorIs(t, err, infra.ErrDuplicate)
+	})
+}
+
+func TestReceptionRepository_ReopenBarcodeConflict(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		f := newProductFixture(t, ctx, tx)
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		pvzRepo := postgres.NewPVZRepository(tx)
+		statusRepo := postgres.NewReceptionStatusRepository(tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+
+		inProgress, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusInProgress})
+		require.NoError(t, err)
+		reopened, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusReopened})
+		require.NoError(t, err)
+
+		closed := newProduct(f.productType.ID, f.reception.ID, now.Add(-time.Hour))
+		closed.Barcode = "4601234567890"
+		_, err = f.productRepo.Create(ctx, closed)
+		require.NoError(t, err)
+
+		fixturePvz, err := pvzRepo.Get(ctx, domain.PVZ{ID: f.reception.PvzID})
+		require.NoError(t, err)
+		otherPvz, err := pvzRepo.Create(ctx, domain.PVZ{ID: uuid.New(), RegistrationDate: now, CityID: fixturePvz.CityID})
+		require.NoError(t, err)
+
+		open, err := receptionRepo.Create(ctx, domain.Reception{PvzID: otherPvz.ID, DateTime: now, StatusID: inProgress.ID})
+		require.NoError(t, err)
+
+		rescanned := newProduct(f.productType.ID, open.ID, now)
+		rescanned.Barcode = "4601234567890"
+		_, err = f.productRepo.Create(ctx, rescanned)
+		require.NoError(t, err)
+
+		// при переоткрытии товары приёмки снова попадают под уникальность штрихкода
+		_, err = receptionRepo.Update(ctx, f.reception.ID, domain.Reception{StatusID: reopened.ID})
+		require.ErrorIs(t, err, infra.ErrDuplicate)
+		assert.Equal(t, "idx_products_open_barcode", infra.ConstraintName(err))
+	})
+}
+
+func TestProductRepository_InventoryByType(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		f := newProductFixture(t, ctx, tx)
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		productTypeRepo := postgres.NewProductTypeRepository(tx)
+		statusRepo := postgres.NewReceptionStatusRepository(tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+		transitionRepo := postgres.NewReceptionTransitionRepository(tx)
+		issuanceRepo := postgres.NewIssuanceRepository(tx)
+
+		shoes, err := productTypeRepo.Get(ctx, domain.ProductType{Name: "обувь"})
+		require.NoError(t, err)
+
+		_, err = f.productRepo.Create(ctx, newProduct(shoes.ID, f.reception.ID, now.Add(-2*time.Hour)))
+		require.NoError(t, err)
+		issued, err := f.productRepo.Create(ctx, newProduct(f.productType.ID, f.reception.ID, now.Add(-2*time.Hour)))
+		require.NoError(t, err)
+		_, err = f.productRepo.Create(ctx, newProduct(f.productType.ID, f.reception.ID, now.Add(-2*time.Hour)))
+		require.NoError(t, err)
+
+		_, err = f.productRepo.SetIssued(ctx, issued.ID, true)
+		require.NoError(t, err)
+		_, err = issuanceRepo.Create(ctx, domain.Issuance{
+			ProductID: issued.ID,
+			PvzID:     f.reception.PvzID,
+			Kind:      domain.IssuanceKindIssue,
+			Customer:  "Ivanov",
+			CreatedAt: now.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		// товар приёмки, отменённой полчаса назад
+		inProgress, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusInProgress})
+		require.NoError(t, err)
+		cancelled, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusCancelled})
+		require.NoError(t, err)
+
+		reception, err := receptionRepo.Create(ctx, domain.Reception{PvzID: f.reception.PvzID, DateTime: now.Add(-time.Hour), StatusID: inProgress.ID})
+		require.NoError(t, err)
+		_, err = f.productRepo.Create(ctx, newProduct(shoes.ID, reception.ID, now.Add(-time.Hour)))
+		require.NoError(t, err)
+		_, err = receptionRepo.Update(ctx, reception.ID, domain.Reception{StatusID: cancelled.ID})
+		require.NoError(t, err)
+		_, err = transitionRepo.Create(ctx, domain.ReceptionTransition{
+			ReceptionID: reception.ID,
+			FromStatus:  domain.ReceptionStatusInProgress,
+			ToStatus:    domain.ReceptionStatusCancelled,
+			CreatedAt:   now.Add(-30 * time.Minute),
+		})
+		require.NoError(t, err)
+
+		current, err := f.productRepo.InventoryByType(ctx, f.reception.PvzID, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"обувь": 1, "электроника": 1}, current)
+
+		beforeIssue := now.Add(-90 * time.Minute)
+		historical, err := f.productRepo.InventoryByType(ctx, f.reception.PvzID, &beforeIssue)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"обувь": 1, "электроника": 2}, historical)
+
+		beforeCancel := now.Add(-45 * time.Minute)
+		historical, err = f.productRepo.InventoryByType(ctx, f.reception.PvzID, &beforeCancel)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"обувь": 2, "электроника": 1}, historical)
+
+		beforeReceive := now.Add(-3 * time.Hour)
+		historical, err = f.productRepo.InventoryByType(ctx, f.reception.PvzID, &beforeReceive)
+		require.NoError(t, err)
+		assert.Empty(t, historical)
+	})
+}
@@ -79,6 +79,162 @@ func TestPVZRepository_Get(t *testing.T) {
 	})
 }
 
+func TestPVZRepository_UpdateStatus(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+
+		city, err := cityRepo.Create(ctx, domain.City{
+			ID:   uuid.New(),
+			Name: "TestCity",
+		})
+		require.NoError(t, err)
+
+		pvzRepo := postgres.NewPVZRepository(tx)
+
+		created, err := pvzRepo.Create(ctx, domain.PVZ{
+			ID:               uuid.New(),
+			RegistrationDate: time.Now(),
+			CityID:           city.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, domain.PVZStatusActive, created.Status)
+		assert.Empty(t, created.StatusReason)
+		assert.Nil(t, created.StatusEffectiveAt)
+
+		effectiveAt := time.Now().Add(-time.Hour)
+
+		updated, err := pvzRepo.UpdateStatus(ctx, created.ID, domain.PVZStatusSuspended, "ремонт", effectiveAt)
+		require.NoError(t, err)
+		assert.Equal(t, domain.PVZStatusSuspended, updated.Status)
+		assert.Equal(t, "ремонт", updated.StatusReason)
+		require.NotNil(t, updated.StatusEffectiveAt)
+		assert.WithinDuration(t, effectiveAt, *updated.StatusEffectiveAt, time.Millisecond)
+
+		got, err := pvzRepo.Get(ctx, domain.PVZ{ID: created.ID})
+		require.NoError(t, err)
+		assert.Equal(t, domain.PVZStatusSuspended, got.Status)
+
+		_, err = pvzRepo.UpdateStatus(ctx, uuid.New(), domain.PVZStatusClosed, "переезд", effectiveAt)
+		assert.ErrorIs(t, err, infra.ErrNotFound)
+	})
+}
+
+func TestPVZRepository_UpdateProfile(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+
+		city, err := cityRepo.Create(ctx, domain.City{
+			ID:   uuid.New(),
+			Name: "TestCity",
+		})
+		require.NoError(t, err)
+
+		pvzRepo := postgres.NewPVZRepository(tx)
+
+		capacity := 200
+		created, err := pvzRepo.Create(ctx, domain.PVZ{
+			ID:               uuid.New(),
+			RegistrationDate: time.Now(),
+			CityID:           city.ID,
+			Address:          "ул. Ленина, 5",
+			Location:         &domain.GeoPoint{Lat: 59.9343, Lon: 30.3351},
+			Capacity:         &capacity,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ул. Ленина, 5", created.Address)
+		assert.Equal(t, &domain.GeoPoint{Lat: 59.9343, Lon: 30.3351}, created.Location)
+		assert.Nil(t, created.WorkingHours)
+		assert.Equal(t, &capacity, created.Capacity)
+		assert.Nil(t, created.TypeCapacities)
+
+		hours := domain.WorkingHours{
+			{Day: domain.Monday, Open: "09:00", Close: "13:00"},
+			{Day: domain.Monday, Open: "14:00", Close: "21:00"},
+			{Day: domain.Saturday, Open: "10:00", Close: "24:00"},
+		}
+
+		changed := *created
+		changed.WorkingHours = hours
+		changed.Location = nil
+		changed.Capacity = nil
+		changed.TypeCapacities = map[string]int{"обувь": 20, "одежда": 50}
+
+		updated, err := pvzRepo.UpdateProfile(ctx, changed)
+		require.NoError(t, err)
+		assert.Equal(t, hours, updated.WorkingHours)
+		assert.Nil(t, updated.Location)
+		assert.Nil(t, updated.Capacity)
+		assert.Equal(t, map[string]int{"обувь": 20, "одежда": 50}, updated.TypeCapacities)
+		assert.Equal(t, "ул. Ленина, 5", updated.Address)
+
+		got, err := pvzRepo.Get(ctx, domain.PVZ{ID: created.ID})
+		require.NoError(t, err)
+		assert.Equal(t, hours, got.WorkingHours)
+		assert.Equal(t, map[string]int{"обувь": 20, "одежда": 50}, got.TypeCapacities)
+
+		changed.ID = uuid.New()
+		_, err = pvzRepo.UpdateProfile(ctx, changed)
+		assert.ErrorIs(t, err, infra.ErrNotFound)
+	})
+}
+
+func TestPVZRepository_ListNearby(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+
+		city, err := cityRepo.Create(ctx, domain.City{
+			ID:   uuid.New(),
+			Name: "TestCity",
+		})
+		require.NoError(t, err)
+
+		pvzRepo := postgres.NewPVZRepository(tx)
+
+		createPVZ := func(location *domain.GeoPoint) *domain.PVZ {
+			created, err := pvzRepo.Create(ctx, domain.PVZ{
+				ID:               uuid.New(),
+				RegistrationDate: time.Now(),
+				CityID:           city.ID,
+				Location:         location,
+			})
+			require.NoError(t, err)
+			return created
+		}
+
+		// точки в малонаселённом районе, чтобы не пересекаться с другими данными
+		point := domain.GeoPoint{Lat: -45.0, Lon: 170.0}
+		near := createPVZ(&domain.GeoPoint{Lat: -45.0, Lon: 170.01})
+		nearest := createPVZ(&domain.GeoPoint{Lat: -45.002, Lon: 170.0})
+		far := createPVZ(&domain.GeoPoint{Lat: -45.0, Lon: 170.2})
+		suspended := createPVZ(&domain.GeoPoint{Lat: -45.001, Lon: 170.0})
+		createPVZ(nil)
+
+		_, err = pvzRepo.UpdateStatus(ctx, suspended.ID, domain.PVZStatusSuspended, "ремонт", time.Now())
+		require.NoError(t, err)
+
+		res, err := pvzRepo.ListNearby(ctx, point, 5, 10)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+
+		assert.Equal(t, nearest.ID, res[0].ID)
+		assert.InDelta(t, 0.222, res[0].DistanceKm, 0.005)
+		assert.Equal(t, "TestCity", res[0].City.Name)
+		assert.Equal(t, near.ID, res[1].ID)
+		assert.InDelta(t, 0.786, res[1].DistanceKm, 0.005)
+
+		res, err = pvzRepo.ListNearby(ctx, point, 20, 10)
+		require.NoError(t, err)
+		require.Len(t, res, 3)
+		assert.Equal(t, far.ID, res[2].ID)
+		assert.InDelta(t, 15.73, res[2].DistanceKm, 0.05)
+
+		res, err = pvzRepo.ListNearby(ctx, point, 20, 1)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, nearest.ID, res[0].ID)
+	})
+}
+
 func TestPVZRepository_GetList(t *testing.T) {
 	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
 		cityRepo := postgres.NewCityRepository(tx)
@@ -187,7 +343,7 @@ func TestPVZRepository_ListPvzByAcceptanceDateAndCity(t *testing.T) {
 		start := now.Add(-2 * time.Hour)
 		end := now.Add(-1 * time.Minute)
 
-		result, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, pagination, &start, &end)
+		result, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &start, EndDate: &end}, nil, pagination, nil)
 		require.NoError(t, err)
 
 		assert.Len(t, result, 2)
@@ -201,7 +357,7 @@ func TestPVZRepository_ListPvzByAcceptanceDateAndCity(t *testing.T) {
 
 		start = now.Add(-10 * time.Minute)
 		end = now
-		emptyResult, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, pagination, &start, &end)
+		emptyResult, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &start, EndDate: &end}, nil, pagination, nil)
 		require.NoError(t, err)
 		assert.Empty(t, emptyResult)
 	})
@@ -248,7 +404,7 @@ func TestPVZRepository_ListPvzByAcceptanceDateAndCity_Pagination(t *testing.T) {
 		pagination := &listparams.Pagination{Limit: 2, Page: 1}
 		start := now.Add(-10 * time.Hour)
 		end := now
-		page1, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, pagination, &start, &end)
+		page1, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &start, EndDate: &end}, nil, pagination, nil)
 		require.NoError(t, err)
 		assert.Len(t, page1, 2)
 
@@ -256,14 +412,185 @@ func TestPVZRepository_ListPvzByAcceptanceDateAndCity_Pagination(t *testing.T) {
 		assert.True(t, page1[0].RegistrationDate.After(page1[1].RegistrationDate) || page1[0].RegistrationDate.Equal(page1[1].RegistrationDate))
 
 		pagination = &listparams.Pagination{Limit: 2, Page: 2}
-		page2, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, pagination, &start, &end)
+		page2, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &start, EndDate: &end}, nil, pagination, nil)
 		require.NoError(t, err)
 		assert.Len(t, page2, 2)
 		assert.True(t, page2[0].RegistrationDate.After(page2[1].RegistrationDate) || page2[0].RegistrationDate.Equal(page2[1].RegistrationDate))
 
 		pagination = &listparams.Pagination{Limit: 2, Page: 3}
-		page3, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, pagination, &start, &end)
+		page3, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{StartDate: &start, EndDate: &end}, nil, pagination, nil)
 		require.NoError(t, err)
 		assert.Len(t, page3, 1) // последняя страница содержит 1 элемент
 	})
 }
+
+func TestPVZRepository_ListPvzByAcceptanceDateAndCity_Keyset(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+		statusRepo := postgres.NewReceptionStatusRepository(tx)
+		pvzRepo := postgres.NewPVZRepository(tx)
+
+		city, err := cityRepo.Create(ctx, domain.City{ID: uuid.New(), Name: "City"})
+		require.NoError(t, err)
+
+		status, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusClose})
+		require.NoError(t, err)
+
+		// у двух PVZ одинаковая дата регистрации, порядок между ними определяет id
+		regDate := time.Date(2001, 3, 1, 12, 0, 0, 0, time.UTC)
+		regDates := []time.Time{regDate, regDate, regDate.Add(-time.Hour), regDate.Add(-2 * time.Hour)}
+		for _, date := range regDates {
+			var pvz *domain.PVZ
+			pvz, err = pvzRepo.Create(ctx, domain.PVZ{ID: uuid.New(), RegistrationDate: date, CityID: city.ID})
+			require.NoError(t, err)
+
+			_, err = receptionRepo.Create(ctx, domain.Reception{PvzID: pvz.ID, DateTime: date, StatusID: status.ID})
+			require.NoError(t, err)
+		}
+
+		start := regDate.Add(-24 * time.Hour)
+		end := regDate
+		filter := domain.PVZListFilter{StartDate: &start, EndDate: &end}
+		pagination := &listparams.Pagination{Limit: 3, Page: 1}
+
+		all, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, filter, nil, &listparams.Pagination{Limit: 10, Page: 1}, nil)
+		require.NoError(t, err)
+		require.Len(t, all, 4)
+
+		var got []*domain.PVZ
+		var after *listparams.Cursor
+		for {
+			page, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, filter, nil, pagination, after)
+			require.NoError(t, err)
+			got = append(got, page...)
+
+			if len(page) < int(pagination.Limit) {
+				break
+			}
+			last := page[len(page)-1]
+			after = &listparams.Cursor{Time: last.RegistrationDate, ID: last.ID}
+		}
+
+		// обход по курсору отдаёт те же PVZ в том же порядке без пропусков и дублей
+		require.Len(t, got, len(all))
+		for i := range all {
+			assert.Equal(t, all[i].ID, got[i].ID)
+		}
+	})
+}
+
+func TestPVZRepository_ListPvzByAcceptanceDateAndCity_FilterAndSort(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		fx := newProductFixture(t, ctx, tx)
+		cityRepo := postgres.NewCityRepository(tx)
+		pvzRepo := postgres.NewPVZRepository(tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+		statusRepo := postgres.NewReceptionStatusRepository(tx)
+
+		inProgress, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusInProgress})
+		require.NoError(t, err)
+
+		// отдельный период, чтобы не пересекаться с приёмками из других тестов
+		base := time.Date(2002, 5, 1, 12, 0, 0, 0, time.UTC)
+		start, end := base.Add(-24*time.Hour), base.Add(24*time.Hour)
+
+		cityA, err := cityRepo.Create(ctx, domain.City{ID: uuid.New(), Name: "ФильтрГородА"})
+		require.NoError(t, err)
+		cityB, err := cityRepo.Create(ctx, domain.City{ID: uuid.New(), Name: "ФильтрГородБ"})
+		require.NoError(t, err)
+
+		// pvzA: открытая приёмка без товаров, pvzB: закрытая приёмка с товаром, pvzC: приёмок нет
+		pvzA, err := pvzRepo.Create(ctx, domain.PVZ{ID: uuid.New(), RegistrationDate: base.Add(-3 * time.Hour), CityID: cityB.ID})
+		require.NoError(t, err)
+		pvzB, err := pvzRepo.Create(ctx, domain.PVZ{ID: uuid.New(), RegistrationDate: base.Add(-2 * time.Hour), CityID: cityA.ID})
+		require.NoError(t, err)
+		pvzC, err := pvzRepo.Create(ctx, domain.PVZ{ID: uuid.New(), RegistrationDate: base.Add(-1 * time.Hour), CityID: cityA.ID})
+		require.NoError(t, err)
+
+		_, err = receptionRepo.Create(ctx, domain.Reception{PvzID: pvzA.ID, DateTime: base.Add(time.Hour), StatusID: inProgress.ID})
+		require.NoError(t, err)
+
+		closed, err := receptionRepo.Create(ctx, domain.Reception{PvzID: pvzB.ID, DateTime: base, StatusID: fx.reception.StatusID})
+		require.NoError(t, err)
+		_, err = fx.productRepo.Create(ctx, newProduct(fx.productType.ID, closed.ID, base))
+		require.NoError(t, err)
+
+		ids := func(pvzs []*domain.PVZ) []uuid.UUID {
+			out := make([]uuid.UUID, 0, len(pvzs))
+			for _, pvz := range pvzs {
+				out = append(out, pvz.ID)
+			}
+			return out
+		}
+		pagination := &listparams.Pagination{Limit: 10, Page: 1}
+		cities := []string{cityA.Name, cityB.Name}
+
+		result, err := pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{Cities: cities}, nil, pagination, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{pvzC.ID, pvzB.ID, pvzA.ID}, ids(result))
+
+		result, err = pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{Cities: []string{cityB.Name}}, nil, pagination, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{pvzA.ID}, ids(result))
+
+		result, err = pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{
+			Cities:            cities,
+			ReceptionStatuses: []domain.ReceptionStatusCode{domain.ReceptionStatusInProgress},
+		}, nil, pagination, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{pvzA.ID}, ids(result))
+
+		result, err = pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{
+			StartDate:    &start,
+			EndDate:      &end,
+			ProductTypes: []string{fx.productType.Name},
+		}, nil, pagination, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{pvzB.ID}, ids(result))
+
+		// статус и тип товара должны относиться к одной приёмке
+		result, err = pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{
+			Cities:            cities,
+			ReceptionStatuses: []domain.ReceptionStatusCode{domain.ReceptionStatusInProgress},
+			ProductTypes:      []string{fx.productType.Name},
+		}, nil, pagination, nil)
+		require.NoError(t, err)
+		assert.Empty(t, result)
+
+		result, err = pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{Cities: cities}, []listparams.Sort{
+			{Field: domain.PVZSortCity, Order: listparams.SortAsc},
+			{Field: domain.PVZSortRegistrationDate, Order: listparams.SortAsc},
+		}, pagination, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{pvzB.ID, pvzC.ID, pvzA.ID}, ids(result))
+
+		// PVZ без приёмок в конце при любом направлении
+		result, err = pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{Cities: cities}, []listparams.Sort{
+			{Field: domain.PVZSortLastReceptionAt, Order: listparams.SortDesc},
+		}, pagination, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{pvzA.ID, pvzB.ID, pvzC.ID}, ids(result))
+
+		result, err = pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{Cities: cities}, []listparams.Sort{
+			{Field: domain.PVZSortLastReceptionAt, Order: listparams.SortAsc},
+		}, pagination, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{pvzB.ID, pvzA.ID, pvzC.ID}, ids(result))
+
+		_, err = pvzRepo.ListPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{}, []listparams.Sort{{Field: "pvz.id"}}, pagination, nil)
+		require.Error(t, err)
+
+		total, err := pvzRepo.CountPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{Cities: cities})
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+
+		total, err = pvzRepo.CountPvzByAcceptanceDateAndCity(ctx, domain.PVZListFilter{
+			StartDate:    &start,
+			EndDate:      &end,
+			ProductTypes: []string{fx.productType.Name},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+	})
+}
@@ -2,6 +2,7 @@ package postgres_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/valeragav/avito-pvz-service/internal/domain"
 	"github.com/valeragav/avito-pvz-service/internal/infra"
 	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
 )
 
 func TestReceptionRepository_Create(t *testing.T) {
@@ -246,6 +248,65 @@ func TestReceptionRepository_ListByIDsWithStatus(t *testing.T) {
 	})
 }
 
+func TestReceptionRepository_ListByPVZWithStatus(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+		pvzRepo := postgres.NewPVZRepository(tx)
+		statusRepo := postgres.NewReceptionStatusRepository(tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+
+		city, err := cityRepo.Create(ctx, domain.City{ID: uuid.New(), Name: "City"})
+		require.NoError(t, err)
+
+		pvz, err := pvzRepo.Create(ctx, domain.PVZ{ID: uuid.New(), RegistrationDate: time.Now(), CityID: city.ID})
+		require.NoError(t, err)
+		other, err := pvzRepo.Create(ctx, domain.PVZ{ID: uuid.New(), RegistrationDate: time.Now(), CityID: city.ID})
+		require.NoError(t, err)
+
+		status, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusClose})
+		require.NoError(t, err)
+		statusID := status.ID
+
+		base := time.Date(2026, time.February, 1, 10, 0, 0, 0, time.UTC)
+		ids := make([]uuid.UUID, 0, 3)
+		for i := range 3 {
+			reception, err := receptionRepo.Create(ctx, domain.Reception{
+				PvzID:    pvz.ID,
+				DateTime: base.Add(time.Duration(i) * 24 * time.Hour),
+				StatusID: statusID,
+			})
+			require.NoError(t, err)
+			ids = append(ids, reception.ID)
+		}
+
+		_, err = receptionRepo.Create(ctx, domain.Reception{PvzID: other.ID, DateTime: base, StatusID: statusID})
+		require.NoError(t, err)
+
+		// новые первыми
+		got, err := receptionRepo.ListByPVZWithStatus(ctx, pvz.ID, nil, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		assert.Equal(t, []uuid.UUID{ids[2], ids[1], ids[0]}, []uuid.UUID{got[0].ID, got[1].ID, got[2].ID})
+		assert.Equal(t, domain.ReceptionStatusClose, got[0].ReceptionStatus.Name)
+
+		page, err := receptionRepo.ListByPVZWithStatus(ctx, pvz.ID, &listparams.Pagination{Page: 2, Limit: 2}, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, ids[0], page[0].ID)
+
+		startDate := base.Add(12 * time.Hour)
+		filtered, err := receptionRepo.ListByPVZWithStatus(ctx, pvz.ID, nil, &startDate, nil)
+		require.NoError(t, err)
+		assert.Len(t, filtered, 2)
+
+		endDate := base.Add(36 * time.Hour)
+		filtered, err = receptionRepo.ListByPVZWithStatus(ctx, pvz.ID, nil, &startDate, &endDate)
+		require.NoError(t, err)
+		require.Len(t, filtered, 1)
+		assert.Equal(t, ids[1], filtered[0].ID)
+	})
+}
+
 func TestReceptionRepository_FindByStatus(t *testing.T) {
 	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
 		cityRepo := postgres.NewCityRepository(tx)
@@ -335,24 +396,32 @@ func TestReceptionRepository_Update(t *testing.T) {
 		})
 		require.NoError(t, err)
 
+		createdBy, closedBy := uuid.New(), uuid.New()
+
 		created, err := receptionRepo.Create(ctx, domain.Reception{
-			ID:       uuid.New(),
-			PvzID:    pvz.ID,
-			DateTime: time.Now().Add(-1 * time.Hour),
-			StatusID: statusOldID,
+			ID:        uuid.New(),
+			PvzID:     pvz.ID,
+			DateTime:  time.Now().Add(-1 * time.Hour),
+			StatusID:  statusOldID,
+			CreatedBy: createdBy,
 		})
 		require.NoError(t, err)
+		assert.Equal(t, createdBy, created.CreatedBy)
+		assert.Equal(t, uuid.Nil, created.ClosedBy)
 
 		newDateTime := time.Now()
 		updated, err := receptionRepo.Update(ctx, created.ID, domain.Reception{
 			DateTime: newDateTime,
 			StatusID: statusNewID,
+			ClosedBy: closedBy,
 		})
 		require.NoError(t, err)
 		require.NotNil(t, updated)
 		assert.Equal(t, created.ID, updated.ID)
 		assert.Equal(t, created.PvzID, updated.PvzID)
 		assert.Equal(t, statusNewID, updated.StatusID)
+		assert.Equal(t, createdBy, updated.CreatedBy)
+		assert.Equal(t, closedBy, updated.ClosedBy)
 		assert.WithinDuration(t, newDateTime, updated.DateTime, time.Millisecond)
 
 		_, err = receptionRepo.Update(ctx, uuid.New(), domain.Reception{
@@ -361,3 +430,63 @@ func TestReceptionRepository_Update(t *testing.T) {
 		assert.ErrorIs(t, err, infra.ErrNotFound)
 	})
 }
+
+func TestReceptionRepository_Export(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		f := newProductFixture(t, ctx, tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+
+		// отдельное окно в прошлом, чтобы не зависеть от других приёмок в базе
+		start := time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := start.Add(24 * time.Hour)
+
+		withProducts, err := receptionRepo.Create(ctx, domain.Reception{
+			ID:       uuid.New(),
+			PvzID:    f.reception.PvzID,
+			DateTime: start.Add(time.Hour),
+			StatusID: f.reception.StatusID,
+		})
+		require.NoError(t, err)
+
+		empty, err := receptionRepo.Create(ctx, domain.Reception{
+			ID:       uuid.New(),
+			PvzID:    f.reception.PvzID,
+			DateTime: start.Add(2 * time.Hour),
+			StatusID: f.reception.StatusID,
+		})
+		require.NoError(t, err)
+
+		first, err := f.productRepo.Create(ctx, newProduct(f.productType.ID, withProducts.ID, start.Add(time.Hour+time.Minute)))
+		require.NoError(t, err)
+		second, err := f.productRepo.Create(ctx, newProduct(f.productType.ID, withProducts.ID, start.Add(time.Hour+2*time.Minute)))
+		require.NoError(t, err)
+
+		var rows []domain.ReceptionExportRow
+		err = receptionRepo.Export(ctx, &start, &end, func(row domain.ReceptionExportRow) error {
+			rows = append(rows, row)
+			return nil
+		})
+		require.NoError(t, err)
+
+		// по строке на товар, приёмка без товаров одной строкой с пустым товаром
+		require.Len(t, rows, 3)
+		assert.Equal(t, withProducts.ID, rows[0].ReceptionID)
+		assert.Equal(t, first.ID, rows[0].ProductID)
+		assert.Equal(t, "электроника", rows[0].ProductType)
+		assert.Equal(t, "TestCity", rows[0].City)
+		assert.Equal(t, domain.ReceptionStatusClose, rows[0].Status)
+		assert.Equal(t, second.ID, rows[1].ProductID)
+		assert.Equal(t, empty.ID, rows[2].ReceptionID)
+		assert.Equal(t, uuid.Nil, rows[2].ProductID)
+
+		// ошибка fn прерывает чтение
+		errStop := errors.New("stop")
+		calls := 0
+		err = receptionRepo.Export(ctx, &start, &end, func(domain.ReceptionExportRow) error {
+			calls++
+			return errStop
+		})
+		require.ErrorIs(t, err, errStop)
+		assert.Equal(t, 1, calls)
+	})
+}
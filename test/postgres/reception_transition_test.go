@@ -0,0 +1,131 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+)
+
+func TestReceptionRepository_ReopenedIsOpen(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+		pvzRepo := postgres.NewPVZRepository(tx)
+		statusRepo := postgres.NewReceptionStatusRepository(tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+
+		city, err := cityRepo.Create(ctx, domain.City{ID: uuid.New(), Name: "TestCity"})
+		require.NoError(t, err)
+
+		pvz, err := pvzRepo.Create(ctx, domain.PVZ{
+			ID:               uuid.New(),
+			RegistrationDate: time.Now(),
+			CityID:           city.ID,
+		})
+		require.NoError(t, err)
+
+		closeStatus, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusClose})
+		require.NoError(t, err)
+		reopenedStatus, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusReopened})
+		require.NoError(t, err)
+		inProgressStatus, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusInProgress})
+		require.NoError(t, err)
+
+		reception, err := receptionRepo.Create(ctx, domain.Reception{
+			PvzID:    pvz.ID,
+			DateTime: time.Now(),
+			StatusID: closeStatus.ID,
+		})
+		require.NoError(t, err)
+
+		_, err = receptionRepo.FindOpen(ctx, domain.Reception{PvzID: pvz.ID})
+		require.ErrorIs(t, err, infra.ErrNotFound)
+
+		_, err = receptionRepo.Update(ctx, reception.ID, domain.Reception{StatusID: reopenedStatus.ID})
+		require.NoError(t, err)
+
+		open, err := receptionRepo.FindOpen(ctx, domain.Reception{PvzID: pvz.ID})
+		require.NoError(t, err)
+		assert.Equal(t, reception.ID, open.ID)
+		assert.Equal(t, domain.ReceptionStatusReopened, open.ReceptionStatus.Name)
+
+		got, err := receptionRepo.GetWithStatus(ctx, reception.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ReceptionStatusReopened, got.ReceptionStatus.Name)
+
+		// переоткрытая приёмка занимает место открытой в PVZ
+		_, err = receptionRepo.Create(ctx, domain.Reception{
+			PvzID:    pvz.ID,
+			DateTime: time.Now(),
+			StatusID: inProgressStatus.ID,
+		})
+		require.ErrorIs(t, err, infra.ErrDuplicate)
+		assert.Equal(t, "idx_receptions_pvz_in_progress", infra.ConstraintName(err))
+	})
+}
+
+func TestReceptionTransitionRepository(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+		pvzRepo := postgres.NewPVZRepository(tx)
+		statusRepo := postgres.NewReceptionStatusRepository(tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+		transitionRepo := postgres.NewReceptionTransitionRepository(tx)
+
+		city, err := cityRepo.Create(ctx, domain.City{ID: uuid.New(), Name: "TestCity"})
+		require.NoError(t, err)
+
+		pvz, err := pvzRepo.Create(ctx, domain.PVZ{
+			ID:               uuid.New(),
+			RegistrationDate: time.Now(),
+			CityID:           city.ID,
+		})
+		require.NoError(t, err)
+
+		status, err := statusRepo.Get(ctx, domain.ReceptionStatus{Name: domain.ReceptionStatusInProgress})
+		require.NoError(t, err)
+
+		reception, err := receptionRepo.Create(ctx, domain.Reception{
+			PvzID:    pvz.ID,
+			DateTime: time.Now(),
+			StatusID: status.ID,
+		})
+		require.NoError(t, err)
+
+		actorID := uuid.New()
+		now := time.Now()
+
+		_, err = transitionRepo.Create(ctx, domain.ReceptionTransition{
+			ReceptionID: reception.ID,
+			ToStatus:    domain.ReceptionStatusInProgress,
+			ActorID:     actorID,
+			CreatedAt:   now.Add(-time.Minute),
+		})
+		require.NoError(t, err)
+
+		_, err = transitionRepo.Create(ctx, domain.ReceptionTransition{
+			ReceptionID: reception.ID,
+			FromStatus:  domain.ReceptionStatusInProgress,
+			ToStatus:    domain.ReceptionStatusCancelled,
+			ActorID:     actorID,
+			CreatedAt:   now,
+		})
+		require.NoError(t, err)
+
+		history, err := transitionRepo.ListByReception(ctx, reception.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+
+		assert.Empty(t, history[0].FromStatus)
+		assert.Equal(t, domain.ReceptionStatusInProgress, history[0].ToStatus)
+		assert.Equal(t, domain.ReceptionStatusInProgress, history[1].FromStatus)
+		assert.Equal(t, domain.ReceptionStatusCancelled, history[1].ToStatus)
+		assert.Equal(t, actorID, history[1].ActorID)
+	})
+}
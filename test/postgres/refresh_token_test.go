@@ -0,0 +1,144 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+)
+
+func TestRefreshTokenRepository(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		userRepo := postgres.NewUserRepository(tx)
+		refreshRepo := postgres.NewRefreshTokenRepository(tx)
+
+		user, err := userRepo.Create(ctx, domain.User{
+			ID:           uuid.New(),
+			PasswordHash: "Hash",
+			Email:        "refresh@example.com",
+			Role:         domain.EmployeeRole,
+		})
+		require.NoError(t, err)
+
+		first, err := refreshRepo.Create(ctx, domain.RefreshToken{
+			UserID:    user.ID,
+			TokenHash: "hash-1",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		assert.False(t, first.IsRevoked())
+
+		second, err := refreshRepo.Create(ctx, domain.RefreshToken{
+			UserID:    user.ID,
+			TokenHash: "hash-2",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		got, err := refreshRepo.GetByHashForUpdate(ctx, "hash-1")
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, got.ID)
+
+		_, err = refreshRepo.GetByHashForUpdate(ctx, "unknown")
+		assert.ErrorIs(t, err, infra.ErrNotFound)
+
+		require.NoError(t, refreshRepo.Revoke(ctx, first.ID))
+
+		got, err = refreshRepo.GetByHashForUpdate(ctx, "hash-1")
+		require.NoError(t, err)
+		assert.True(t, got.IsRevoked())
+
+		require.NoError(t, refreshRepo.RevokeAllByUser(ctx, user.ID))
+
+		got, err = refreshRepo.GetByHashForUpdate(ctx, second.TokenHash)
+		require.NoError(t, err)
+		assert.True(t, got.IsRevoked())
+	})
+}
+
+func TestRevokedTokenRepository(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		revokedRepo := postgres.NewRevokedTokenRepository(tx)
+
+		jti := uuid.New()
+
+		exists, err := revokedRepo.Exists(ctx, jti)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		require.NoError(t, revokedRepo.Create(ctx, jti, time.Now().Add(time.Hour)))
+		// повторный отзыв не ошибка
+		require.NoError(t, revokedRepo.Create(ctx, jti, time.Now().Add(time.Hour)))
+
+		exists, err = revokedRepo.Exists(ctx, jti)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
+
+func TestRefreshTokenRepository_DeleteExpired(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		userRepo := postgres.NewUserRepository(tx)
+		refreshRepo := postgres.NewRefreshTokenRepository(tx)
+
+		user, err := userRepo.Create(ctx, domain.User{
+			ID:           uuid.New(),
+			PasswordHash: "Hash",
+			Email:        "refresh-purge@example.com",
+			Role:         domain.EmployeeRole,
+		})
+		require.NoError(t, err)
+
+		_, err = refreshRepo.Create(ctx, domain.RefreshToken{
+			UserID:    user.ID,
+			TokenHash: "hash-expired",
+			ExpiresAt: time.Now().Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		_, err = refreshRepo.Create(ctx, domain.RefreshToken{
+			UserID:    user.ID,
+			TokenHash: "hash-active",
+			ExpiresAt: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		deleted, err := refreshRepo.DeleteExpired(ctx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, deleted, int64(1))
+
+		_, err = refreshRepo.GetByHashForUpdate(ctx, "hash-expired")
+		assert.ErrorIs(t, err, infra.ErrNotFound)
+
+		_, err = refreshRepo.GetByHashForUpdate(ctx, "hash-active")
+		require.NoError(t, err)
+	})
+}
+
+func TestRevokedTokenRepository_DeleteExpired(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		revokedRepo := postgres.NewRevokedTokenRepository(tx)
+
+		expired, active := uuid.New(), uuid.New()
+		require.NoError(t, revokedRepo.Create(ctx, expired, time.Now().Add(-time.Hour)))
+		require.NoError(t, revokedRepo.Create(ctx, active, time.Now().Add(time.Hour)))
+
+		deleted, err := revokedRepo.DeleteExpired(ctx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, deleted, int64(1))
+
+		exists, err := revokedRepo.Exists(ctx, expired)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		exists, err = revokedRepo.Exists(ctx, active)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
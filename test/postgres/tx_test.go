@@ -0,0 +1,167 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+)
+
+func TestPVZRepository_GetForUpdate(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+		pvzRepo := postgres.NewPVZRepository(tx)
+
+		city, err := cityRepo.Create(ctx, domain.City{ID: uuid.New(), Name: "TestCity"})
+		require.NoError(t, err)
+
+		pvz, err := pvzRepo.Create(ctx, domain.PVZ{
+			ID:               uuid.New(),
+			RegistrationDate: time.Now(),
+			CityID:           city.ID,
+		})
+		require.NoError(t, err)
+
+		got, err := pvzRepo.GetForUpdate(ctx, pvz.ID)
+		require.NoError(t, err)
+		assert.Equal(t, pvz.ID, got.ID)
+		assert.Equal(t, city.ID, got.CityID)
+
+		_, err = pvzRepo.GetForUpdate(ctx, uuid.New())
+		assert.ErrorIs(t, err, infra.ErrNotFound)
+	})
+}
+
+func TestReceptionRepository_OnlyOneInProgressPerPVZ(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+		pvzRepo := postgres.NewPVZRepository(tx)
+		statusRepo := postgres.NewReceptionStatusRepository(tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+
+		city, err := cityRepo.Create(ctx, domain.City{ID: uuid.New(), Name: "TestCity"})
+		require.NoError(t, err)
+
+		pvz, err := pvzRepo.Create(ctx, domain.PVZ{
+			ID:               uuid.New(),
+			RegistrationDate: time.Now(),
+			CityID:           city.ID,
+		})
+		require.NoError(t, err)
+
+		inProgressID, closeID := uuid.New(), uuid.New()
+		err = statusRepo.CreateBatch(ctx, []domain.ReceptionStatus{
+			{ID: inProgressID, Name: domain.ReceptionStatusInProgress},
+			{ID: closeID, Name: domain.ReceptionStatusClose},
+		})
+		require.NoError(t, err)
+
+		_, err = receptionRepo.Create(ctx, domain.Reception{
+			PvzID:    pvz.ID,
+			DateTime: time.Now(),
+			StatusID: inProgressID,
+		})
+		require.NoError(t, err)
+
+		// вторая открытая приёмка в том же PVZ отсекается частичным уникальным индексом
+		_, err = receptionRepo.Create(ctx, domain.Reception{
+			PvzID:    pvz.ID,
+			DateTime: time.Now(),
+			StatusID: inProgressID,
+		})
+		require.ErrorIs(t, err, infra.ErrDuplicate)
+	})
+
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		cityRepo := postgres.NewCityRepository(tx)
+		pvzRepo := postgres.NewPVZRepository(tx)
+		statusRepo := postgres.NewReceptionStatusRepository(tx)
+		receptionRepo := postgres.NewReceptionRepository(tx)
+
+		city, err := cityRepo.Create(ctx, domain.City{ID: uuid.New(), Name: "TestCity"})
+		require.NoError(t, err)
+
+		pvz, err := pvzRepo.Create(ctx, domain.PVZ{
+			ID:               uuid.New(),
+			RegistrationDate: time.Now(),
+			CityID:           city.ID,
+		})
+		require.NoError(t, err)
+
+		inProgressID, closeID := uuid.New(), uuid.New()
+		err = statusRepo.CreateBatch(ctx, []domain.ReceptionStatus{
+			{ID: inProgressID, Name: domain.ReceptionStatusInProgress},
+			{ID: closeID, Name: domain.ReceptionStatusClose},
+		})
+		require.NoError(t, err)
+
+		first, err := receptionRepo.Create(ctx, domain.Reception{
+			PvzID:    pvz.ID,
+			DateTime: time.Now(),
+			StatusID: inProgressID,
+		})
+		require.NoError(t, err)
+
+		// после закрытия можно открыть новую приёмку
+		_, err = receptionRepo.Update(ctx, first.ID, domain.Reception{StatusID: closeID})
+		require.NoError(t, err)
+
+		_, err = receptionRepo.Create(ctx, domain.Reception{
+			PvzID:    pvz.ID,
+			DateTime: time.Now(),
+			StatusID: inProgressID,
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestTxManager_Do(t *testing.T) {
+	ctx := context.Background()
+	txManager := postgres.NewTxManager(testApp.DB)
+	cityRepo := postgres.NewCityRepository(testApp.DB)
+
+	t.Run("rollback on error", func(t *testing.T) {
+		cityID := uuid.New()
+		errFn := errors.New("fn error")
+
+		err := txManager.Do(ctx, func(ctx context.Context) error {
+			_, err := cityRepo.Create(ctx, domain.City{ID: cityID, Name: "TxRollbackCity"})
+			require.NoError(t, err)
+
+			// вложенный вызов переиспользует текущую транзакцию
+			return txManager.Do(ctx, func(ctx context.Context) error {
+				_, err := cityRepo.Get(ctx, domain.City{ID: cityID})
+				require.NoError(t, err)
+				return errFn
+			})
+		})
+		require.ErrorIs(t, err, errFn)
+
+		_, err = cityRepo.Get(ctx, domain.City{ID: cityID})
+		assert.ErrorIs(t, err, infra.ErrNotFound)
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		cityID := uuid.New()
+
+		err := txManager.Do(ctx, func(ctx context.Context) error {
+			_, err := cityRepo.Create(ctx, domain.City{ID: cityID, Name: "TxCommitCity"})
+			return err
+		})
+		require.NoError(t, err)
+
+		got, err := cityRepo.Get(ctx, domain.City{ID: cityID})
+		require.NoError(t, err)
+		assert.Equal(t, cityID, got.ID)
+
+		_, err = testApp.DB.Exec(ctx, "DELETE FROM cities WHERE id = $1", cityID)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,130 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valeragav/avito-pvz-service/internal/domain"
+	"github.com/valeragav/avito-pvz-service/internal/infra"
+	"github.com/valeragav/avito-pvz-service/internal/infra/postgres"
+	"github.com/valeragav/avito-pvz-service/pkg/listparams"
+)
+
+func TestWebhookSubscriptionRepository(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		subscriptionRepo := postgres.NewWebhookSubscriptionRepository(tx)
+
+		closed, err := subscriptionRepo.Create(ctx, domain.WebhookSubscription{
+			URL:        "https://partner.example/closed",
+			Secret:     "0123456789abcdef",
+			EventTypes: []domain.EventType{domain.EventReceptionClosed},
+		})
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, closed.ID)
+		assert.Equal(t, []domain.EventType{domain.EventReceptionClosed}, closed.EventTypes)
+
+		_, err = subscriptionRepo.Create(ctx, domain.WebhookSubscription{
+			URL:        "https://partner.example/opened",
+			Secret:     "0123456789abcdef",
+			EventTypes: []domain.EventType{domain.EventReceptionOpened},
+		})
+		require.NoError(t, err)
+
+		got, err := subscriptionRepo.Get(ctx, closed.ID)
+		require.NoError(t, err)
+		assert.Equal(t, closed.URL, got.URL)
+		assert.Equal(t, closed.Secret, got.Secret)
+
+		byType, err := subscriptionRepo.ListByEventType(ctx, domain.EventReceptionClosed)
+		require.NoError(t, err)
+		var ids []uuid.UUID
+		for _, s := range byType {
+			ids = append(ids, s.ID)
+			assert.Contains(t, s.EventTypes, domain.EventReceptionClosed)
+		}
+		assert.Contains(t, ids, closed.ID)
+
+		require.NoError(t, subscriptionRepo.Delete(ctx, closed.ID))
+		assert.ErrorIs(t, subscriptionRepo.Delete(ctx, closed.ID), infra.ErrNotFound)
+
+		_, err = subscriptionRepo.Get(ctx, closed.ID)
+		assert.ErrorIs(t, err, infra.ErrNotFound)
+	})
+}
+
+func TestWebhookDeliveryRepository(t *testing.T) {
+	WithTx(t, func(ctx context.Context, tx postgres.DBTX) {
+		subscriptionRepo := postgres.NewWebhookSubscriptionRepository(tx)
+		deliveryRepo := postgres.NewWebhookDeliveryRepository(tx)
+
+		subscription, err := subscriptionRepo.Create(ctx, domain.WebhookSubscription{
+			URL:        "https://partner.example/hook",
+			Secret:     "0123456789abcdef",
+			EventTypes: []domain.EventType{domain.EventReceptionClosed},
+		})
+		require.NoError(t, err)
+
+		event := domain.OutboxEvent{
+			ID:          uuid.New(),
+			Type:        domain.EventReceptionClosed,
+			AggregateID: uuid.New(),
+			PvzID:       uuid.New(),
+			Payload:     domain.ReceptionClosedPayload{ProductCounts: map[string]int{"обувь": 2}},
+			CreatedAt:   time.Now(),
+		}
+
+		delivery := domain.WebhookDelivery{SubscriptionID: subscription.ID, Event: event}
+		require.NoError(t, deliveryRepo.Create(ctx, delivery))
+		// повторная постановка того же события игнорируется
+		require.NoError(t, deliveryRepo.Create(ctx, delivery))
+
+		due, err := deliveryRepo.ClaimDue(ctx, 100, time.Minute)
+		require.NoError(t, err)
+
+		var got *domain.WebhookDelivery
+		for _, d := range due {
+			if d.SubscriptionID == subscription.ID {
+				require.Nil(t, got, "duplicate delivery")
+				got = d
+			}
+		}
+		require.NotNil(t, got)
+		assert.Equal(t, event.ID, got.Event.ID)
+		require.NotNil(t, got.Subscription)
+		assert.Equal(t, subscription.Secret, got.Subscription.Secret)
+
+		// арендованная доставка не выдаётся повторно до сохранения результата
+		due, err = deliveryRepo.ClaimDue(ctx, 100, time.Minute)
+		require.NoError(t, err)
+		for _, d := range due {
+			assert.NotEqual(t, got.ID, d.ID)
+		}
+
+		err = deliveryRepo.UpdateAttempt(ctx, got.ID, domain.WebhookDelivery{
+			Status:         domain.WebhookDeliveryPending,
+			Attempts:       1,
+			NextAttemptAt:  time.Now().Add(time.Hour),
+			LastStatusCode: 503,
+			LastError:      "webhook responded with status 503",
+		})
+		require.NoError(t, err)
+
+		// отложенная доставка не выбирается до наступления next_attempt_at
+		due, err = deliveryRepo.ClaimDue(ctx, 100, time.Minute)
+		require.NoError(t, err)
+		for _, d := range due {
+			assert.NotEqual(t, got.ID, d.ID)
+		}
+
+		log, err := deliveryRepo.ListBySubscription(ctx, subscription.ID, &listparams.Pagination{Page: 1, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, log, 1)
+		assert.Equal(t, 1, log[0].Attempts)
+		assert.Equal(t, 503, log[0].LastStatusCode)
+		assert.Equal(t, domain.WebhookDeliveryPending, log[0].Status)
+	})
+}